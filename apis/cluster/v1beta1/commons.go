@@ -28,11 +28,68 @@ type ResourceUsage struct {
 	// +optional
 	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
 
+	// Available represents the total resources of all the nodes on a member cluster that are available for scheduling
+	// and have not been requested by the running pods yet.
+	// +optional
+	Available corev1.ResourceList `json:"available,omitempty"`
+
 	// When the resource usage is observed.
 	// +optional
 	ObservationTime metav1.Time `json:"observationTime,omitempty"`
 }
 
+// PropertyName is the name of a cluster property; it should be a Kubernetes label name.
+type PropertyName string
+
+const (
+	// NodeCountProperty is a property that describes the number of nodes in a member cluster.
+	NodeCountProperty PropertyName = "kubernetes-fleet.io/node-count"
+
+	// KubernetesVersionProperty is a property that describes the Kubernetes version of a member cluster.
+	KubernetesVersionProperty PropertyName = "kubernetes-fleet.io/kubernetes-version"
+
+	// TotalCPUCapacityProperty is a resource property that describes the total CPU capacity
+	// of a member cluster; the value is read from the capacity in the resource usage.
+	TotalCPUCapacityProperty PropertyName = "resources.kubernetes-fleet.io/total-cpu"
+
+	// AllocatableCPUCapacityProperty is a resource property that describes the allocatable CPU capacity
+	// of a member cluster; the value is read from the allocatable in the resource usage.
+	AllocatableCPUCapacityProperty PropertyName = "resources.kubernetes-fleet.io/allocatable-cpu"
+
+	// TotalMemoryCapacityProperty is a resource property that describes the total memory capacity
+	// of a member cluster; the value is read from the capacity in the resource usage.
+	TotalMemoryCapacityProperty PropertyName = "resources.kubernetes-fleet.io/total-memory"
+
+	// AllocatableMemoryCapacityProperty is a resource property that describes the allocatable memory capacity
+	// of a member cluster; the value is read from the allocatable in the resource usage.
+	AllocatableMemoryCapacityProperty PropertyName = "resources.kubernetes-fleet.io/allocatable-memory"
+
+	// AvailableCPUCapacityProperty is a resource property that describes the available CPU capacity
+	// of a member cluster; the value is read from the available in the resource usage.
+	AvailableCPUCapacityProperty PropertyName = "resources.kubernetes-fleet.io/available-cpu"
+
+	// AvailableMemoryCapacityProperty is a resource property that describes the available memory capacity
+	// of a member cluster; the value is read from the available in the resource usage.
+	AvailableMemoryCapacityProperty PropertyName = "resources.kubernetes-fleet.io/available-memory"
+)
+
+// PropertyValue is the value of a cluster property.
+type PropertyValue struct {
+	// Value is the value of the cluster property.
+	//
+	// Currently, it should be a valid Kubernetes quantity, or a version string for the
+	// Kubernetes version property.
+	// For more information, see
+	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
+	//
+	// +required
+	Value string `json:"value"`
+
+	// ObservationTime is when the cluster property is observed.
+	// +required
+	ObservationTime metav1.Time `json:"observationTime"`
+}
+
 // AgentType defines a type of agent/binary running in a member cluster.
 type AgentType string
 
@@ -82,6 +139,13 @@ const (
 	// - "False" means the member agent is unhealthy.
 	// - "Unknown" means the member agent has an unknown health status.
 	AgentHealthy AgentConditionType = "Healthy"
+	// AgentPropertyCollectionSucceeded indicates if the member agent has collected the properties and the resource
+	// usage of the member cluster via the property provider at the last heartbeat.
+	// Its condition status can be one of the following:
+	// - "True" means the properties and the resource usage are collected.
+	// - "False" means the collection has failed; the properties and the resource usage collected before are
+	//   still reported with their observation time.
+	AgentPropertyCollectionSucceeded AgentConditionType = "PropertyCollectionSucceeded"
 )
 
 const (
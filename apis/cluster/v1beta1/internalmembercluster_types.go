@@ -52,6 +52,11 @@ type InternalMemberClusterStatus struct {
 	// +optional
 	ResourceUsage ResourceUsage `json:"resourceUsage,omitempty"`
 
+	// Properties is an array of properties observed for the member cluster. It is populated by the
+	// property provider of the member agent.
+	// +optional
+	Properties map[PropertyName]PropertyValue `json:"properties,omitempty"`
+
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
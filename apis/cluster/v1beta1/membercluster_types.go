@@ -6,6 +6,7 @@ Licensed under the MIT license.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +48,33 @@ type MemberClusterSpec struct {
 	// How often (in seconds) for the member cluster to send a heartbeat to the hub cluster. Default: 60 seconds. Min: 1 second. Max: 10 minutes.
 	// +optional
 	HeartbeatPeriodSeconds int32 `json:"heartbeatPeriodSeconds,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=100
+
+	// If specified, the member cluster's taints.
+	// The scheduler will not place resources of a ClusterResourcePlacement onto the member cluster
+	// unless the placement tolerates all of its taints. Placements that have already landed on the
+	// member cluster are not affected when a taint is added.
+	// +optional
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// Taint attached to MemberCluster has the "effect" on
+// any ClusterResourcePlacement that does not tolerate the Taint.
+type Taint struct {
+	// The taint key to be applied to a MemberCluster.
+	// +required
+	Key string `json:"key"`
+
+	// The taint value corresponding to the taint key.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// The effect of the taint on ClusterResourcePlacements that do not tolerate the taint.
+	// Only NoSchedule is supported.
+	// +kubebuilder:validation:Enum=NoSchedule
+	// +required
+	Effect corev1.TaintEffect `json:"effect"`
 }
 
 // MemberClusterStatus defines the observed status of MemberCluster.
@@ -64,6 +92,13 @@ type MemberClusterStatus struct {
 	// +optional
 	ResourceUsage ResourceUsage `json:"resourceUsage,omitempty"`
 
+	// Properties is an array of properties observed for the member cluster. It is copied from the corresponding InternalMemberCluster object.
+	//
+	// The scheduler can select clusters by these properties, as well as by the resource
+	// properties that are read from the resource usage, via property selectors in a placement.
+	// +optional
+	Properties map[PropertyName]PropertyValue `json:"properties,omitempty"`
+
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
@@ -117,6 +117,13 @@ func (in *InternalMemberClusterSpec) DeepCopy() *InternalMemberClusterSpec {
 func (in *InternalMemberClusterStatus) DeepCopyInto(out *InternalMemberClusterStatus) {
 	*out = *in
 	in.ResourceUsage.DeepCopyInto(&out.ResourceUsage)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[PropertyName]PropertyValue, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.AgentStatus != nil {
 		in, out := &in.AgentStatus, &out.AgentStatus
 		*out = make([]AgentStatus, len(*in))
@@ -141,7 +148,7 @@ func (in *MemberCluster) DeepCopyInto(out *MemberCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -199,6 +206,11 @@ func (in *MemberClusterList) DeepCopyObject() runtime.Object {
 func (in *MemberClusterSpec) DeepCopyInto(out *MemberClusterSpec) {
 	*out = *in
 	out.Identity = in.Identity
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]Taint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberClusterSpec.
@@ -222,6 +234,13 @@ func (in *MemberClusterStatus) DeepCopyInto(out *MemberClusterStatus) {
 		}
 	}
 	in.ResourceUsage.DeepCopyInto(&out.ResourceUsage)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[PropertyName]PropertyValue, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.AgentStatus != nil {
 		in, out := &in.AgentStatus, &out.AgentStatus
 		*out = make([]AgentStatus, len(*in))
@@ -241,6 +260,22 @@ func (in *MemberClusterStatus) DeepCopy() *MemberClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyValue) DeepCopyInto(out *PropertyValue) {
+	*out = *in
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyValue.
+func (in *PropertyValue) DeepCopy() *PropertyValue {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
 	*out = *in
@@ -258,6 +293,13 @@ func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Available != nil {
+		in, out := &in.Available, &out.Available
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
 }
 
@@ -270,3 +312,18 @@ func (in *ResourceUsage) DeepCopy() *ResourceUsage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Taint) DeepCopyInto(out *Taint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Taint.
+func (in *Taint) DeepCopy() *Taint {
+	if in == nil {
+		return nil
+	}
+	out := new(Taint)
+	in.DeepCopyInto(out)
+	return out
+}
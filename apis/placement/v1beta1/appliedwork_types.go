@@ -64,6 +64,12 @@ type AppliedResourceMeta struct {
 	// It is not directly settable by a client.
 	// +optional
 	UID types.UID `json:"uid,omitempty"`
+
+	// RetainedSince is the time at which the resource was removed from the work and started to be retained on the
+	// cluster according to the Retain deletion policy of the work. The resource is deleted once the retain grace
+	// period has passed since then.
+	// +optional
+	RetainedSince *metav1.Time `json:"retainedSince,omitempty"`
 }
 
 // +genclient
@@ -43,6 +43,17 @@ type ResourceBindingSpec struct {
 	// it points to the name of the leading snapshot of the index group.
 	ResourceSnapshotName string `json:"resourceSnapshotName"`
 
+	// ResourceOverrideSnapshots is a list of ResourceOverride snapshots associated with the selected resources and the
+	// target cluster. The work generator applies them, in order, to the resources placed on the target cluster.
+	// +optional
+	ResourceOverrideSnapshots []NamespacedName `json:"resourceOverrideSnapshots,omitempty"`
+
+	// ClusterResourceOverrideSnapshots is a list of ClusterResourceOverride snapshot names associated with the selected
+	// resources and the target cluster. The work generator applies them, in order, to the resources placed on the
+	// target cluster.
+	// +optional
+	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
+
 	// SchedulingPolicySnapshotName is the name of the scheduling policy snapshot that this resource binding
 	// points to; more specifically, the scheduler creates this bindings in accordance with this
 	// scheduling policy snapshot.
@@ -53,6 +64,39 @@ type ResourceBindingSpec struct {
 
 	// ClusterDecision explains why the scheduler selected this cluster.
 	ClusterDecision ClusterDecision `json:"clusterDecision"`
+
+	// ApplyStrategy describes how the member agent applies the resources to the target cluster.
+	// The rollout controller copies it from the placement when the binding is rolled out.
+	// +optional
+	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+
+	// DeletionPolicy describes what the member agent does with the resources when they are no longer placed on the
+	// target cluster. The rollout controller copies it from the placement when the binding is rolled out.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DryRunResourceSnapshotName is the name of the resource snapshot that the binding is going to be rolled to.
+	// The rollout controller sets it when the placement asks to validate the resources with dry-run before rolling
+	// them out. The work generator then generates a dry-run work with the resources and reports the result in
+	// the DryRunSucceeded condition.
+	// +optional
+	DryRunResourceSnapshotName string `json:"dryRunResourceSnapshotName,omitempty"`
+
+	// RolloutGates are the checks which the member agent runs on the target cluster once the resources are available
+	// there. The rollout controller copies them from the placement when the binding is rolled out, and the work
+	// generator reports the result in the RolloutGatesPassed condition.
+	// +optional
+	RolloutGates []RolloutGate `json:"rolloutGates,omitempty"`
+}
+
+// NamespacedName comprises a resource name, with a mandatory namespace.
+type NamespacedName struct {
+	// Name is the name of the namespaced scope resource.
+	// +required
+	Name string `json:"name"`
+	// Namespace is namespace of the namespaced scope resource.
+	// +required
+	Namespace string `json:"namespace"`
 }
 
 // BindingState is the state of the binding.
@@ -100,6 +144,29 @@ const (
 	// - "False" means not all the resources are created in the target cluster yet.
 	// - "Unknown" means it is unknown.
 	ResourceBindingApplied ResourceBindingConditionType = "Applied"
+
+	// ResourceBindingAvailable indicates the available condition of the given resources.
+	// Its condition status can be one of the following:
+	// - "True" means all the resources are available in the target cluster, or all the resources are applied
+	// and the availability of some of them cannot be tracked.
+	// - "False" means not all the resources are available in the target cluster yet.
+	// - "Unknown" means it is unknown.
+	ResourceBindingAvailable ResourceBindingConditionType = "Available"
+
+	// ResourceBindingDryRunSucceeded indicates whether the resources in the resource snapshot that the binding is
+	// going to be rolled to pass the dry-run on the target cluster.
+	// Its condition status can be one of the following:
+	// - "True" means the target cluster accepts all the resources with server-side dry-run requests.
+	// - "False" means the target cluster rejects some of the resources.
+	// - "Unknown" means the dry-run is not finished yet.
+	ResourceBindingDryRunSucceeded ResourceBindingConditionType = "DryRunSucceeded"
+
+	// ResourceBindingRolloutGatesPassed indicates whether the rollout gates pass on the target cluster.
+	// Its condition status can be one of the following:
+	// - "True" means all the rollout gates pass.
+	// - "False" means some of the rollout gates fail.
+	// - "Unknown" means the rollout gates are not checked yet.
+	ResourceBindingRolloutGatesPassed ResourceBindingConditionType = "RolloutGatesPassed"
 )
 
 // ClusterResourceBindingList is a collection of ClusterResourceBinding.
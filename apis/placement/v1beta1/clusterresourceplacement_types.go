@@ -6,7 +6,9 @@ Licensed under the MIT license.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -31,6 +33,9 @@ const (
 
 	// DefaultUnavailablePeriodSeconds is the default period of time we consider a newly applied workload as unavailable.
 	DefaultUnavailablePeriodSeconds = 60
+
+	// DefaultRolloutGateTimeoutSeconds is the default timeout of a single check of a rollout gate.
+	DefaultRolloutGateTimeoutSeconds = int32(10)
 )
 
 // +genclient
@@ -99,10 +104,20 @@ type ClusterResourcePlacementSpec struct {
 	// +kubebuilder:default=10
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// PinnedResourceIndex pins the placement to the ClusterResourceSnapshot with the given resource index, e.g., to roll
+	// back to a previous version of the selected resources. The bindings are rolled to the pinned resource snapshot
+	// with the same rollout strategy instead of the latest one, while the new resource snapshots are still created.
+	// The pinned resource snapshot is retained even if it exceeds the revision history limit.
+	// The latest resource snapshot is rolled out again once it is unset.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PinnedResourceIndex *int32 `json:"pinnedResourceIndex,omitempty"`
 }
 
 // ClusterResourceSelector is used to select cluster scoped resources as the target resources to be placed.
-// If a namespace is selected, ALL the resources under the namespace are selected automatically.
+// If a namespace is selected, ALL the resources under the namespace are selected automatically, unless the
+// SelectionScope or the NamespacedResourceSelectors is specified.
 // All the fields are `ANDed`. In other words, a resource must match all the fields to be selected.
 type ClusterResourceSelector struct {
 	// Group name of the cluster-scoped resource.
@@ -115,7 +130,7 @@ type ClusterResourceSelector struct {
 	Version string `json:"version"`
 
 	// Kind of the cluster-scoped resource.
-	// Note: When `Kind` is `namespace`, ALL the resources under the selected namespaces are selected.
+	// Note: When `Kind` is `namespace`, ALL the resources under the selected namespaces are selected by default.
 	// +required
 	Kind string `json:"kind"`
 
@@ -130,8 +145,40 @@ type ClusterResourceSelector struct {
 	// Note that namespace-scoped resources can't be selected even if they match the query.
 	// +optional
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// SelectionScope defines the scope of the resources selected with the namespaces, which can only be specified when
+	// `Kind` is `namespace`. Defaults to `NamespaceWithResources`.
+	// It cannot be specified in a ClusterResourceOverride.
+	// +kubebuilder:validation:Enum=NamespaceWithResources;NamespaceOnly
+	// +optional
+	SelectionScope SelectionScope `json:"selectionScope,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=20
+
+	// NamespacedResourceSelectors is an array of selectors used to select the namespace scoped resources in the
+	// selected namespaces, which can only be specified when `Kind` is `namespace` and the SelectionScope is
+	// `NamespaceWithResources`. The selectors are `ORed`.
+	// If specified, only the namespace object itself and the namespace scoped resources matching any of the selectors
+	// are selected, e.g., to leave out the test pods or secrets in the namespace of the workload.
+	// In a ClusterResourceOverride, the override applies to the namespace scoped resources matching any of the
+	// selectors in the selected namespaces, including the ones wrapped in the envelope objects.
+	// You can have 0-20 selectors.
+	// +optional
+	NamespacedResourceSelectors []NamespacedResourceSelector `json:"namespacedResourceSelectors,omitempty"`
 }
 
+// SelectionScope defines the scope of the resources selected with a namespace.
+// +enum
+type SelectionScope string
+
+const (
+	// NamespaceWithResources means that the namespace object and the resources in the namespace are selected.
+	NamespaceWithResources SelectionScope = "NamespaceWithResources"
+
+	// NamespaceOnly means that only the namespace object itself is selected.
+	NamespaceOnly SelectionScope = "NamespaceOnly"
+)
+
 // PlacementPolicy contains the rules to select target member clusters to place the selected resources.
 // Note that only clusters that are both joined and satisfying the rules will be selected.
 //
@@ -168,6 +215,42 @@ type PlacementPolicy struct {
 	// +patchMergeKey=topologyKey
 	// +patchStrategy=merge
 	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey"`
+
+	// If specified, the ClusterResourcePlacement's Tolerations.
+	// Tolerations allow the scheduler to place resources onto member clusters with matching taints.
+	// Tolerations are ignored if the placement type is "PickFixed".
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+}
+
+// Toleration allows ClusterResourcePlacement to tolerate any taint that matches
+// the triple <key,value,effect> using the matching operator <operator>.
+type Toleration struct {
+	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
+	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Operator represents a key's relationship to the value.
+	// Valid operators are Exists and Equal. Defaults to Equal.
+	// Exists is equivalent to wildcard for value, so that a
+	// ClusterResourcePlacement can tolerate all taints of a particular category.
+	// +kubebuilder:default=Equal
+	// +kubebuilder:validation:Enum=Equal;Exists
+	// +optional
+	Operator corev1.TolerationOperator `json:"operator,omitempty"`
+
+	// Value is the taint value the toleration matches to.
+	// If the operator is Exists, the value should be empty, otherwise just a regular string.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect indicates the taint effect to match. Empty means match all taint effects.
+	// When specified, only allowed value is NoSchedule.
+	// +kubebuilder:validation:Enum=NoSchedule
+	// +optional
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
 }
 
 // Affinity is a group of cluster affinity scheduling rules. More to be added.
@@ -219,12 +302,116 @@ type PreferredClusterSelector struct {
 }
 
 // ClusterSelectorTerm contains the requirements to select clusters.
+// If both the label selector and the property selector are specified, a cluster must match
+// both of them to be selected. The property sorter, if specified, does not select clusters;
+// it only decides how the matching clusters are weighted.
 type ClusterSelectorTerm struct {
 	// LabelSelector is a label query over all the joined member clusters. Clusters matching the query are selected.
-	// +required
+	// +optional
 	LabelSelector metav1.LabelSelector `json:"labelSelector"`
+
+	// PropertySelector is a property query over all the joined member clusters. Clusters matching the query are selected.
+	// The properties are read from the status of the member clusters.
+	// +optional
+	PropertySelector *PropertySelector `json:"propertySelector,omitempty"`
+
+	// PropertySorter sorts the clusters matching the term by a specific property, and assigns each
+	// cluster a share of the preference weight in proportion to its observed value of the property,
+	// normalized across all the matching clusters.
+	//
+	// This field is only applicable to preferred cluster selectors; it is not allowed in required
+	// cluster selectors.
+	// +optional
+	PropertySorter *PropertySorter `json:"propertySorter,omitempty"`
+}
+
+// PropertySorter helps user specify how to sort clusters by a specific property.
+type PropertySorter struct {
+	// Name is the name of the property; it should be a Kubernetes label name.
+	// The observed values of the property should be valid Kubernetes quantities.
+	// Clusters that do not report the property receive no weight.
+	// +required
+	Name string `json:"name"`
+
+	// SortOrder explains how Fleet should sort the clusters by the property.
+	//
+	// With the Descending order, the cluster with the largest observed value receives the full
+	// weight, and the cluster with the smallest observed value receives no weight; with the
+	// Ascending order, it is the other way around. Clusters in between receive a share of the
+	// weight proportional to their observed values.
+	// +kubebuilder:validation:Enum=Descending;Ascending
+	// +required
+	SortOrder PropertySortOrder `json:"sortOrder"`
+}
+
+// PropertySortOrder is the order in which clusters are sorted by a specific property.
+type PropertySortOrder string
+
+const (
+	// Descending sorts clusters by a property in descending order; clusters with larger observed
+	// values are preferred.
+	Descending PropertySortOrder = "Descending"
+	// Ascending sorts clusters by a property in ascending order; clusters with smaller observed
+	// values are preferred.
+	Ascending PropertySortOrder = "Ascending"
+)
+
+// PropertySelector helps user specify property requirements when picking clusters for resource placement.
+type PropertySelector struct {
+	// MatchExpressions is an array of PropertySelectorRequirements. The requirements are `ANDed`.
+	// +kubebuilder:validation:MaxItems=20
+	// +required
+	MatchExpressions []PropertySelectorRequirement `json:"matchExpressions"`
+}
+
+// PropertySelectorRequirement is a specific property requirement when picking clusters for resource placement.
+type PropertySelectorRequirement struct {
+	// Name is the name of the property; it should be a Kubernetes label name.
+	// Clusters that do not report the property do not match the requirement.
+	// +required
+	Name string `json:"name"`
+
+	// Operator specifies the relationship between a cluster's observed value of the specified property
+	// and the value given in the requirement.
+	// +kubebuilder:validation:Enum=Gt;Ge;Eq;Ne;Lt;Le
+	// +required
+	Operator PropertySelectorOperator `json:"operator"`
+
+	// Values are a list of values of the specified property which Fleet will compare against
+	// the observed values of individual member clusters in accordance with the given operator.
+	//
+	// At this moment, exactly one value is required; it should be a valid Kubernetes quantity,
+	// or a version string (e.g., 1.28.3) for the Kubernetes version property.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	// +required
+	Values []string `json:"values"`
 }
 
+// PropertySelectorOperator is the operator that can be used with PropertySelectorRequirements.
+type PropertySelectorOperator string
+
+const (
+	// PropertySelectorGreaterThan dictates Fleet to select a cluster if its observed value of a given
+	// property is greater than the value specified in the requirement.
+	PropertySelectorGreaterThan PropertySelectorOperator = "Gt"
+	// PropertySelectorGreaterThanOrEqualTo dictates Fleet to select a cluster if its observed value
+	// of a given property is greater than or equal to the value specified in the requirement.
+	PropertySelectorGreaterThanOrEqualTo PropertySelectorOperator = "Ge"
+	// PropertySelectorEqualTo dictates Fleet to select a cluster if its observed value of a given
+	// property is equal to the value specified in the requirement.
+	PropertySelectorEqualTo PropertySelectorOperator = "Eq"
+	// PropertySelectorNotEqualTo dictates Fleet to select a cluster if its observed value of a given
+	// property is not equal to the value specified in the requirement.
+	PropertySelectorNotEqualTo PropertySelectorOperator = "Ne"
+	// PropertySelectorLessThan dictates Fleet to select a cluster if its observed value of a given
+	// property is less than the value specified in the requirement.
+	PropertySelectorLessThan PropertySelectorOperator = "Lt"
+	// PropertySelectorLessThanOrEqualTo dictates Fleet to select a cluster if its observed value of a
+	// given property is less than or equal to the value specified in the requirement.
+	PropertySelectorLessThanOrEqualTo PropertySelectorOperator = "Le"
+)
+
 // TopologySpreadConstraint specifies how to spread resources among the given cluster topology.
 type TopologySpreadConstraint struct {
 	// MaxSkew describes the degree to which resources may be unevenly distributed.
@@ -273,17 +460,268 @@ const (
 
 // RolloutStrategy describes how to roll out a new change in selected resources to target clusters.
 type RolloutStrategy struct {
-	// Type of rollout. The only supported type is "RollingUpdate". Default is "RollingUpdate".
+	// Type of rollout. The supported types are "RollingUpdate" and "StagedUpdate". Default is "RollingUpdate".
 	// +optional
-	// +kubebuilder:validation:Enum=RollingUpdate
+	// +kubebuilder:validation:Enum=RollingUpdate;StagedUpdate
 	// +kubebuilder:default=RollingUpdate
 	Type RolloutStrategyType `json:"type,omitempty"`
 
-	// Rolling update config params. Present only if RolloutStrategyType = RollingUpdate.
+	// Rolling update config params. Present if RolloutStrategyType = RollingUpdate or StagedUpdate.
+	// With the staged update, it limits how many clusters in the same stage are updated at the same time.
 	// +optional
 	RollingUpdate *RollingUpdateConfig `json:"rollingUpdate,omitempty"`
+
+	// Staged update config params. Present only if RolloutStrategyType = StagedUpdate.
+	// +optional
+	StagedUpdate *StagedUpdateStrategy `json:"stagedUpdate,omitempty"`
+
+	// ApplyStrategy describes how the member agent applies the selected resources to the target clusters
+	// and how it handles the drifts of the placed resources.
+	// +optional
+	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+
+	// DeletionPolicy describes what the member agent does with the placed resources when they are no longer placed
+	// on a target cluster, i.e., when the cluster is unselected, the placement is deleted or the cluster leaves the fleet.
+	// If it is not set, the resources are deleted unless the cluster leaves the fleet.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DryRunBeforeRollout asks the member agents to validate the new resources against the target clusters with
+	// server-side dry-run requests before the resources are rolled out to them. The rollout does not advance to
+	// the target clusters which reject the new resources. Default is false.
+	// +optional
+	DryRunBeforeRollout bool `json:"dryRunBeforeRollout,omitempty"`
+
+	// Paused stops the rollout in progress, i.e. the resources placed on the target clusters are neither updated nor
+	// removed, and the newly selected clusters do not get the resources until the rollout is resumed. The clusters are
+	// still scheduled, the new resource snapshots are still created, and the dry-runs and the staged update status are
+	// still kept up to date, so that the resources are rolled out once the rollout is resumed. Default is false.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// RolloutGates are the application level checks which the member agent runs on a target cluster once the
+	// placed resources are available there. A target cluster is not counted as ready by the rollout until all the
+	// gates pass, so a failing gate blocks further updates the same way as an unavailable cluster does. The placement
+	// can be rolled back by pinning it to a previous resource index.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	RolloutGates []RolloutGate `json:"rolloutGates,omitempty"`
+}
+
+// RolloutGate describes an application level check run by the member agent on a target cluster.
+type RolloutGate struct {
+	// Name is the name of the gate, which must be unique among the gates of the placement.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type of the gate. The supported types are "HTTPProbe" and "MetricsQuery".
+	// +kubebuilder:validation:Enum=HTTPProbe;MetricsQuery
+	// +kubebuilder:validation:Required
+	Type RolloutGateType `json:"type"`
+
+	// HTTPProbe config params. Present only if Type = HTTPProbe.
+	// +optional
+	HTTPProbe *HTTPProbeGate `json:"httpProbe,omitempty"`
+
+	// MetricsQuery config params. Present only if Type = MetricsQuery.
+	// +optional
+	MetricsQuery *MetricsQueryGate `json:"metricsQuery,omitempty"`
+
+	// TimeoutSeconds is the number of seconds after which a single check of the gate times out. Defaults to 10.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// RolloutGateType describes the type of the rollout gate.
+// +enum
+type RolloutGateType string
+
+const (
+	// RolloutGateTypeHTTPProbe checks the gate with an HTTP GET request.
+	RolloutGateTypeHTTPProbe RolloutGateType = "HTTPProbe"
+
+	// RolloutGateTypeMetricsQuery checks the gate with a query against a Prometheus compatible metrics server.
+	RolloutGateTypeMetricsQuery RolloutGateType = "MetricsQuery"
+)
+
+// HTTPProbeGate describes a gate which passes if an HTTP GET request succeeds.
+type HTTPProbeGate struct {
+	// URL is the address which the member agent sends the HTTP GET request to, e.g.
+	// http://my-service.my-namespace.svc.cluster.local:8080/healthz. It must be an http or https URL reachable from
+	// the member agent. The gate passes if the response status code is greater than or equal to 200 and less than 400;
+	// redirects are not followed.
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+}
+
+// MetricsQueryGate describes a gate which passes if the result of a metrics query stays under a threshold.
+type MetricsQueryGate struct {
+	// Address is the address of the Prometheus compatible metrics server which the member agent sends the query to,
+	// e.g. http://prometheus.monitoring.svc.cluster.local:9090. It must be an http or https URL reachable from the
+	// member agent; redirects are not followed.
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Query is the PromQL instant query, e.g. the error rate of the requests served by the placed application.
+	// It must return a scalar or a vector with at least one sample.
+	// +kubebuilder:validation:Required
+	Query string `json:"query"`
+
+	// Threshold is the maximum value of the samples returned by the query. The gate fails if any of them exceeds it.
+	// +kubebuilder:validation:Required
+	Threshold resource.Quantity `json:"threshold"`
+}
+
+// ApplyStrategy describes how the member agent applies the resources to the target cluster.
+type ApplyStrategy struct {
+	// Type defines the type of strategy to use. Default to ClientSideApply.
+	// Available options are:
+	// - ClientSideApply: the member agent applies the resources with a three-way merge patch, like `kubectl apply` does.
+	//   If the last applied configuration of a resource is too large to be kept in its annotation, the member agent
+	//   falls back to server-side apply for that resource, which forces the conflicts only if ServerSideApplyConfig
+	//   asks for it.
+	// - ServerSideApply: the member agent applies the resources with server-side apply.
+	// - ReportDiff: the member agent never changes the resources on the target cluster; it only compares the resources
+	//   on the target cluster with the ones from the hub cluster and reports the differences in the status of the work.
+	// +kubebuilder:default=ClientSideApply
+	// +kubebuilder:validation:Enum=ClientSideApply;ServerSideApply;ReportDiff
+	// +optional
+	Type ApplyStrategyType `json:"type,omitempty"`
+
+	// ServerSideApplyConfig defines the configuration for server-side apply. It is honored only when type is
+	// ServerSideApply, or when type is ClientSideApply and the member agent falls back to server-side apply.
+	// +optional
+	ServerSideApplyConfig *ServerSideApplyConfig `json:"serverSideApplyConfig,omitempty"`
+
+	// WhenToApply determines how the member agent handles the drifts of the placed resources, i.e., the changes made
+	// directly on the target cluster to the fields that the placed resources specify.
+	// Available options are:
+	// - Always: the member agent overwrites the drifts with the resources from the hub cluster.
+	// - IfNotDrifted: the member agent only reports the drifts in the status of the work and leaves the drifted
+	//   resources as they are until the drifts are removed on the target cluster or the resources are changed on
+	//   the hub cluster.
+	// Default is Always.
+	// +kubebuilder:default=Always
+	// +kubebuilder:validation:Enum=Always;IfNotDrifted
+	// +optional
+	WhenToApply WhenToApplyType `json:"whenToApply,omitempty"`
+
+	// WhenToTakeOver determines how the member agent handles the resources which already exist on the target cluster
+	// but are not placed by Fleet.
+	// Available options are:
+	// - Always: the member agent takes over such resources and overwrites them with the resources from the hub cluster.
+	// - IfNoDiff: the member agent takes over such resources only if the fields specified in the resources from the
+	//   hub cluster have the same values on the target cluster; otherwise, it reports the differences in the status of
+	//   the work and leaves the resources as they are.
+	// - Never: the member agent never takes over such resources and fails to apply them.
+	// Default is Never.
+	// +kubebuilder:default=Never
+	// +kubebuilder:validation:Enum=Always;IfNoDiff;Never
+	// +optional
+	WhenToTakeOver WhenToTakeOverType `json:"whenToTakeOver,omitempty"`
+
+	// ReportBackStatus, if set, instructs the member agent to report the status of each applied resource on the
+	// target cluster back to the hub cluster, where it is surfaced per cluster in the status of the placement.
+	// The status of a resource is not reported back if it is larger than 4KiB.
+	// Default is false.
+	// +optional
+	ReportBackStatus bool `json:"reportBackStatus,omitempty"`
+}
+
+// ApplyStrategyType describes the type of the strategy used to apply the resources to the target cluster.
+// +enum
+type ApplyStrategyType string
+
+const (
+	// ApplyStrategyTypeClientSideApply applies the resources with a three-way merge patch.
+	ApplyStrategyTypeClientSideApply ApplyStrategyType = "ClientSideApply"
+
+	// ApplyStrategyTypeServerSideApply applies the resources with server-side apply.
+	ApplyStrategyTypeServerSideApply ApplyStrategyType = "ServerSideApply"
+
+	// ApplyStrategyTypeReportDiff only reports the differences between the resources on the target cluster and the
+	// ones from the hub cluster without applying them.
+	ApplyStrategyTypeReportDiff ApplyStrategyType = "ReportDiff"
+)
+
+// ServerSideApplyConfig defines the configuration for server-side apply.
+// Details: https://kubernetes.io/docs/reference/using-api/server-side-apply/#conflicts
+type ServerSideApplyConfig struct {
+	// ForceConflicts forces the member agent to take the ownership of the fields which are also managed by other
+	// field managers on the target cluster. If it is false, the apply fails when there are such conflicts.
+	// +optional
+	ForceConflicts bool `json:"force,omitempty"`
+}
+
+// WhenToApplyType describes how the member agent handles the drifts of the placed resources.
+// +enum
+type WhenToApplyType string
+
+const (
+	// WhenToApplyTypeAlways instructs the member agent to overwrite the drifts of the placed resources.
+	WhenToApplyTypeAlways WhenToApplyType = "Always"
+
+	// WhenToApplyTypeIfNotDrifted instructs the member agent to only report the drifts of the placed resources.
+	WhenToApplyTypeIfNotDrifted WhenToApplyType = "IfNotDrifted"
+)
+
+// WhenToTakeOverType describes how the member agent handles the resources which already exist on the target cluster
+// but are not placed by Fleet.
+// +enum
+type WhenToTakeOverType string
+
+const (
+	// WhenToTakeOverTypeAlways instructs the member agent to always take over the existing resources.
+	WhenToTakeOverTypeAlways WhenToTakeOverType = "Always"
+
+	// WhenToTakeOverTypeIfNoDiff instructs the member agent to take over the existing resources only if they have no
+	// differences from the resources from the hub cluster.
+	WhenToTakeOverTypeIfNoDiff WhenToTakeOverType = "IfNoDiff"
+
+	// WhenToTakeOverTypeNever instructs the member agent to never take over the existing resources.
+	WhenToTakeOverTypeNever WhenToTakeOverType = "Never"
+)
+
+// DeletionPolicy describes what the member agent does with the placed resources when they are no longer placed on the
+// target cluster.
+type DeletionPolicy struct {
+	// Type defines the type of deletion policy. Default to Delete.
+	// Available options are:
+	// - Delete: the member agent deletes the resources from the target cluster.
+	// - Orphan: the member agent stops managing the resources and leaves them on the target cluster.
+	// - Retain: the member agent keeps the resources on the target cluster for the retain grace period and deletes
+	//   them afterwards. This also applies to the resources removed from the placement and to the resources left on
+	//   the cluster when it leaves the fleet.
+	// +kubebuilder:default=Delete
+	// +kubebuilder:validation:Enum=Delete;Orphan;Retain
+	// +optional
+	Type DeletionPolicyType `json:"type,omitempty"`
+
+	// RetainGracePeriodSeconds is the number of seconds for which the member agent keeps the resources on the target
+	// cluster before deleting them. It is required and honored only when type is Retain.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetainGracePeriodSeconds *int32 `json:"retainGracePeriodSeconds,omitempty"`
 }
 
+// DeletionPolicyType describes what the member agent does with the placed resources when they are no longer placed.
+// +enum
+type DeletionPolicyType string
+
+const (
+	// DeletionPolicyTypeDelete instructs the member agent to delete the resources.
+	DeletionPolicyTypeDelete DeletionPolicyType = "Delete"
+
+	// DeletionPolicyTypeOrphan instructs the member agent to leave the resources on the cluster unmanaged.
+	DeletionPolicyTypeOrphan DeletionPolicyType = "Orphan"
+
+	// DeletionPolicyTypeRetain instructs the member agent to delete the resources after a grace period.
+	DeletionPolicyTypeRetain DeletionPolicyType = "Retain"
+)
+
 // +enum
 type RolloutStrategyType string
 
@@ -291,6 +729,10 @@ const (
 	// RollingUpdateRolloutStrategyType replaces the old placed resource using rolling update
 	// i.e. gradually create the new one while replace the old ones.
 	RollingUpdateRolloutStrategyType RolloutStrategyType = "RollingUpdate"
+
+	// StagedUpdateRolloutStrategyType rolls out the new resources to the target clusters stage by stage, i.e. the
+	// clusters in a stage are updated only after all the clusters in the previous stages are updated and available.
+	StagedUpdateRolloutStrategyType RolloutStrategyType = "StagedUpdate"
 )
 
 // RollingUpdateConfig contains the config to control the desired behavior of rolling update.
@@ -326,14 +768,114 @@ type RollingUpdateConfig struct {
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 
 	// UnavailablePeriodSeconds is used to config the time to wait between rolling out phases.
-	// A resource placement is considered available after `UnavailablePeriodSeconds` seconds
-	// has passed after the resources are applied to the target cluster successfully.
+	// A resource placement is considered available once all of its resources are available on the target cluster.
+	// For the resources whose availability cannot be tracked, the resource placement is considered available after
+	// `UnavailablePeriodSeconds` seconds has passed after the resources are applied to the target cluster successfully.
 	// Default is 60.
 	// +kubebuilder:default=60
 	// +optional
 	UnavailablePeriodSeconds *int `json:"unavailablePeriodSeconds,omitempty"`
 }
 
+// StagedUpdateStrategy contains the config to control the desired behavior of the staged update.
+// The stages only gate when the target clusters which already have the resources placed are updated to a new resource
+// snapshot. The newly selected clusters get the latest resources right away, and the clusters not selected by any of
+// the stages are updated after all the stages complete.
+type StagedUpdateStrategy struct {
+	// Stages are the ordered stages the new resources are rolled out through.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=31
+	// +required
+	Stages []StageConfig `json:"stages"`
+}
+
+// StageConfig describes a stage of the staged update.
+type StageConfig struct {
+	// Name is the name of the stage. It must be unique among the stages.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +required
+	Name string `json:"name"`
+
+	// LabelSelector selects the member clusters in the stage by their labels. An empty label selector selects all
+	// the clusters. A cluster selected by multiple stages belongs to the first one of them.
+	// +required
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+
+	// WaitTime is the time to wait after all the clusters in the stage are updated and available before moving
+	// to the next stage.
+	// +optional
+	WaitTime *metav1.Duration `json:"waitTime,omitempty"`
+
+	// RequireApproval asks for a manual approval after all the clusters in the stage are updated and available
+	// before moving to the next stage. The stage is approved by creating a ClusterStageApproval which names the stage
+	// and the master resource snapshot being rolled out, so that an approval never carries over to a newer resource
+	// snapshot. Default is false.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+}
+
+// StagedUpdateStatus records the progress of the staged update of a resource snapshot.
+type StagedUpdateStatus struct {
+	// ResourceSnapshotName is the name of the master resource snapshot rolled out by the stages.
+	// +required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+
+	// CurrentStage is the name of the stage whose clusters are being updated or which is waiting to complete.
+	// It is empty once all the stages complete.
+	// +optional
+	CurrentStage string `json:"currentStage,omitempty"`
+
+	// StagesStatus lists the status of each stage in order.
+	// +optional
+	StagesStatus []StageUpdatingStatus `json:"stagesStatus,omitempty"`
+}
+
+// StageUpdatingStatus records the progress of a stage of the staged update.
+type StageUpdatingStatus struct {
+	// StageName is the name of the stage.
+	// +required
+	StageName string `json:"stageName"`
+
+	// Clusters are the names of the target clusters in the stage.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// StartTime is the time when the clusters in the stage started to be updated.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time when all the clusters in the stage were updated and available.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+
+	// Conditions is an array of current observed conditions of the stage.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// StageUpdatingConditionType identifies a specific condition of a stage of the staged update.
+// +enum
+type StageUpdatingConditionType string
+
+const (
+	// StageUpdatingConditionProgressing indicates whether the stage is making progress.
+	// Its condition status can be one of the following:
+	// - "True" means the clusters in the stage are being updated.
+	// - "False" means the stage is waiting for the wait time to pass or for the approval.
+	StageUpdatingConditionProgressing StageUpdatingConditionType = "Progressing"
+
+	// StageUpdatingConditionSucceeded indicates whether the stage completes.
+	// Its condition status can be one of the following:
+	// - "True" means all the clusters in the stage are updated and available, and the stage is not waiting anymore.
+	StageUpdatingConditionSucceeded StageUpdatingConditionType = "Succeeded"
+)
+
 // ClusterResourcePlacementStatus defines the observed state of the ClusterResourcePlacement object.
 type ClusterResourcePlacementStatus struct {
 	// SelectedResources contains a list of resources selected by ResourceSelectors.
@@ -409,7 +951,7 @@ type EnvelopeIdentifier struct {
 	Namespace string `json:"namespace,omitempty"`
 
 	// Type of the envelope object.
-	// +kubebuilder:validation:Enum=ConfigMap
+	// +kubebuilder:validation:Enum=ConfigMap;Secret;ClusterResourceEnvelope;ResourceEnvelope
 	// +kubebuilder:default=ConfigMap
 	// +optional
 	Type EnvelopeType `json:"type"`
@@ -422,6 +964,15 @@ type EnvelopeType string
 const (
 	// ConfigMapEnvelopeType means the envelope object is of type `ConfigMap`.
 	ConfigMapEnvelopeType EnvelopeType = "ConfigMap"
+
+	// SecretEnvelopeType means the envelope object is of type `Secret`.
+	SecretEnvelopeType EnvelopeType = "Secret"
+
+	// ClusterResourceEnvelopeType means the envelope object is of type `ClusterResourceEnvelope`.
+	ClusterResourceEnvelopeType EnvelopeType = "ClusterResourceEnvelope"
+
+	// ResourceEnvelopeType means the envelope object is of type `ResourceEnvelope`.
+	ResourceEnvelopeType EnvelopeType = "ResourceEnvelope"
 )
 
 // ResourcePlacementStatus represents the placement status of selected resources for one target cluster.
@@ -439,6 +990,15 @@ type ResourcePlacementStatus struct {
 	// +optional
 	FailedPlacements []FailedResourcePlacement `json:"failedPlacements,omitempty"`
 
+	// +kubebuilder:validation:MaxItems=100
+
+	// BackReportedStatuses is a list of the statuses of the resources placed on the given cluster, which are reported
+	// back by the member agent when the apply strategy asks for it.
+	// Note that we only include 100 back reported statuses, up to 64KiB in total, even if there are more.
+	// This field is only meaningful if the `ClusterName` is not empty.
+	// +optional
+	BackReportedStatuses []BackReportedResourceStatus `json:"backReportedStatuses,omitempty"`
+
 	// Conditions is an array of current observed conditions for ResourcePlacementStatus.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -455,6 +1015,17 @@ type FailedResourcePlacement struct {
 	Condition metav1.Condition `json:"condition"`
 }
 
+// BackReportedResourceStatus contains the status of a resource placed on a cluster.
+type BackReportedResourceStatus struct {
+	// The placed resource.
+	// +required
+	ResourceIdentifier `json:",inline"`
+
+	// The status of the resource on the cluster reported back by the member agent.
+	// +required
+	BackReportedStatus BackReportedStatus `json:"backReportedStatus"`
+}
+
 // ClusterResourcePlacementConditionType defines a specific condition of a cluster resource placement.
 // +enum
 type ClusterResourcePlacementConditionType string
@@ -485,6 +1056,21 @@ const (
 	// - "False" means some of them have failed. We will place some of the detailed failure in the FailedResourcePlacement array.
 	// - "Unknown" means we haven't finished the apply yet.
 	ClusterResourcePlacementAppliedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementApplied"
+
+	// ClusterResourcePlacementRolloutPausedConditionType indicates whether the rollout of the ClusterResourcePlacement
+	// is paused.
+	// Its condition status can be one of the following:
+	// - "True" means the rollout is paused and the bindings are not updated until it is resumed.
+	// The condition is removed once the rollout is resumed.
+	ClusterResourcePlacementRolloutPausedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementRolloutPaused"
+
+	// ClusterResourcePlacementRolloutStartedConditionType indicates whether the rollout of the selected resources
+	// to the target clusters has started.
+	// Its condition status can be one of the following:
+	// - "True" means the bindings are being rolled to the latest resource snapshot, or to the pinned one.
+	// - "False" means the rollout cannot start, e.g., the resource snapshot which the placement is pinned to does not
+	// exist. We will fill the Reason field.
+	ClusterResourcePlacementRolloutStartedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementRolloutStarted"
 )
 
 // ResourcePlacementConditionType defines a specific condition of a resource placement.
@@ -11,6 +11,17 @@ const (
 	ClusterResourceBindingKind          = "ClusterResourceBinding"
 	ClusterResourceSnapshotKind         = "ClusterResourceSnapshot"
 	ClusterSchedulingPolicySnapshotKind = "ClusterSchedulingPolicySnapshot"
+	ClusterResourceOverrideKind         = "ClusterResourceOverride"
+	ClusterResourceOverrideResource     = "clusterresourceoverrides"
+	ClusterResourceOverrideSnapshotKind = "ClusterResourceOverrideSnapshot"
+	ResourceOverrideKind                = "ResourceOverride"
+	ResourceOverrideSnapshotKind        = "ResourceOverrideSnapshot"
+	ResourcePlacementKind               = "ResourcePlacement"
+	ResourcePlacementResource           = "resourceplacements"
+	ClusterResourceEnvelopeKind         = "ClusterResourceEnvelope"
+	ResourceEnvelopeKind                = "ResourceEnvelope"
+	ClusterStagedUpdateRunKind          = "ClusterStagedUpdateRun"
+	ClusterStageApprovalKind            = "ClusterStageApproval"
 	WorkKind                            = "Work"
 	AppliedWorkKind                     = "AppliedWork"
 )
@@ -32,6 +43,16 @@ const (
 	// CRPTrackingLabel is the label that points to the cluster resource policy that creates a resource binding.
 	CRPTrackingLabel = fleetPrefix + "parent-CRP"
 
+	// ResourcePlacementNamespaceLabel is the label that points to the namespace of the resource placement that creates a
+	// cluster resource placement, as the owner reference to the resource placement does not carry its namespace.
+	// The cluster resource placement selects the namespace scoped resources in the namespace only if it's controlled by
+	// the resource placement.
+	ResourcePlacementNamespaceLabel = fleetPrefix + "parent-resource-placement-namespace"
+
+	// ResourcePlacementNameLabel is the label that points to the name of the resource placement that creates a cluster
+	// resource placement.
+	ResourcePlacementNameLabel = fleetPrefix + "parent-resource-placement-name"
+
 	// ResourceSnapshotTrackingLabel is the label that points to the cluster resource snapshot that this work is generated from.
 	ResourceSnapshotTrackingLabel = fleetPrefix + "parent-resource-snapshot"
 
@@ -53,6 +74,23 @@ const (
 	// The format is {workPrefix}-configMap-uuid
 	WorkNameWithConfigEnvelopeFmt = "%s-configmap-%s"
 
+	// WorkNameWithSecretEnvelopeFmt is the format of the name of a work generated with secret envelop.
+	// The format is {workPrefix}-secret-uuid
+	WorkNameWithSecretEnvelopeFmt = "%s-secret-%s"
+
+	// WorkNameWithResourceEnvelopeFmt is the format of the name of a work generated with ClusterResourceEnvelope or
+	// ResourceEnvelope.
+	// The format is {workPrefix}-envelope-uuid
+	WorkNameWithResourceEnvelopeFmt = "%s-envelope-%s"
+
+	// DryRunWorkNameFmt is the format of the name of the dry-run work generated for a binding.
+	// The name of the dry-run work is {crpName}-dryrun.
+	DryRunWorkNameFmt = "%s-dryrun"
+
+	// RolloutGateWorkNameFmt is the format of the name of the work carrying the rollout gates of a binding.
+	// The name of the rollout gate work is {crpName}-rolloutgate.
+	RolloutGateWorkNameFmt = "%s-rolloutgate"
+
 	// ParentResourceSnapshotIndexLabel is the label applied to work that contains the index of the resource snapshot that generates the work.
 	ParentResourceSnapshotIndexLabel = fleetPrefix + "parent-resource-snapshot-index"
 
@@ -67,6 +105,12 @@ const (
 	// we need to apply to the member cluster instead of the configMap itself.
 	EnvelopeConfigMapAnnotation = fleetPrefix + "envelope-configmap"
 
+	// EnvelopeSecretAnnotation is the annotation that indicates the secret is an envelope secret that contains resources
+	// we need to apply to the member cluster instead of the secret itself.
+	// Note that the wrapped resources are decoded and placed as plain manifests in the works, so the envelope secret
+	// does not keep them confidential from the users who can read the works.
+	EnvelopeSecretAnnotation = fleetPrefix + "envelope-secret"
+
 	// EnvelopeTypeLabel is the label that marks the work object as generated from an envelope object.
 	// The value of the annotation is the type of the envelope object.
 	EnvelopeTypeLabel = fleetPrefix + "envelope-work"
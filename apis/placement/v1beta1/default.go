@@ -21,7 +21,8 @@ func SetDefaultsClusterResourcePlacement(obj *ClusterResourcePlacement) {
 	if strategy.Type == "" {
 		strategy.Type = RollingUpdateRolloutStrategyType
 	}
-	if strategy.Type == RollingUpdateRolloutStrategyType {
+	// The staged update limits the clusters updated at the same time in a stage with the rolling update config.
+	if strategy.Type == RollingUpdateRolloutStrategyType || strategy.Type == StagedUpdateRolloutStrategyType {
 		if strategy.RollingUpdate == nil {
 			strategy.RollingUpdate = &RollingUpdateConfig{}
 		}
@@ -38,6 +39,12 @@ func SetDefaultsClusterResourcePlacement(obj *ClusterResourcePlacement) {
 			strategy.RollingUpdate.UnavailablePeriodSeconds = &unavailablePeriodSeconds
 		}
 	}
+	for i := range strategy.RolloutGates {
+		if strategy.RolloutGates[i].TimeoutSeconds == nil {
+			timeoutSeconds := DefaultRolloutGateTimeoutSeconds
+			strategy.RolloutGates[i].TimeoutSeconds = &timeoutSeconds
+		}
+	}
 	if obj.Spec.RevisionHistoryLimit == nil {
 		obj.Spec.RevisionHistoryLimit = new(int32)
 		*obj.Spec.RevisionHistoryLimit = RevisionHistoryLimitDefaultValue
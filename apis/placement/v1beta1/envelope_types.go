@@ -0,0 +1,76 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=cre,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceEnvelope wraps resources which should be applied to the member clusters instead of the hub cluster,
+// e.g., a validating webhook configuration which would otherwise affect the hub cluster.
+// The ClusterResourceEnvelope itself is not applied to the member clusters when it's selected by a placement; the
+// wrapped resources are applied instead.
+type ClusterResourceEnvelope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Data contains the wrapped resources keyed by a name of the caller's choosing, e.g., the file name of the manifest.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=100
+	// +required
+	Data map[string]Manifest `json:"data"`
+}
+
+// ClusterResourceEnvelopeList contains a list of ClusterResourceEnvelope.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterResourceEnvelopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterResourceEnvelope `json:"items"`
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=re,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceEnvelope wraps resources which should be applied to the member clusters instead of the hub cluster,
+// e.g., a deployment whose pods should not run on the hub cluster.
+// The ResourceEnvelope itself is not applied to the member clusters when it's selected by a placement; the
+// wrapped resources are applied instead.
+type ResourceEnvelope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Data contains the wrapped resources keyed by a name of the caller's choosing, e.g., the file name of the manifest.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=100
+	// +required
+	Data map[string]Manifest `json:"data"`
+}
+
+// ResourceEnvelopeList contains a list of ResourceEnvelope.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceEnvelopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceEnvelope `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterResourceEnvelope{}, &ClusterResourceEnvelopeList{}, &ResourceEnvelope{}, &ResourceEnvelopeList{})
+}
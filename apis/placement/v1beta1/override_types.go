@@ -0,0 +1,245 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1beta1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=cro,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceOverride defines a group of override policies about how to override the selected cluster scope resources,
+// and the namespace scoped resources selected in the selected namespaces, to target clusters.
+//
+// The work generator applies the override policies when it generates the work objects for a target cluster, so that
+// the same resource selected by a ClusterResourcePlacement can have different content on different member clusters.
+type ClusterResourceOverride struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ClusterResourceOverride.
+	// +required
+	Spec ClusterResourceOverrideSpec `json:"spec"`
+}
+
+// ClusterResourceOverrideSpec defines the desired state of the ClusterResourceOverride.
+type ClusterResourceOverrideSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+
+	// ClusterResourceSelectors is an array of selectors used to select cluster scoped resources. The selectors are `ORed`.
+	// If a namespace is selected, ONLY the namespace object itself is overridden, unless the NamespacedResourceSelectors
+	// is specified to select the resources under the namespace as well.
+	// You can have 1-20 selectors.
+	// +required
+	ClusterResourceSelectors []ClusterResourceSelector `json:"clusterResourceSelectors"`
+
+	// Policy defines how to override the selected resources on the target clusters.
+	// +required
+	Policy *OverridePolicy `json:"policy"`
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=ro,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceOverride defines a group of override policies about how to override the selected namespaced scope resources
+// to target clusters.
+//
+// Unlike ClusterResourceOverride, it can only select the resources in the same namespace as the ResourceOverride object,
+// so that the application teams can own the overrides of their resources without the cluster-admin permission.
+type ResourceOverride struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ResourceOverride.
+	// +required
+	Spec ResourceOverrideSpec `json:"spec"`
+}
+
+// ResourceOverrideSpec defines the desired state of the ResourceOverride.
+type ResourceOverrideSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+
+	// ResourceSelectors is an array of selectors used to select namespace scoped resources. The selectors are `ORed`.
+	// You can have 1-20 selectors.
+	// +required
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors"`
+
+	// Policy defines how to override the selected resources on the target clusters.
+	// +required
+	Policy *OverridePolicy `json:"policy"`
+}
+
+// ResourceSelector is used to select namespace scoped resources as the target resources to be overridden.
+// The resource must be in the same namespace as the ResourceOverride object.
+type ResourceSelector struct {
+	// Group name of the namespace-scoped resource.
+	// Use an empty string to select resources under the core API group (e.g., services).
+	// +required
+	Group string `json:"group"`
+
+	// Version of the namespace-scoped resource.
+	// +required
+	Version string `json:"version"`
+
+	// Kind of the namespace-scoped resource.
+	// +required
+	Kind string `json:"kind"`
+
+	// Name of the namespace-scoped resource.
+	// +required
+	Name string `json:"name"`
+}
+
+// OverridePolicy defines how to override the selected resources on the target clusters.
+type OverridePolicy struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+
+	// OverrideRules defines an array of override rules to be applied on the selected resources.
+	// The order of the rules determines the override order.
+	// When there are two rules selecting the same fields on the target cluster, the last one will win.
+	// You can have 1-20 rules.
+	// +required
+	OverrideRules []OverrideRule `json:"overrideRules"`
+}
+
+// OverrideRule defines how to override the selected resources on the target clusters.
+type OverrideRule struct {
+	// ClusterSelector selects the target clusters.
+	// The resources will be overridden before applying to the matching clusters.
+	// If ClusterSelector is not set, it means selecting ALL the member clusters.
+	// Only the label selectors of the cluster selector terms are evaluated; property selectors and sorters are ignored.
+	// +optional
+	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+
+	// JSONPatchOverrides defines a list of JSON patch override rules, which are applied in order.
+	// +required
+	JSONPatchOverrides []JSONPatchOverride `json:"jsonPatchOverrides"`
+}
+
+// JSONPatchOverride applies a JSON patch on the selected resources following [RFC 6902](https://datatracker.ietf.org/doc/html/rfc6902).
+type JSONPatchOverride struct {
+	// Operator defines the operation on the target field.
+	// +kubebuilder:validation:Enum=add;remove;replace
+	// +required
+	Operator JSONPatchOverrideOperator `json:"op"`
+
+	// Path defines the target location as a JSON pointer, e.g., /metadata/labels/env.
+	// Whether the path must exist depends on the operator:
+	// - `add` creates the target field, or replaces its value if it exists; an array index inserts the value before
+	//   the element at the index, and `-` appends it to the array. The parent of the target must exist.
+	// - `remove` and `replace` require the target to exist.
+	// The override fails if the path does not meet the requirement of its operator.
+	// +required
+	Path string `json:"path"`
+
+	// Value defines the content to be applied on the target location.
+	// Value should be empty when operator is `remove`.
+	// +optional
+	Value apiextensionsv1.JSON `json:"value,omitempty"`
+}
+
+// JSONPatchOverrideOperator defines the supported JSON patch operator.
+// +enum
+type JSONPatchOverrideOperator string
+
+const (
+	// JSONPatchOverrideOpAdd adds the value to the target location.
+	// An example target JSON document:
+	//
+	//   { "foo": [ "bar", "baz" ] }
+	//
+	//   A JSON Patch override:
+	//
+	//   [
+	//     { "op": "add", "path": "/foo/1", "value": "qux" }
+	//   ]
+	//
+	//   The resulting JSON document:
+	//
+	//   { "foo": [ "bar", "qux", "baz" ] }
+	JSONPatchOverrideOpAdd JSONPatchOverrideOperator = "add"
+
+	// JSONPatchOverrideOpRemove removes the value from the target location.
+	// An example target JSON document:
+	//
+	//   {
+	//     "baz": "qux",
+	//     "foo": "bar"
+	//   }
+	//   A JSON Patch override:
+	//
+	//   [
+	//     { "op": "remove", "path": "/baz" }
+	//   ]
+	//
+	//   The resulting JSON document:
+	//
+	//   { "foo": "bar" }
+	JSONPatchOverrideOpRemove JSONPatchOverrideOperator = "remove"
+
+	// JSONPatchOverrideOpReplace replaces the value at the target location with a new value.
+	// An example target JSON document:
+	//
+	//   {
+	//     "baz": "qux",
+	//     "foo": "bar"
+	//   }
+	//
+	//   A JSON Patch override:
+	//
+	//   [
+	//     { "op": "replace", "path": "/baz", "value": "boo" }
+	//   ]
+	//
+	//   The resulting JSON document:
+	//
+	//   {
+	//     "baz": "boo",
+	//     "foo": "bar"
+	//   }
+	JSONPatchOverrideOpReplace JSONPatchOverrideOperator = "replace"
+)
+
+// ClusterResourceOverrideList contains a list of ClusterResourceOverride.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterResourceOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterResourceOverride `json:"items"`
+}
+
+// ResourceOverrideList contains a list of ResourceOverride.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceOverride `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterResourceOverride{}, &ClusterResourceOverrideList{},
+		&ResourceOverride{}, &ResourceOverrideList{},
+	)
+}
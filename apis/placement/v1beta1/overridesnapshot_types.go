@@ -0,0 +1,117 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OverrideIndexLabel is the label that indicate the override snapshot index of an override.
+	OverrideIndexLabel = fleetPrefix + "override-index"
+
+	// OverrideTrackingLabel is the label that points to the override that creates an override snapshot.
+	OverrideTrackingLabel = fleetPrefix + "parent-resource-override"
+
+	// OverrideSnapshotNameFmt is the name format of the override snapshot: {OverrideName}-{OverrideSnapshotIndex}.
+	OverrideSnapshotNameFmt = "%s-%d"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=cross,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourceOverrideSnapshot is used to store a snapshot of ClusterResourceOverride.
+// Its spec is immutable.
+// The naming convention of a ClusterResourceOverrideSnapshot is {ClusterResourceOverrideName}-{OverrideSnapshotIndex}.
+// OverrideSnapshotIndex will begin with 0.
+// Each snapshot must have the following labels:
+//   - `OverrideTrackingLabel` which points to its owner ClusterResourceOverride.
+//   - `OverrideIndexLabel` which is the index of the override snapshot.
+//   - `IsLatestSnapshotLabel` which indicates whether the snapshot is the latest one.
+type ClusterResourceOverrideSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ClusterResourceOverrideSnapshotSpec.
+	// +required
+	Spec ClusterResourceOverrideSnapshotSpec `json:"spec"`
+}
+
+// ClusterResourceOverrideSnapshotSpec defines the desired state of ClusterResourceOverride.
+type ClusterResourceOverrideSnapshotSpec struct {
+	// OverrideSpec stores the spec of ClusterResourceOverride.
+	// +required
+	OverrideSpec ClusterResourceOverrideSpec `json:"overrideSpec"`
+
+	// OverrideHash is the sha-256 hash value of the OverrideSpec field.
+	// +required
+	OverrideHash []byte `json:"overrideHash"`
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=ross,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceOverrideSnapshot is used to store a snapshot of ResourceOverride.
+// Its spec is immutable.
+// The naming convention of a ResourceOverrideSnapshot is {ResourceOverrideName}-{OverrideSnapshotIndex}.
+// It is created in the same namespace as its owner ResourceOverride.
+// OverrideSnapshotIndex will begin with 0.
+// Each snapshot must have the following labels:
+//   - `OverrideTrackingLabel` which points to its owner ResourceOverride.
+//   - `OverrideIndexLabel` which is the index of the override snapshot.
+//   - `IsLatestSnapshotLabel` which indicates whether the snapshot is the latest one.
+type ResourceOverrideSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ResourceOverrideSnapshot.
+	// +required
+	Spec ResourceOverrideSnapshotSpec `json:"spec"`
+}
+
+// ResourceOverrideSnapshotSpec defines the desired state of ResourceOverride.
+type ResourceOverrideSnapshotSpec struct {
+	// OverrideSpec stores the spec of ResourceOverride.
+	// +required
+	OverrideSpec ResourceOverrideSpec `json:"overrideSpec"`
+
+	// OverrideHash is the sha-256 hash value of the OverrideSpec field.
+	// +required
+	OverrideHash []byte `json:"overrideHash"`
+}
+
+// ClusterResourceOverrideSnapshotList contains a list of ClusterResourceOverrideSnapshot.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterResourceOverrideSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterResourceOverrideSnapshot `json:"items"`
+}
+
+// ResourceOverrideSnapshotList contains a list of ResourceOverrideSnapshot.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceOverrideSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceOverrideSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterResourceOverrideSnapshot{}, &ClusterResourceOverrideSnapshotList{},
+		&ResourceOverrideSnapshot{}, &ResourceOverrideSnapshotList{},
+	)
+}
@@ -137,6 +137,13 @@ type ClusterScore struct {
 	// A priority score may not present if the cluster does not meet the topology spread.
 	// +optional
 	TopologySpreadScore *int32 `json:"priorityScore,omitempty"`
+
+	// ResourceAvailabilityScore represents the resource availability score of the cluster calculated
+	// by the last scheduling decision based on the allocatable and available CPU and memory
+	// reported by the cluster.
+	// A resource availability score may not present if the cluster does not report its resource usage.
+	// +optional
+	ResourceAvailabilityScore *int32 `json:"resourceAvailabilityScore,omitempty"`
 }
 
 // ClusterSchedulingPolicySnapshotList contains a list of ClusterSchedulingPolicySnapshot.
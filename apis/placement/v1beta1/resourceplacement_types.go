@@ -0,0 +1,127 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ResourcePlacementCleanupFinalizer is a finalizer added by the resourcePlacement controller to all resourcePlacements,
+	// to make sure that the clusterResourcePlacement backing the resourcePlacement is deleted with it.
+	ResourcePlacementCleanupFinalizer = fleetPrefix + "rp-cleanup"
+)
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=rp,categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.metadata.generation`,name="Gen",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="ClusterResourcePlacementScheduled")].status`,name="Scheduled",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="ClusterResourcePlacementApplied")].status`,name="Applied",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourcePlacement is used to select namespace scoped resources in its own namespace, e.g., a deployment and its
+// configMap, and place them onto selected member clusters in a fleet.
+//
+// Unlike ClusterResourcePlacement, neither the namespace object nor the other resources in the namespace are placed,
+// so that the application teams can place their workloads without owning the whole namespace. The namespace must
+// exist on the target clusters before the resources are applied.
+//
+// The resourcePlacement controller places the selected resources through a ClusterResourcePlacement which is created
+// and deleted with the ResourcePlacement, so that they are scheduled and rolled out in the same way. The backing
+// ClusterResourcePlacement is controlled by the ResourcePlacement, labelled with its namespace, and should not be
+// updated directly.
+type ResourcePlacement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ResourcePlacement.
+	// +required
+	Spec ResourcePlacementSpec `json:"spec"`
+
+	// The observed status of ResourcePlacement, which is reported from its backing ClusterResourcePlacement.
+	// +optional
+	Status ClusterResourcePlacementStatus `json:"status,omitempty"`
+}
+
+// ResourcePlacementSpec defines the desired state of ResourcePlacement.
+type ResourcePlacementSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+
+	// ResourceSelectors is an array of selectors used to select namespace scoped resources in the same namespace as
+	// the ResourcePlacement. The selectors are `ORed`.
+	// You can have 1-100 selectors.
+	// +required
+	ResourceSelectors []NamespacedResourceSelector `json:"resourceSelectors"`
+
+	// Policy defines how to select member clusters to place the selected resources.
+	// If unspecified, all the joined member clusters are selected.
+	// The tolerations cannot be specified, so that the resources are never placed onto the tainted member clusters.
+	// +optional
+	Policy *PlacementPolicy `json:"policy,omitempty"`
+
+	// The rollout strategy to use to replace existing placement with new ones.
+	// The rollout gates cannot be specified, and the apply strategy can neither take over the existing resources on
+	// the target clusters nor force the server-side apply conflicts.
+	// +optional
+	// +patchStrategy=retainKeys
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+
+	// The number of old ClusterSchedulingPolicySnapshot or ClusterResourceSnapshot resources to retain to allow rollback.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:default=10
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// NamespacedResourceSelector is used to select namespace scoped resources in the namespace of the ResourcePlacement,
+// or in the namespaces selected by a ClusterResourceSelector, as the target resources to be placed.
+// All the fields are `ANDed`. In other words, a resource must match all the fields to be selected.
+type NamespacedResourceSelector struct {
+	// Group name of the namespace-scoped resource.
+	// Use an empty string to select resources under the core API group (e.g., configmaps).
+	// +required
+	Group string `json:"group"`
+
+	// Version of the namespace-scoped resource.
+	// +required
+	Version string `json:"version"`
+
+	// Kind of the namespace-scoped resource.
+	// +required
+	Kind string `json:"kind"`
+
+	// You can only specify at most one of the following two fields: Name and LabelSelector.
+	// If none is specified, all the namespace-scoped resources with the given group, version and kind are selected.
+
+	// Name of the namespace-scoped resource.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// A label query over all the namespace-scoped resources in the namespace. Resources matching the query are selected.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ResourcePlacementList contains a list of ResourcePlacement.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourcePlacementList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePlacement `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourcePlacement{}, &ResourcePlacementList{})
+}
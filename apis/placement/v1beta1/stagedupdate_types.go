@@ -0,0 +1,94 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=csur,categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.resourceSnapshotName`,name="Resource-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.currentStage`,name="Current-Stage",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterStagedUpdateRun records the progress of the staged update of a ClusterResourcePlacement whose rollout strategy
+// type is StagedUpdate. It is created, updated and deleted by the rollout controller only, and is owned by the
+// ClusterResourcePlacement with the same name.
+type ClusterStagedUpdateRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The observed progress of the staged update.
+	// +optional
+	Status StagedUpdateStatus `json:"status,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=csa,categories={fleet,fleet-placement}
+// +kubebuilder:printcolumn:JSONPath=`.spec.placementName`,name="Placement",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.stageName`,name="Stage",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.resourceSnapshotName`,name="Resource-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterStageApproval is created by the users to approve a stage, which requires approval, of the staged update of
+// a ClusterResourcePlacement to complete, so that the rollout moves on to the next stage.
+// An approval is only valid for the resource snapshot it names, so that it never carries over to a newer one.
+type ClusterStageApproval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ClusterStageApproval.
+	// +required
+	Spec ClusterStageApprovalSpec `json:"spec"`
+}
+
+// ClusterStageApprovalSpec defines the desired state of the ClusterStageApproval.
+type ClusterStageApprovalSpec struct {
+	// PlacementName is the name of the ClusterResourcePlacement whose stage is approved.
+	// +required
+	PlacementName string `json:"placementName"`
+
+	// StageName is the name of the approved stage.
+	// +required
+	StageName string `json:"stageName"`
+
+	// ResourceSnapshotName is the name of the master resource snapshot rolled out by the approved stage.
+	// +required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+}
+
+// ClusterStagedUpdateRunList contains a list of ClusterStagedUpdateRun.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterStagedUpdateRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterStagedUpdateRun `json:"items"`
+}
+
+// ClusterStageApprovalList contains a list of ClusterStageApproval.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterStageApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterStageApproval `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterStagedUpdateRun{}, &ClusterStagedUpdateRunList{},
+		&ClusterStageApproval{}, &ClusterStageApprovalList{},
+	)
+}
@@ -34,10 +34,72 @@ const (
 	// LastAppliedConfigAnnotation is to record the last applied configuration on the object.
 	LastAppliedConfigAnnotation = fleetPrefix + "last-applied-configuration"
 
+	// ApplyWaveAnnotation is the annotation that users can set on a resource to explicitly specify the wave in which
+	// the member agent applies the resource. Its value is an integer; the resources in a lower wave are applied first.
+	// The wave of a resource without the annotation is derived from its kind: 0 for namespaces, CRDs, priority classes
+	// and storage classes, 1 for service accounts and RBAC, 2 for configurations and storage, 3 for services,
+	// 4 for workloads and 5 for all the other kinds.
+	ApplyWaveAnnotation = fleetPrefix + "apply-wave"
+
+	// RetainUntilAnnotation is the annotation that the member agent sets on an appliedWork when its work, which has
+	// the Retain deletion policy, is deleted or the cluster leaves the fleet. Its value is a RFC3339 timestamp after
+	// which the member agent deletes the appliedWork together with the resources it applied.
+	RetainUntilAnnotation = fleetPrefix + "retain-until"
+
 	// WorkConditionTypeApplied represents workload in Work is applied successfully on the spoke cluster.
 	WorkConditionTypeApplied = "Applied"
 	// WorkConditionTypeAvailable represents workload in Work exists on the spoke cluster.
 	WorkConditionTypeAvailable = "Available"
+	// WorkConditionTypeDryRunSucceeded represents workload in a dry-run Work is accepted by the spoke cluster with
+	// server-side dry-run requests.
+	WorkConditionTypeDryRunSucceeded = "DryRunSucceeded"
+	// WorkConditionTypeRolloutGatesPassed represents all the rollout gates in Work pass on the spoke cluster.
+	WorkConditionTypeRolloutGatesPassed = "RolloutGatesPassed"
+)
+
+// The condition reasons which the member agent sets on the Work and its manifests; the hub agent reads some of them to
+// aggregate the status of the bindings.
+const (
+	// WorkAvailableReason is the reason string of work condition when all the manifests are available.
+	WorkAvailableReason = "WorkAvailable"
+	// WorkNotTrackableReason is the reason string of work condition when all the manifests are applied
+	// but the availability of some of them cannot be tracked.
+	WorkNotTrackableReason = "WorkNotTrackable"
+	// WorkNotAvailableYetReason is the reason string of work condition when some of the manifests are not available yet.
+	WorkNotAvailableYetReason = "WorkNotAvailableYet"
+	// WorkAvailabilityUnknownReason is the reason string of work condition when the availability of
+	// some of the manifests is unknown, e.g., they failed to be applied.
+	WorkAvailabilityUnknownReason = "WorkAvailabilityUnknown"
+
+	// ManifestAvailableReason is the reason string of condition when the manifest is available.
+	ManifestAvailableReason = "ManifestAvailable"
+	// ManifestNotTrackableReason is the reason string of condition when the availability of the manifest cannot be tracked.
+	ManifestNotTrackableReason = "ManifestNotTrackable"
+	// ManifestNotAvailableYetReason is the reason string of condition when the manifest is not available yet.
+	ManifestNotAvailableYetReason = "ManifestNotAvailableYet"
+	// ManifestNotAppliedReason is the reason string of condition when the manifest failed to be applied,
+	// so its availability is unknown.
+	ManifestNotAppliedReason = "ManifestNotApplied"
+	// ManifestAvailabilityUnknownReason is the reason string of condition when the agent failed to
+	// track the availability of the manifest.
+	ManifestAvailabilityUnknownReason = "ManifestAvailabilityUnknown"
+
+	// WorkDryRunSucceededReason is the reason string of work condition when all the manifests pass the dry-run.
+	WorkDryRunSucceededReason = "WorkDryRunSucceeded"
+	// WorkDryRunFailedReason is the reason string of work condition when some of the manifests fail the dry-run.
+	WorkDryRunFailedReason = "WorkDryRunFailed"
+	// ManifestDryRunSucceededReason is the reason string of condition when the manifest passes the dry-run.
+	ManifestDryRunSucceededReason = "ManifestDryRunSucceeded"
+	// ManifestDryRunSkippedReason is the reason string of condition when the manifest cannot be validated because
+	// it depends on a namespace or a custom resource definition in the same work which does not exist on the cluster yet.
+	ManifestDryRunSkippedReason = "ManifestDryRunSkipped"
+	// ManifestDryRunFailedReason is the reason string of condition when the manifest fails the dry-run.
+	ManifestDryRunFailedReason = "ManifestDryRunFailed"
+
+	// WorkRolloutGatesPassedReason is the reason string of work condition when all the rollout gates pass.
+	WorkRolloutGatesPassedReason = "WorkRolloutGatesPassed"
+	// WorkRolloutGatesFailedReason is the reason string of work condition when some of the rollout gates fail.
+	WorkRolloutGatesFailedReason = "WorkRolloutGatesFailed"
 )
 
 // This api is copied from https://github.com/kubernetes-sigs/work-api/blob/master/pkg/apis/v1alpha1/work_types.go.
@@ -47,6 +109,25 @@ const (
 type WorkSpec struct {
 	// Workload represents the manifest workload to be deployed on spoke cluster
 	Workload WorkloadTemplate `json:"workload,omitempty"`
+
+	// ApplyStrategy describes how the member agent applies the workload to the spoke cluster.
+	// +optional
+	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+
+	// DeletionPolicy describes what the member agent does with the applied resources when the work is deleted or
+	// the spoke cluster leaves the fleet.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DryRun indicates that the member agent only validates the workload against the spoke cluster with server-side
+	// dry-run requests and reports the result in the status, without persisting anything on the spoke cluster.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// RolloutGates are the checks which the member agent runs on the spoke cluster, with the result reported in the
+	// status. A work with rollout gates carries no workload.
+	// +optional
+	RolloutGates []RolloutGate `json:"rolloutGates,omitempty"`
 }
 
 // WorkloadTemplate represents the manifest workload to be deployed on spoke cluster
@@ -117,6 +198,88 @@ type ManifestCondition struct {
 	// Conditions represents the conditions of this resource on spoke cluster
 	// +required
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// DriftDetails explains the drifts found on the resource on spoke cluster, i.e., the fields of the manifest
+	// whose values are changed directly on spoke cluster.
+	// It is only reported when the apply strategy asks the member agent to leave the drifts as they are.
+	// +optional
+	DriftDetails *DriftDetails `json:"driftDetails,omitempty"`
+
+	// DiffDetails explains the differences between the resource on spoke cluster and the manifest.
+	// It is only reported when the apply strategy is ReportDiff, or when the resource already exists on spoke cluster
+	// and is not taken over because of the differences.
+	// +optional
+	DiffDetails *DiffDetails `json:"diffDetails,omitempty"`
+
+	// BackReportedStatus is the status of the resource on spoke cluster.
+	// It is only reported when the apply strategy asks the member agent to report back the status.
+	// +optional
+	BackReportedStatus *BackReportedStatus `json:"backReportedStatus,omitempty"`
+}
+
+// BackReportedStatus describes the status of a resource on spoke cluster reported back by the member agent.
+type BackReportedStatus struct {
+	// ObservedStatus is the status field of the resource on spoke cluster.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +required
+	ObservedStatus runtime.RawExtension `json:"observedStatus"`
+
+	// ObservationTime is the time when the status was observed.
+	// +required
+	ObservationTime metav1.Time `json:"observationTime"`
+}
+
+// DiffDetails describes the differences between a resource on spoke cluster and its manifest.
+type DiffDetails struct {
+	// ObservationTime is the time when the differences were last observed.
+	// +required
+	ObservationTime metav1.Time `json:"observationTime"`
+
+	// ObservedInMemberClusterGeneration is the generation of the resource on spoke cluster when the differences
+	// were observed. It is not set if the resource does not exist on spoke cluster.
+	// +optional
+	ObservedInMemberClusterGeneration *int64 `json:"observedInMemberClusterGeneration,omitempty"`
+
+	// ObservedDiffs is a list of the fields whose values differ. If the resource does not exist on spoke cluster,
+	// the list contains a single entry whose path is "/".
+	// +optional
+	ObservedDiffs []PatchDetail `json:"observedDiffs,omitempty"`
+}
+
+// DriftDetails describes the drifts found on a resource on spoke cluster.
+type DriftDetails struct {
+	// ObservationTime is the time when the drifts were last observed.
+	// +required
+	ObservationTime metav1.Time `json:"observationTime"`
+
+	// FirstDriftedObservedTime is the first time the resource was observed to have drifted.
+	// +required
+	FirstDriftedObservedTime metav1.Time `json:"firstDriftedObservedTime"`
+
+	// ObservedInMemberClusterGeneration is the generation of the resource on spoke cluster when the drifts
+	// were observed.
+	// +required
+	ObservedInMemberClusterGeneration int64 `json:"observedInMemberClusterGeneration"`
+
+	// ObservedDrifts is a list of the drifted fields.
+	// +optional
+	ObservedDrifts []PatchDetail `json:"observedDrifts,omitempty"`
+}
+
+// PatchDetail describes a field whose value on spoke cluster differs from the one in the manifest.
+type PatchDetail struct {
+	// Path is the JSON pointer (RFC 6901) of the field.
+	// +required
+	Path string `json:"path"`
+
+	// ValueInMember is the value of the field on spoke cluster.
+	// It is not set if the field does not exist on spoke cluster.
+	// +optional
+	ValueInMember string `json:"valueInMember,omitempty"`
+
+	// ValueInHub is the value of the field in the manifest.
+	// +optional
+	ValueInHub string `json:"valueInHub,omitempty"`
 }
 
 // +genclient
@@ -40,6 +40,10 @@ func (in *Affinity) DeepCopy() *Affinity {
 func (in *AppliedResourceMeta) DeepCopyInto(out *AppliedResourceMeta) {
 	*out = *in
 	out.WorkResourceIdentifier = in.WorkResourceIdentifier
+	if in.RetainedSince != nil {
+		in, out := &in.RetainedSince, &out.RetainedSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedResourceMeta.
@@ -132,7 +136,9 @@ func (in *AppliedWorkStatus) DeepCopyInto(out *AppliedWorkStatus) {
 	if in.AppliedResources != nil {
 		in, out := &in.AppliedResources, &out.AppliedResources
 		*out = make([]AppliedResourceMeta, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -146,6 +152,60 @@ func (in *AppliedWorkStatus) DeepCopy() *AppliedWorkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyStrategy) DeepCopyInto(out *ApplyStrategy) {
+	*out = *in
+	if in.ServerSideApplyConfig != nil {
+		in, out := &in.ServerSideApplyConfig, &out.ServerSideApplyConfig
+		*out = new(ServerSideApplyConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyStrategy.
+func (in *ApplyStrategy) DeepCopy() *ApplyStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackReportedResourceStatus) DeepCopyInto(out *BackReportedResourceStatus) {
+	*out = *in
+	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
+	in.BackReportedStatus.DeepCopyInto(&out.BackReportedStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackReportedResourceStatus.
+func (in *BackReportedResourceStatus) DeepCopy() *BackReportedResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackReportedResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackReportedStatus) DeepCopyInto(out *BackReportedStatus) {
+	*out = *in
+	in.ObservedStatus.DeepCopyInto(&out.ObservedStatus)
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackReportedStatus.
+func (in *BackReportedStatus) DeepCopy() *BackReportedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackReportedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAffinity) DeepCopyInto(out *ClusterAffinity) {
 	*out = *in
@@ -252,6 +312,234 @@ func (in *ClusterResourceBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceEnvelope) DeepCopyInto(out *ClusterResourceEnvelope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]Manifest, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelope.
+func (in *ClusterResourceEnvelope) DeepCopy() *ClusterResourceEnvelope {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceEnvelope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceEnvelope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceEnvelopeList) DeepCopyInto(out *ClusterResourceEnvelopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourceEnvelope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelopeList.
+func (in *ClusterResourceEnvelopeList) DeepCopy() *ClusterResourceEnvelopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceEnvelopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceEnvelopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverride) DeepCopyInto(out *ClusterResourceOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverride.
+func (in *ClusterResourceOverride) DeepCopy() *ClusterResourceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideList) DeepCopyInto(out *ClusterResourceOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourceOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideList.
+func (in *ClusterResourceOverrideList) DeepCopy() *ClusterResourceOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSnapshot) DeepCopyInto(out *ClusterResourceOverrideSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSnapshot.
+func (in *ClusterResourceOverrideSnapshot) DeepCopy() *ClusterResourceOverrideSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverrideSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSnapshotList) DeepCopyInto(out *ClusterResourceOverrideSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourceOverrideSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSnapshotList.
+func (in *ClusterResourceOverrideSnapshotList) DeepCopy() *ClusterResourceOverrideSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverrideSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSnapshotSpec) DeepCopyInto(out *ClusterResourceOverrideSnapshotSpec) {
+	*out = *in
+	in.OverrideSpec.DeepCopyInto(&out.OverrideSpec)
+	if in.OverrideHash != nil {
+		in, out := &in.OverrideHash, &out.OverrideHash
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSnapshotSpec.
+func (in *ClusterResourceOverrideSnapshotSpec) DeepCopy() *ClusterResourceOverrideSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSpec) DeepCopyInto(out *ClusterResourceOverrideSpec) {
+	*out = *in
+	if in.ClusterResourceSelectors != nil {
+		in, out := &in.ClusterResourceSelectors, &out.ClusterResourceSelectors
+		*out = make([]ClusterResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(OverridePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSpec.
+func (in *ClusterResourceOverrideSpec) DeepCopy() *ClusterResourceOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourcePlacement) DeepCopyInto(out *ClusterResourcePlacement) {
 	*out = *in
@@ -332,6 +620,11 @@ func (in *ClusterResourcePlacementSpec) DeepCopyInto(out *ClusterResourcePlaceme
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PinnedResourceIndex != nil {
+		in, out := &in.PinnedResourceIndex, &out.PinnedResourceIndex
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourcePlacementSpec.
@@ -388,6 +681,13 @@ func (in *ClusterResourceSelector) DeepCopyInto(out *ClusterResourceSelector) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespacedResourceSelectors != nil {
+		in, out := &in.NamespacedResourceSelectors, &out.NamespacedResourceSelectors
+		*out = make([]NamespacedResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSelector.
@@ -531,6 +831,11 @@ func (in *ClusterScore) DeepCopyInto(out *ClusterScore) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ResourceAvailabilityScore != nil {
+		in, out := &in.ResourceAvailabilityScore, &out.ResourceAvailabilityScore
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScore.
@@ -569,6 +874,16 @@ func (in *ClusterSelector) DeepCopy() *ClusterSelector {
 func (in *ClusterSelectorTerm) DeepCopyInto(out *ClusterSelectorTerm) {
 	*out = *in
 	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.PropertySelector != nil {
+		in, out := &in.PropertySelector, &out.PropertySelector
+		*out = new(PropertySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PropertySorter != nil {
+		in, out := &in.PropertySorter, &out.PropertySorter
+		*out = new(PropertySorter)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSelectorTerm.
@@ -582,35 +897,265 @@ func (in *ClusterSelectorTerm) DeepCopy() *ClusterSelectorTerm {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvelopeIdentifier) DeepCopyInto(out *EnvelopeIdentifier) {
+func (in *ClusterStageApproval) DeepCopyInto(out *ClusterStageApproval) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvelopeIdentifier.
-func (in *EnvelopeIdentifier) DeepCopy() *EnvelopeIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStageApproval.
+func (in *ClusterStageApproval) DeepCopy() *ClusterStageApproval {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvelopeIdentifier)
+	out := new(ClusterStageApproval)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailedResourcePlacement) DeepCopyInto(out *FailedResourcePlacement) {
-	*out = *in
-	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
-	in.Condition.DeepCopyInto(&out.Condition)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedResourcePlacement.
-func (in *FailedResourcePlacement) DeepCopy() *FailedResourcePlacement {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStageApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(FailedResourcePlacement)
-	in.DeepCopyInto(out)
-	return out
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStageApprovalList) DeepCopyInto(out *ClusterStageApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStageApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStageApprovalList.
+func (in *ClusterStageApprovalList) DeepCopy() *ClusterStageApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStageApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStageApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStageApprovalSpec) DeepCopyInto(out *ClusterStageApprovalSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStageApprovalSpec.
+func (in *ClusterStageApprovalSpec) DeepCopy() *ClusterStageApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStageApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStagedUpdateRun) DeepCopyInto(out *ClusterStagedUpdateRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStagedUpdateRun.
+func (in *ClusterStagedUpdateRun) DeepCopy() *ClusterStagedUpdateRun {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStagedUpdateRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStagedUpdateRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStagedUpdateRunList) DeepCopyInto(out *ClusterStagedUpdateRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStagedUpdateRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStagedUpdateRunList.
+func (in *ClusterStagedUpdateRunList) DeepCopy() *ClusterStagedUpdateRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStagedUpdateRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStagedUpdateRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionPolicy) DeepCopyInto(out *DeletionPolicy) {
+	*out = *in
+	if in.RetainGracePeriodSeconds != nil {
+		in, out := &in.RetainGracePeriodSeconds, &out.RetainGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionPolicy.
+func (in *DeletionPolicy) DeepCopy() *DeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffDetails) DeepCopyInto(out *DiffDetails) {
+	*out = *in
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+	if in.ObservedInMemberClusterGeneration != nil {
+		in, out := &in.ObservedInMemberClusterGeneration, &out.ObservedInMemberClusterGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ObservedDiffs != nil {
+		in, out := &in.ObservedDiffs, &out.ObservedDiffs
+		*out = make([]PatchDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffDetails.
+func (in *DiffDetails) DeepCopy() *DiffDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetails) DeepCopyInto(out *DriftDetails) {
+	*out = *in
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+	in.FirstDriftedObservedTime.DeepCopyInto(&out.FirstDriftedObservedTime)
+	if in.ObservedDrifts != nil {
+		in, out := &in.ObservedDrifts, &out.ObservedDrifts
+		*out = make([]PatchDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetails.
+func (in *DriftDetails) DeepCopy() *DriftDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvelopeIdentifier) DeepCopyInto(out *EnvelopeIdentifier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvelopeIdentifier.
+func (in *EnvelopeIdentifier) DeepCopy() *EnvelopeIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvelopeIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedResourcePlacement) DeepCopyInto(out *FailedResourcePlacement) {
+	*out = *in
+	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
+	in.Condition.DeepCopyInto(&out.Condition)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedResourcePlacement.
+func (in *FailedResourcePlacement) DeepCopy() *FailedResourcePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedResourcePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProbeGate) DeepCopyInto(out *HTTPProbeGate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbeGate.
+func (in *HTTPProbeGate) DeepCopy() *HTTPProbeGate {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbeGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPatchOverride) DeepCopyInto(out *JSONPatchOverride) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOverride.
+func (in *JSONPatchOverride) DeepCopy() *JSONPatchOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPatchOverride)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -640,6 +1185,21 @@ func (in *ManifestCondition) DeepCopyInto(out *ManifestCondition) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DriftDetails != nil {
+		in, out := &in.DriftDetails, &out.DriftDetails
+		*out = new(DriftDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiffDetails != nil {
+		in, out := &in.DiffDetails, &out.DiffDetails
+		*out = new(DiffDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackReportedStatus != nil {
+		in, out := &in.BackReportedStatus, &out.BackReportedStatus
+		*out = new(BackReportedStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestCondition.
@@ -647,134 +1207,656 @@ func (in *ManifestCondition) DeepCopy() *ManifestCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ManifestCondition)
+	out := new(ManifestCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsQueryGate) DeepCopyInto(out *MetricsQueryGate) {
+	*out = *in
+	out.Threshold = in.Threshold.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsQueryGate.
+func (in *MetricsQueryGate) DeepCopy() *MetricsQueryGate {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsQueryGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedName.
+func (in *NamespacedName) DeepCopy() *NamespacedName {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedResourceSelector) DeepCopyInto(out *NamespacedResourceSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedResourceSelector.
+func (in *NamespacedResourceSelector) DeepCopy() *NamespacedResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverridePolicy) DeepCopyInto(out *OverridePolicy) {
+	*out = *in
+	if in.OverrideRules != nil {
+		in, out := &in.OverrideRules, &out.OverrideRules
+		*out = make([]OverrideRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverridePolicy.
+func (in *OverridePolicy) DeepCopy() *OverridePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OverridePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideRule) DeepCopyInto(out *OverrideRule) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(ClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JSONPatchOverrides != nil {
+		in, out := &in.JSONPatchOverrides, &out.JSONPatchOverrides
+		*out = make([]JSONPatchOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideRule.
+func (in *OverrideRule) DeepCopy() *OverrideRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchDetail) DeepCopyInto(out *PatchDetail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchDetail.
+func (in *PatchDetail) DeepCopy() *PatchDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+	if in.ClusterNames != nil {
+		in, out := &in.ClusterNames, &out.ClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumberOfClusters != nil {
+		in, out := &in.NumberOfClusters, &out.NumberOfClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]Toleration, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferredClusterSelector) DeepCopyInto(out *PreferredClusterSelector) {
+	*out = *in
+	in.Preference.DeepCopyInto(&out.Preference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredClusterSelector.
+func (in *PreferredClusterSelector) DeepCopy() *PreferredClusterSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferredClusterSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertySelector) DeepCopyInto(out *PropertySelector) {
+	*out = *in
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]PropertySelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertySelector.
+func (in *PropertySelector) DeepCopy() *PropertySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertySelectorRequirement) DeepCopyInto(out *PropertySelectorRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertySelectorRequirement.
+func (in *PropertySelectorRequirement) DeepCopy() *PropertySelectorRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertySelectorRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertySorter) DeepCopyInto(out *PropertySorter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertySorter.
+func (in *PropertySorter) DeepCopy() *PropertySorter {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertySorter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
+	*out = *in
+	if in.ResourceOverrideSnapshots != nil {
+		in, out := &in.ResourceOverrideSnapshots, &out.ResourceOverrideSnapshots
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterResourceOverrideSnapshots != nil {
+		in, out := &in.ClusterResourceOverrideSnapshots, &out.ClusterResourceOverrideSnapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ClusterDecision.DeepCopyInto(&out.ClusterDecision)
+	if in.ApplyStrategy != nil {
+		in, out := &in.ApplyStrategy, &out.ApplyStrategy
+		*out = new(ApplyStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutGates != nil {
+		in, out := &in.RolloutGates, &out.RolloutGates
+		*out = make([]RolloutGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
+func (in *ResourceBindingSpec) DeepCopy() *ResourceBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingStatus.
+func (in *ResourceBindingStatus) DeepCopy() *ResourceBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceContent) DeepCopyInto(out *ResourceContent) {
+	*out = *in
+	in.RawExtension.DeepCopyInto(&out.RawExtension)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceContent.
+func (in *ResourceContent) DeepCopy() *ResourceContent {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEnvelope) DeepCopyInto(out *ResourceEnvelope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]Manifest, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelope.
+func (in *ResourceEnvelope) DeepCopy() *ResourceEnvelope {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEnvelope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceEnvelope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEnvelopeList) DeepCopyInto(out *ResourceEnvelopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceEnvelope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelopeList.
+func (in *ResourceEnvelopeList) DeepCopy() *ResourceEnvelopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEnvelopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceEnvelopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceIdentifier) DeepCopyInto(out *ResourceIdentifier) {
+	*out = *in
+	if in.Envelope != nil {
+		in, out := &in.Envelope, &out.Envelope
+		*out = new(EnvelopeIdentifier)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceIdentifier.
+func (in *ResourceIdentifier) DeepCopy() *ResourceIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverride.
+func (in *ResourceOverride) DeepCopy() *ResourceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOverrideList) DeepCopyInto(out *ResourceOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverrideList.
+func (in *ResourceOverrideList) DeepCopy() *ResourceOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOverrideSnapshot) DeepCopyInto(out *ResourceOverrideSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverrideSnapshot.
+func (in *ResourceOverrideSnapshot) DeepCopy() *ResourceOverrideSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOverrideSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceOverrideSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOverrideSnapshotList) DeepCopyInto(out *ResourceOverrideSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceOverrideSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverrideSnapshotList.
+func (in *ResourceOverrideSnapshotList) DeepCopy() *ResourceOverrideSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOverrideSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceOverrideSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+func (in *ResourceOverrideSnapshotSpec) DeepCopyInto(out *ResourceOverrideSnapshotSpec) {
 	*out = *in
-	if in.ClusterNames != nil {
-		in, out := &in.ClusterNames, &out.ClusterNames
-		*out = make([]string, len(*in))
+	in.OverrideSpec.DeepCopyInto(&out.OverrideSpec)
+	if in.OverrideHash != nil {
+		in, out := &in.OverrideHash, &out.OverrideHash
+		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
-	if in.NumberOfClusters != nil {
-		in, out := &in.NumberOfClusters, &out.NumberOfClusters
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(Affinity)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TopologySpreadConstraints != nil {
-		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
-		*out = make([]TopologySpreadConstraint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
-func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverrideSnapshotSpec.
+func (in *ResourceOverrideSnapshotSpec) DeepCopy() *ResourceOverrideSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PlacementPolicy)
+	out := new(ResourceOverrideSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PreferredClusterSelector) DeepCopyInto(out *PreferredClusterSelector) {
+func (in *ResourceOverrideSpec) DeepCopyInto(out *ResourceOverrideSpec) {
 	*out = *in
-	in.Preference.DeepCopyInto(&out.Preference)
+	if in.ResourceSelectors != nil {
+		in, out := &in.ResourceSelectors, &out.ResourceSelectors
+		*out = make([]ResourceSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(OverridePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredClusterSelector.
-func (in *PreferredClusterSelector) DeepCopy() *PreferredClusterSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverrideSpec.
+func (in *ResourceOverrideSpec) DeepCopy() *ResourceOverrideSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PreferredClusterSelector)
+	out := new(ResourceOverrideSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
+func (in *ResourcePlacement) DeepCopyInto(out *ResourcePlacement) {
 	*out = *in
-	in.ClusterDecision.DeepCopyInto(&out.ClusterDecision)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
-func (in *ResourceBindingSpec) DeepCopy() *ResourceBindingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacement.
+func (in *ResourcePlacement) DeepCopy() *ResourcePlacement {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceBindingSpec)
+	out := new(ResourcePlacement)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacement) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
+func (in *ResourcePlacementList) DeepCopyInto(out *ResourcePlacementList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePlacement, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingStatus.
-func (in *ResourceBindingStatus) DeepCopy() *ResourceBindingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementList.
+func (in *ResourcePlacementList) DeepCopy() *ResourcePlacementList {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceBindingStatus)
+	out := new(ResourcePlacementList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceContent) DeepCopyInto(out *ResourceContent) {
-	*out = *in
-	in.RawExtension.DeepCopyInto(&out.RawExtension)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceContent.
-func (in *ResourceContent) DeepCopy() *ResourceContent {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacementList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ResourceContent)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceIdentifier) DeepCopyInto(out *ResourceIdentifier) {
+func (in *ResourcePlacementSpec) DeepCopyInto(out *ResourcePlacementSpec) {
 	*out = *in
-	if in.Envelope != nil {
-		in, out := &in.Envelope, &out.Envelope
-		*out = new(EnvelopeIdentifier)
+	if in.ResourceSelectors != nil {
+		in, out := &in.ResourceSelectors, &out.ResourceSelectors
+		*out = make([]NamespacedResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(PlacementPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceIdentifier.
-func (in *ResourceIdentifier) DeepCopy() *ResourceIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementSpec.
+func (in *ResourcePlacementSpec) DeepCopy() *ResourcePlacementSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceIdentifier)
+	out := new(ResourcePlacementSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -789,6 +1871,13 @@ func (in *ResourcePlacementStatus) DeepCopyInto(out *ResourcePlacementStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BackReportedStatuses != nil {
+		in, out := &in.BackReportedStatuses, &out.BackReportedStatuses
+		*out = make([]BackReportedResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -808,6 +1897,21 @@ func (in *ResourcePlacementStatus) DeepCopy() *ResourcePlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSnapshotSpec) DeepCopyInto(out *ResourceSnapshotSpec) {
 	*out = *in
@@ -882,6 +1986,36 @@ func (in *RollingUpdateConfig) DeepCopy() *RollingUpdateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutGate) DeepCopyInto(out *RolloutGate) {
+	*out = *in
+	if in.HTTPProbe != nil {
+		in, out := &in.HTTPProbe, &out.HTTPProbe
+		*out = new(HTTPProbeGate)
+		**out = **in
+	}
+	if in.MetricsQuery != nil {
+		in, out := &in.MetricsQuery, &out.MetricsQuery
+		*out = new(MetricsQueryGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutGate.
+func (in *RolloutGate) DeepCopy() *RolloutGate {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 	*out = *in
@@ -890,6 +2024,28 @@ func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 		*out = new(RollingUpdateConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StagedUpdate != nil {
+		in, out := &in.StagedUpdate, &out.StagedUpdate
+		*out = new(StagedUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyStrategy != nil {
+		in, out := &in.ApplyStrategy, &out.ApplyStrategy
+		*out = new(ApplyStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutGates != nil {
+		in, out := &in.RolloutGates, &out.RolloutGates
+		*out = make([]RolloutGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
@@ -956,6 +2112,140 @@ func (in *SchedulingPolicySnapshotStatus) DeepCopy() *SchedulingPolicySnapshotSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConfig) DeepCopyInto(out *ServerSideApplyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConfig.
+func (in *ServerSideApplyConfig) DeepCopy() *ServerSideApplyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageConfig) DeepCopyInto(out *StageConfig) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitTime != nil {
+		in, out := &in.WaitTime, &out.WaitTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageConfig.
+func (in *StageConfig) DeepCopy() *StageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageUpdatingStatus) DeepCopyInto(out *StageUpdatingStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageUpdatingStatus.
+func (in *StageUpdatingStatus) DeepCopy() *StageUpdatingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StageUpdatingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedUpdateStatus) DeepCopyInto(out *StagedUpdateStatus) {
+	*out = *in
+	if in.StagesStatus != nil {
+		in, out := &in.StagesStatus, &out.StagesStatus
+		*out = make([]StageUpdatingStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedUpdateStatus.
+func (in *StagedUpdateStatus) DeepCopy() *StagedUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedUpdateStrategy) DeepCopyInto(out *StagedUpdateStrategy) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]StageConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedUpdateStrategy.
+func (in *StagedUpdateStrategy) DeepCopy() *StagedUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Toleration) DeepCopyInto(out *Toleration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Toleration.
+func (in *Toleration) DeepCopy() *Toleration {
+	if in == nil {
+		return nil
+	}
+	out := new(Toleration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint) {
 	*out = *in
@@ -1054,6 +2344,23 @@ func (in *WorkResourceIdentifier) DeepCopy() *WorkResourceIdentifier {
 func (in *WorkSpec) DeepCopyInto(out *WorkSpec) {
 	*out = *in
 	in.Workload.DeepCopyInto(&out.Workload)
+	if in.ApplyStrategy != nil {
+		in, out := &in.ApplyStrategy, &out.ApplyStrategy
+		*out = new(ApplyStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutGates != nil {
+		in, out := &in.RolloutGates, &out.RolloutGates
+		*out = make([]RolloutGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkSpec.
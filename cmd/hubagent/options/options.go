@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	componentbaseconfig "k8s.io/component-base/config"
 
+	"go.goms.io/fleet/pkg/scheduler/framework/plugins/resourceavailability"
 	"go.goms.io/fleet/pkg/utils"
 )
 
@@ -59,6 +60,9 @@ type Options struct {
 	AllowedPropagatingAPIs string
 	// SkippedPropagatingNamespaces is a list of namespaces that will be skipped for propagating.
 	SkippedPropagatingNamespaces string
+	// PropagationStripRulesFile is the path of the config file which contains the fields that should be stripped
+	// from the resources, including the ones wrapped in the envelopes, before propagating them.
+	PropagationStripRulesFile string
 	// HubQPS is the QPS to use while talking with hub-apiserver. Default is 20.0.
 	HubQPS float64
 	// HubBurst is the burst to allow while talking with hub-apiserver. Default is 100.
@@ -80,6 +84,9 @@ type Options struct {
 	EnableV1Alpha1APIs bool
 	// EnableV1Beta1APIs enables the agents to watch the v1beta1 CRs.
 	EnableV1Beta1APIs bool
+	// ResourceScoringStrategy is the strategy the scheduler uses to score clusters by their
+	// allocatable and available resources.
+	ResourceScoringStrategy string
 }
 
 // NewOptions builds an empty options.
@@ -126,6 +133,9 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 		"<group>/<version>/<kind>,<kind> for skip one or more specific resource(e.g. networking.k8s.io/v1beta1/Ingress,IngressClass) where the kinds are case-insensitive.")
 	flags.StringVar(&o.SkippedPropagatingNamespaces, "skipped-propagating-namespaces", "",
 		"Comma-separated namespaces that should be skipped from propagating in addition to the default skipped namespaces(fleet-system, namespaces prefixed by kube- and fleet-work-).")
+	flags.StringVar(&o.PropagationStripRulesFile, "propagation-strip-rules-file", "",
+		"The path of the YAML or JSON file which lists the fields that should be stripped from the resources, including the ones wrapped in the envelopes, before propagating them, "+
+			"given as the group, version and kind of the resources and the JSON pointers of the fields (e.g. /spec/volumeName). Only used by the v1beta1 APIs.")
 	flags.Float64Var(&o.HubQPS, "hub-api-qps", 20.0, "QPS to use while talking with fleet-apiserver. Doesn't cover events and node heartbeat apis which rate limiting is controlled by a different set of flags.")
 	flags.IntVar(&o.HubBurst, "hub-api-burst", 100, "Burst to use while talking with fleet-apiserver. Doesn't cover events and node heartbeat apis which rate limiting is controlled by a different set of flags.")
 	flags.DurationVar(&o.ResyncPeriod.Duration, "resync-period", 300*time.Second, "Base frequency the informers are resynced.")
@@ -134,6 +144,8 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	flags.IntVar(&o.ConcurrentMemberClusterSyncs, "concurrent-member-cluster-syncs", 1, "The number of member cluster reconcilers that are allowed to run concurrently.")
 	flags.BoolVar(&o.EnableV1Alpha1APIs, "enable-v1alpha1-apis", true, "If set, the agents will watch for the v1alpha1 APIs.")
 	flags.BoolVar(&o.EnableV1Beta1APIs, "enable-v1beta1-apis", false, "If set, the agents will watch for the v1beta1 APIs.")
+	flags.StringVar(&o.ResourceScoringStrategy, "resource-scoring-strategy", string(resourceavailability.LeastAllocated),
+		"The strategy the scheduler uses to score clusters by their allocatable and available resources. Supported values are LeastAllocated, MostAllocated and BalancedAllocation.")
 
 	o.RateLimiterOpts.AddFlags(flags)
 }
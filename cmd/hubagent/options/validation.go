@@ -8,6 +8,7 @@ package options
 import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"go.goms.io/fleet/pkg/scheduler/framework/plugins/resourceavailability"
 	"go.goms.io/fleet/pkg/utils"
 )
 
@@ -30,6 +31,12 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("AllowedPropagatingAPIs"), o.AllowedPropagatingAPIs, "Invalid API string"))
 	}
 
+	if o.PropagationStripRulesFile != "" {
+		if _, err := utils.LoadStripRules(o.PropagationStripRulesFile); err != nil {
+			errs = append(errs, field.Invalid(newPath.Child("PropagationStripRulesFile"), o.PropagationStripRulesFile, err.Error()))
+		}
+	}
+
 	if o.ClusterUnhealthyThreshold.Duration <= 0 {
 		errs = append(errs, field.Invalid(newPath.Child("ClusterUnhealthyThreshold"), o.ClusterUnhealthyThreshold, "Must be greater than 0"))
 	}
@@ -50,5 +57,9 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Required(newPath.Child("EnableV1Alpha1APIs"), "Either EnableV1Alpha1APIs or EnableV1Beta1APIs is required"))
 	}
 
+	if _, err := resourceavailability.ParseScoringStrategy(o.ResourceScoringStrategy); err != nil {
+		errs = append(errs, field.Invalid(newPath.Child("ResourceScoringStrategy"), o.ResourceScoringStrategy, err.Error()))
+	}
+
 	return errs
 }
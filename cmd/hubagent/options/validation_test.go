@@ -25,6 +25,7 @@ func newTestOptions(modifyOptions ModifyOptions) Options {
 		ClusterUnhealthyThreshold:   metav1.Duration{Duration: 1 * time.Second},
 		WebhookClientConnectionType: "url",
 		EnableV1Alpha1APIs:          true,
+		ResourceScoringStrategy:     "LeastAllocated",
 	}
 
 	if modifyOptions != nil {
@@ -73,6 +74,19 @@ func TestValidateControllerManagerConfiguration(t *testing.T) {
 			}),
 			want: field.ErrorList{field.Invalid(newPath.Child("WebhookClientConnectionType"), "invalid", `must be "service" or "url"`)},
 		},
+		"invalid ResourceScoringStrategy": {
+			opt: newTestOptions(func(option *Options) {
+				option.ResourceScoringStrategy = "invalid"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("ResourceScoringStrategy"), "invalid", `must be one of "LeastAllocated", "MostAllocated" or "BalancedAllocation"`)},
+		},
+		"invalid PropagationStripRulesFile": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropagationStripRulesFile = "/not-exist/strip-rules.yaml"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("PropagationStripRulesFile"), "/not-exist/strip-rules.yaml",
+				"failed to read the strip rules config file /not-exist/strip-rules.yaml: open /not-exist/strip-rules.yaml: no such file or directory")},
+		},
 		"WebhookServiceName is empty": {
 			opt: newTestOptions(func(option *Options) {
 				option.EnableWebhook = true
@@ -26,13 +26,16 @@ import (
 	"go.goms.io/fleet/pkg/controllers/clusterresourceplacementwatcher"
 	"go.goms.io/fleet/pkg/controllers/clusterschedulingpolicysnapshot"
 	"go.goms.io/fleet/pkg/controllers/memberclusterplacement"
+	"go.goms.io/fleet/pkg/controllers/overrider"
 	"go.goms.io/fleet/pkg/controllers/resourcechange"
+	"go.goms.io/fleet/pkg/controllers/resourceplacement"
 	"go.goms.io/fleet/pkg/controllers/rollout"
 	"go.goms.io/fleet/pkg/controllers/workgenerator"
 	"go.goms.io/fleet/pkg/resourcewatcher"
 	"go.goms.io/fleet/pkg/scheduler"
 	"go.goms.io/fleet/pkg/scheduler/clustereligibilitychecker"
 	"go.goms.io/fleet/pkg/scheduler/framework"
+	"go.goms.io/fleet/pkg/scheduler/framework/plugins/resourceavailability"
 	"go.goms.io/fleet/pkg/scheduler/profile"
 	"go.goms.io/fleet/pkg/scheduler/queue"
 	schedulercrpwatcher "go.goms.io/fleet/pkg/scheduler/watchers/clusterresourceplacement"
@@ -68,6 +71,13 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourceBindingKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourceSnapshotKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterSchedulingPolicySnapshotKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourceOverrideKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourceOverrideSnapshotKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ResourceOverrideKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ResourceOverrideSnapshotKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ResourcePlacementKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterStagedUpdateRunKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterStageApprovalKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.WorkKind),
 	}
 )
@@ -117,6 +127,14 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		return err
 	}
 
+	var stripRules *utils.StripRules
+	if opts.PropagationStripRulesFile != "" {
+		if stripRules, err = utils.LoadStripRules(opts.PropagationStripRulesFile); err != nil {
+			// The program will never go here because the config file has been checked.
+			return err
+		}
+	}
+
 	// setup namespaces we skip propagation
 	skippedNamespaces := make(map[string]bool)
 	skippedNamespaces["default"] = true
@@ -140,6 +158,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		InformerManager:   dynamicInformerManager,
 		ResourceConfig:    resourceConfig,
 		SkippedNamespaces: skippedNamespaces,
+		StripRules:        stripRules,
 		Scheme:            mgr.GetScheme(),
 		UncachedReader:    mgr.GetAPIReader(),
 	}
@@ -199,6 +218,32 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			return err
 		}
 
+		klog.Info("Setting up resourcePlacement controller")
+		if err := (&resourceplacement.Reconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up resourcePlacement controller")
+			return err
+		}
+
+		klog.Info("Setting up clusterResourceOverride controller")
+		if err := (&overrider.ClusterResourceReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up clusterResourceOverride controller")
+			return err
+		}
+
+		klog.Info("Setting up resourceOverride controller")
+		if err := (&overrider.ResourceReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up resourceOverride controller")
+			return err
+		}
+
 		// Set up  a new controller to do rollout resources according to CRP rollout strategy
 		klog.Info("Setting up rollout controller")
 		if err := (&rollout.Reconciler{
@@ -212,7 +257,8 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		// Set up the work generator
 		klog.Info("Setting up work generator")
 		if err := (&workgenerator.Reconciler{
-			Client: mgr.GetClient(),
+			Client:     mgr.GetClient(),
+			StripRules: stripRules,
 		}).SetupWithManager(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up work generator")
 			return err
@@ -220,7 +266,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		// Set up the scheduler
 		klog.Info("Setting up scheduler")
-		defaultProfile := profile.NewDefaultProfile()
+		defaultProfile := profile.NewDefaultProfile(profile.WithResourceScoringStrategy(resourceavailability.ScoringStrategy(opts.ResourceScoringStrategy)))
 		defaultFramework := framework.NewFramework(defaultProfile, mgr)
 		defaultSchedulingQueue := queue.NewSimpleClusterResourcePlacementSchedulingQueue()
 		defaultScheduler := scheduler.NewScheduler("DefaultScheduler", defaultFramework, defaultSchedulingQueue, mgr)
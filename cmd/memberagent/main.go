@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -42,6 +43,9 @@ import (
 	"go.goms.io/fleet/pkg/controllers/work"
 	workv1alpha1controller "go.goms.io/fleet/pkg/controllers/workv1alpha1"
 	fleetmetrics "go.goms.io/fleet/pkg/metrics"
+	"go.goms.io/fleet/pkg/propertyprovider"
+	"go.goms.io/fleet/pkg/propertyprovider/nodes"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
 	"go.goms.io/fleet/pkg/utils"
 	"go.goms.io/fleet/pkg/utils/httpclient"
 	//+kubebuilder:scaffold:imports
@@ -60,6 +64,10 @@ var (
 	leaderElectionNamespace = flag.String("leader-election-namespace", "kube-system", "The namespace in which the leader election resource will be created.")
 	enableV1Alpha1APIs      = flag.Bool("enable-v1alpha1-apis", true, "If set, the agents will watch for the v1alpha1 APIs.")
 	enableV1Beta1APIs       = flag.Bool("enable-v1beta1-apis", false, "If set, the agents will watch for the v1beta1 APIs.")
+	propertyProvider        = flag.String("property-provider", propertyprovider.NodesPropertyProvider,
+		"The property provider to collect the properties and the resource usage of the member cluster. Valid values are: nodes, none.")
+	workApplyConcurrency = flag.Int("work-apply-concurrency", parallelizer.DefaultNumOfWorkers,
+		"The number of manifests of a work that the member agent applies in parallel.")
 )
 
 func init() {
@@ -89,6 +97,14 @@ func main() {
 		klog.ErrorS(errors.New("either enable-v1alpha1-apis or enable-v1beta1-apis is required"), "invalid APIs flags")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
+	if *propertyProvider != propertyprovider.NodesPropertyProvider && *propertyProvider != propertyprovider.NoPropertyProvider {
+		klog.ErrorS(fmt.Errorf("unknown property provider %q", *propertyProvider), "invalid property provider flag")
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
+	if *workApplyConcurrency < 1 {
+		klog.ErrorS(fmt.Errorf("work apply concurrency must be at least 1, got %d", *workApplyConcurrency), "invalid work apply concurrency flag")
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
 
 	hubURL := os.Getenv("HUB_SERVER_URL")
 
@@ -299,15 +315,34 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 			hubMgr.GetClient(),
 			spokeDynamicClient,
 			memberMgr.GetClient(),
-			restMapper, hubMgr.GetEventRecorderFor("work_controller"), 5, hubOpts.Namespace)
+			restMapper, hubMgr.GetEventRecorderFor("work_controller"), 5, *workApplyConcurrency, hubOpts.Namespace)
 
 		if err = workController.SetupWithManager(hubMgr); err != nil {
 			klog.ErrorS(err, "unable to create v1beta1 controller", "controller", "work")
 			return err
 		}
 
+		if err = workController.SetupRetainedAppliedWorkControllerWithManager(memberMgr); err != nil {
+			klog.ErrorS(err, "unable to create v1beta1 controller", "controller", "retained-applied-work")
+			return err
+		}
+
+		var pp propertyprovider.PropertyProvider
+		switch *propertyProvider {
+		case propertyprovider.NodesPropertyProvider:
+			klog.Info("Setting up the nodes property provider")
+			discoveryClient, err := discovery.NewDiscoveryClientForConfig(memberConfig)
+			if err != nil {
+				klog.ErrorS(err, "unable to create spoke discovery client")
+				return err
+			}
+			pp = nodes.New(memberMgr.GetAPIReader(), discoveryClient)
+		case propertyprovider.NoPropertyProvider:
+			klog.Info("No property provider is set up")
+		}
+
 		klog.Info("Setting up the internalMemberCluster v1beta1 controller")
-		if err = imcv1beta1.NewReconciler(hubMgr.GetClient(), memberMgr.GetClient(), workController).SetupWithManager(hubMgr); err != nil {
+		if err = imcv1beta1.NewReconciler(hubMgr.GetClient(), memberMgr.GetClient(), workController, pp).SetupWithManager(hubMgr); err != nil {
 			klog.ErrorS(err, "unable to create v1beta1 controller", "controller", "internalMemberCluster")
 			return fmt.Errorf("unable to create internalMemberCluster v1beta1 controller: %w", err)
 		}
@@ -155,7 +155,7 @@ func (r *Reconciler) handleUpdate(ctx context.Context, crp *fleetv1beta1.Cluster
 	}
 
 	// validate the resource selectors first before creating any snapshot
-	envelopeObjCount, selectedResources, selectedResourceIDs, err := r.selectResourcesForPlacement(crp)
+	envelopeObjCount, selectedResources, selectedResourceIDs, err := r.selectResourcesForPlacement(ctx, crp)
 	if err != nil {
 		klog.ErrorS(err, "Failed to select the resources", "clusterResourcePlacement", crpKObj)
 		if !errors.Is(err, controller.ErrUserError) {
@@ -191,8 +191,20 @@ func (r *Reconciler) handleUpdate(ctx context.Context, crp *fleetv1beta1.Cluster
 		return ctrl.Result{}, err
 	}
 
+	// the status observes the pinned resource snapshot which the bindings are rolled back to
+	observedResourceSnapshot := latestResourceSnapshot
+	if crp.Spec.PinnedResourceIndex != nil {
+		pinnedResourceSnapshot, err := r.lookupPinnedResourceSnapshot(ctx, crp)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if pinnedResourceSnapshot != nil {
+			observedResourceSnapshot = pinnedResourceSnapshot
+		}
+	}
+
 	// isClusterScheduled is to indicate whether we need to requeue the CRP request to track the rollout status.
-	isClusterScheduled, err := r.setPlacementStatus(ctx, crp, selectedResourceIDs, latestSchedulingPolicySnapshot, latestResourceSnapshot)
+	isClusterScheduled, err := r.setPlacementStatus(ctx, crp, selectedResourceIDs, latestSchedulingPolicySnapshot, observedResourceSnapshot)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -362,6 +374,7 @@ func (r *Reconciler) deleteRedundantSchedulingPolicySnapshots(ctx context.Contex
 }
 
 // deleteRedundantResourceSnapshots handles multiple snapshots in a group.
+// The snapshots with the resource index which the crp is pinned to are never deleted.
 func (r *Reconciler) deleteRedundantResourceSnapshots(ctx context.Context, crp *fleetv1beta1.ClusterResourcePlacement, revisionHistoryLimit int) error {
 	sortedList, err := r.listSortedResourceSnapshots(ctx, crp)
 	if err != nil {
@@ -388,6 +401,10 @@ func (r *Reconciler) deleteRedundantResourceSnapshots(ctx context.Context, crp *
 			klog.ErrorS(err, "Failed to parse the resource index label", "clusterResourcePlacement", crpKObj, "clusterResourceSnapshot", snapshotKObj)
 			return controller.NewUnexpectedBehaviorError(err)
 		}
+		if crp.Spec.PinnedResourceIndex != nil && ii == int(*crp.Spec.PinnedResourceIndex) {
+			// the pinned snapshots are retained as the bindings are rolled back to them, and are not counted
+			continue
+		}
 		if ii != lastGroupIndex {
 			groupCounter++
 			lastGroupIndex = ii
@@ -808,6 +825,28 @@ func (r *Reconciler) lookupLatestResourceSnapshot(ctx context.Context, crp *flee
 	return latestSnapshot, resourceIndex, nil
 }
 
+// lookupPinnedResourceSnapshot returns the master resource snapshot with the resource index which the crp is pinned to,
+// or nil if there is no such snapshot.
+func (r *Reconciler) lookupPinnedResourceSnapshot(ctx context.Context, crp *fleetv1beta1.ClusterResourcePlacement) (*fleetv1beta1.ClusterResourceSnapshot, error) {
+	snapshotList := &fleetv1beta1.ClusterResourceSnapshotList{}
+	pinnedSnapshotLabelMatcher := client.MatchingLabels{
+		fleetv1beta1.CRPTrackingLabel:   crp.Name,
+		fleetv1beta1.ResourceIndexLabel: strconv.Itoa(int(*crp.Spec.PinnedResourceIndex)),
+	}
+	if err := r.Client.List(ctx, snapshotList, pinnedSnapshotLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list the pinned clusterResourceSnapshots", "clusterResourcePlacement", klog.KObj(crp))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	for i := range snapshotList.Items {
+		// only the master snapshot has the resource group hash annotation
+		if len(snapshotList.Items[i].Annotations[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
+			return &snapshotList.Items[i], nil
+		}
+	}
+	klog.V(2).InfoS("The pinned clusterResourceSnapshot does not exist", "clusterResourcePlacement", klog.KObj(crp), "resourceIndex", *crp.Spec.PinnedResourceIndex)
+	return nil, nil
+}
+
 // listSortedResourceSnapshots returns the resource snapshots sorted by its index and its subindex.
 // The resourceSnapshot is less than the other one when resourceIndex is less.
 // When the resourceIndex is equal, then order by the subindex.
@@ -888,6 +927,8 @@ func generatePolicyHash(policy *fleetv1beta1.PlacementPolicy) (string, error) {
 	return fmt.Sprintf("%x", sha256.Sum256(jsonBytes)), nil
 }
 
+// generateResourceHash computes the hash of the selected resources. The resource contents are generated with the strip
+// rules applied, so that the changes of the stripped fields do not create new resource snapshots.
 func generateResourceHash(rs *fleetv1beta1.ResourceSnapshotSpec) (string, error) {
 	jsonBytes, err := json.Marshal(rs)
 	if err != nil {
@@ -911,6 +952,7 @@ func (r *Reconciler) setPlacementStatus(ctx context.Context, crp *fleetv1beta1.C
 	crp.Status.SelectedResources = selectedResourceIDs
 	scheduledCondition := buildScheduledCondition(crp, latestSchedulingPolicySnapshot)
 	crp.SetConditions(scheduledCondition)
+	setRolloutPausedCondition(crp)
 	// set ObservedResourceIndex from the latest resource snapshot's resource index label, before we set Synchronized, Applied conditions.
 	crp.Status.ObservedResourceIndex = latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel]
 
@@ -1081,7 +1123,10 @@ func (r *Reconciler) setResourcePlacementStatusAndResourceConditions(ctx context
 			ObservedGeneration: crp.Generation,
 		}
 		rp.ClusterName = c.ClusterName
-		if c.ClusterScore != nil {
+		switch {
+		case c.ClusterScore != nil && c.ClusterScore.ResourceAvailabilityScore != nil:
+			scheduledCondition.Message = fmt.Sprintf(resourcePlacementConditionScheduleSucceededWithAllScoresMessageFormat, c.ClusterName, *c.ClusterScore.AffinityScore, *c.ClusterScore.TopologySpreadScore, *c.ClusterScore.ResourceAvailabilityScore, c.Reason)
+		case c.ClusterScore != nil:
 			scheduledCondition.Message = fmt.Sprintf(resourcePlacementConditionScheduleSucceededWithScoreMessageFormat, c.ClusterName, *c.ClusterScore.AffinityScore, *c.ClusterScore.TopologySpreadScore, c.Reason)
 		}
 		oldConditions, ok := oldResourcePlacementStatusMap[c.ClusterName]
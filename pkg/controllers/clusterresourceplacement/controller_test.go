@@ -2832,6 +2832,72 @@ func TestGetOrCreateClusterResourceSnapshot_failure(t *testing.T) {
 	}
 }
 
+func TestDeleteRedundantResourceSnapshots(t *testing.T) {
+	masterSnapshot := func(index int) *fleetv1beta1.ClusterResourceSnapshot {
+		return &fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testName, index),
+				Labels: map[string]string{
+					fleetv1beta1.ResourceIndexLabel: strconv.Itoa(index),
+					fleetv1beta1.CRPTrackingLabel:   testName,
+				},
+				Annotations: map[string]string{
+					fleetv1beta1.ResourceGroupHashAnnotation:         "hash",
+					fleetv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+				},
+			},
+		}
+	}
+	tests := map[string]struct {
+		pinnedResourceIndex *int32
+		wantResourceIndexes []string
+	}{
+		"the oldest snapshots are deleted": {
+			wantResourceIndexes: []string{"2"},
+		},
+		"the pinned snapshot is retained": {
+			pinnedResourceIndex: pointer.Int32(0),
+			wantResourceIndexes: []string{"0", "2"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.PinnedResourceIndex = tt.pinnedResourceIndex
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(crp, masterSnapshot(0), masterSnapshot(1), masterSnapshot(2)).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+			}
+			if err := r.deleteRedundantResourceSnapshots(context.Background(), crp, int(multipleRevisionLimit)); err != nil {
+				t.Fatalf("deleteRedundantResourceSnapshots() got error %v, want no error", err)
+			}
+			clusterResourceSnapshotList := &fleetv1beta1.ClusterResourceSnapshotList{}
+			if err := fakeClient.List(context.Background(), clusterResourceSnapshotList); err != nil {
+				t.Fatalf("clusterResourceSnapshot List() got error %v, want no error", err)
+			}
+			gotResourceIndexes := make([]string, 0, len(clusterResourceSnapshotList.Items))
+			for _, snapshot := range clusterResourceSnapshotList.Items {
+				gotResourceIndexes = append(gotResourceIndexes, snapshot.Labels[fleetv1beta1.ResourceIndexLabel])
+			}
+			if diff := cmp.Diff(tt.wantResourceIndexes, gotResourceIndexes, cmpopts.SortSlices(func(i1, i2 string) bool { return i1 < i2 })); diff != "" {
+				t.Errorf("deleteRedundantResourceSnapshots() remaining snapshots mismatch (-want, +got):\n%s", diff)
+			}
+			if tt.pinnedResourceIndex == nil {
+				return
+			}
+			pinned, err := r.lookupPinnedResourceSnapshot(context.Background(), crp)
+			if err != nil || pinned == nil || pinned.Name != masterSnapshot(int(*tt.pinnedResourceIndex)).Name {
+				t.Errorf("lookupPinnedResourceSnapshot() = %v, %v, want the snapshot with resource index %d", pinned, err, *tt.pinnedResourceIndex)
+			}
+		})
+	}
+}
+
 func TestSplitSelectedResources(t *testing.T) {
 	// test service is 383 bytes in size.
 	serviceResourceContent := *serviceResourceContentForTest(t)
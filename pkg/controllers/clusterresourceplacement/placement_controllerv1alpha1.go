@@ -55,6 +55,10 @@ type Reconciler struct {
 	// SkippedNamespaces contains the namespaces that we should not propagate.
 	SkippedNamespaces map[string]bool
 
+	// StripRules contains the fields that we should not propagate for each kind of resources.
+	// It's only needed by v1beta1 APIs.
+	StripRules *utils.StripRules
+
 	Recorder record.EventRecorder
 
 	Scheme *runtime.Scheme
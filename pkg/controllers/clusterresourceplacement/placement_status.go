@@ -24,6 +24,10 @@ import (
 var (
 	// We only include 100 failed resource placements even if there are more than 100.
 	maxFailedResourcePlacementLimit = 100
+
+	// We only include 100 back reported statuses of up to 64KiB in total per cluster to keep the placement small.
+	maxBackReportedStatusLimit     = 100
+	maxBackReportedStatusTotalSize = 64 * 1024
 )
 
 // ClusterResourcePlacementStatus condition reasons
@@ -47,6 +51,9 @@ const (
 	ApplyPendingReason = "ApplyPending"
 	// ApplySucceededReason is the reason string of placement condition when the selected resources are applied successfully.
 	ApplySucceededReason = "ApplySucceeded"
+
+	// RolloutPausedReason is the reason string of placement condition when the rollout is paused.
+	RolloutPausedReason = "RolloutPaused"
 )
 
 // ResourcePlacementStatus condition reasons and message formats
@@ -71,12 +78,29 @@ const (
 	ResourceScheduleFailedReason = "ScheduleFailed"
 
 	// ResourcePlacementStatus schedule condition message formats
-	resourcePlacementConditionScheduleFailedMessageFormat             = "%s is not selected: %s"
-	resourcePlacementConditionScheduleFailedWithScoreMessageFormat    = "%s is not selected with clusterScore %+v: %s"
-	resourcePlacementConditionScheduleSucceededMessageFormat          = "Successfully scheduled resources for placement in %s: %s"
-	resourcePlacementConditionScheduleSucceededWithScoreMessageFormat = "Successfully scheduled resources for placement in %s (affinity score: %d, topology spread score: %d): %s"
+	resourcePlacementConditionScheduleFailedMessageFormat                 = "%s is not selected: %s"
+	resourcePlacementConditionScheduleFailedWithScoreMessageFormat        = "%s is not selected with clusterScore %+v: %s"
+	resourcePlacementConditionScheduleSucceededMessageFormat              = "Successfully scheduled resources for placement in %s: %s"
+	resourcePlacementConditionScheduleSucceededWithScoreMessageFormat     = "Successfully scheduled resources for placement in %s (affinity score: %d, topology spread score: %d): %s"
+	resourcePlacementConditionScheduleSucceededWithAllScoresMessageFormat = "Successfully scheduled resources for placement in %s (affinity score: %d, topology spread score: %d, resource availability score: %d): %s"
 )
 
+// setRolloutPausedCondition sets the rollout paused condition when the rollout of the placement is paused, or removes it
+// otherwise.
+func setRolloutPausedCondition(crp *fleetv1beta1.ClusterResourcePlacement) {
+	if !crp.Spec.Strategy.Paused {
+		meta.RemoveStatusCondition(&crp.Status.Conditions, string(fleetv1beta1.ClusterResourcePlacementRolloutPausedConditionType))
+		return
+	}
+	crp.SetConditions(metav1.Condition{
+		Status:             metav1.ConditionTrue,
+		Type:               string(fleetv1beta1.ClusterResourcePlacementRolloutPausedConditionType),
+		Reason:             RolloutPausedReason,
+		Message:            "The rollout is paused, the resources placed on the target clusters are not updated until it is resumed",
+		ObservedGeneration: crp.Generation,
+	})
+}
+
 func buildClusterResourcePlacementSyncCondition(crp *fleetv1beta1.ClusterResourcePlacement, pendingCount, succeededCount int) metav1.Condition {
 	klog.V(3).InfoS("Building the clusterResourcePlacement synchronized condition", "clusterResourcePlacement", klog.KObj(crp),
 		"numberOfSynchronizedPendingCluster", pendingCount, "numberOfSynchronizedSucceededCluster", succeededCount)
@@ -168,11 +192,16 @@ func (r *Reconciler) setWorkStatusForResourcePlacementStatus(ctx context.Context
 	pendingWorkCounter := 0 // The work has not been applied yet.
 
 	failedResourcePlacements := make([]fleetv1beta1.FailedResourcePlacement, 0, maxFailedResourcePlacementLimit) // preallocate the memory
+	var backReportedStatuses []fleetv1beta1.BackReportedResourceStatus
+	backReportedStatusSize := 0
 	for i := range workList.Items {
 		work := workList.Items[i]
 		if work.DeletionTimestamp != nil {
 			continue // ignore the deleting work
 		}
+		if work.Spec.DryRun || len(work.Spec.RolloutGates) != 0 {
+			continue // ignore the dry-run work and the rollout gate work as they do not place any resources
+		}
 		workKObj := klog.KObj(&work)
 		resourceIndexFromWork, err := labels.ExtractResourceSnapshotIndexFromWork(&work)
 		if err != nil {
@@ -192,6 +221,14 @@ func (r *Reconciler) setWorkStatusForResourcePlacementStatus(ctx context.Context
 			if len(failedManifests) != 0 && len(failedResourcePlacements) < maxFailedResourcePlacementLimit {
 				failedResourcePlacements = append(failedResourcePlacements, failedManifests...)
 			}
+			for _, backReportedStatus := range buildBackReportedStatuses(&work) {
+				size := len(backReportedStatus.BackReportedStatus.ObservedStatus.Raw)
+				if len(backReportedStatuses) >= maxBackReportedStatusLimit || backReportedStatusSize+size > maxBackReportedStatusTotalSize {
+					break
+				}
+				backReportedStatuses = append(backReportedStatuses, backReportedStatus)
+				backReportedStatusSize += size
+			}
 		}
 	}
 
@@ -203,6 +240,7 @@ func (r *Reconciler) setWorkStatusForResourcePlacementStatus(ctx context.Context
 		"numberOfPendingWorks", pendingWorkCounter, "numberOfFailedResources", len(failedResourcePlacements))
 
 	status.FailedPlacements = failedResourcePlacements
+	status.BackReportedStatuses = backReportedStatuses
 
 	isSync, workSynchronizedCondition := buildWorkSynchronizedCondition(crp, clusterResourceBinding)
 	meta.SetStatusCondition(&status.Conditions, workSynchronizedCondition)
@@ -296,49 +334,66 @@ func buildFailedResourcePlacements(work *fleetv1beta1.Work) (isPending bool, res
 		return false, nil
 	}
 
-	// check if the work is generated by an enveloped object
-	envelopeType, isEnveloped := work.GetLabels()[fleetv1beta1.EnvelopeTypeLabel]
-	var envelopObjName, envelopObjNamespace string
-	if isEnveloped {
-		// If the work  generated by an enveloped object, it must contain those labels.
-		envelopObjName = work.GetLabels()[fleetv1beta1.EnvelopeNameLabel]
-		envelopObjNamespace = work.GetLabels()[fleetv1beta1.EnvelopeNamespaceLabel]
-	}
 	res = make([]fleetv1beta1.FailedResourcePlacement, 0, len(work.Status.ManifestConditions))
 	for _, manifestCondition := range work.Status.ManifestConditions {
 		appliedCond = meta.FindStatusCondition(manifestCondition.Conditions, fleetv1beta1.WorkConditionTypeApplied)
-		// collect if there is an explicit fail
-		if appliedCond != nil && appliedCond.Status != metav1.ConditionTrue {
-			if isEnveloped {
+		// collect if there is an explicit fail; the manifests waiting for the previous apply waves are unknown
+		if appliedCond != nil && appliedCond.Status == metav1.ConditionFalse {
+			resourceIdentifier := buildResourceIdentifier(work, manifestCondition.Identifier)
+			if resourceIdentifier.Envelope != nil {
 				klog.V(2).InfoS("Find a failed to apply enveloped manifest",
 					"manifestName", manifestCondition.Identifier.Name,
 					"group", manifestCondition.Identifier.Group,
 					"version", manifestCondition.Identifier.Version, "kind", manifestCondition.Identifier.Kind,
-					"envelopeType", envelopeType, "envelopObjName", envelopObjName, "envelopObjNamespace", envelopObjNamespace)
+					"envelopeType", resourceIdentifier.Envelope.Type, "envelopObjName", resourceIdentifier.Envelope.Name,
+					"envelopObjNamespace", resourceIdentifier.Envelope.Namespace)
 			} else {
 				klog.V(2).InfoS("Find a failed to apply manifest",
 					"manifestName", manifestCondition.Identifier.Name, "group", manifestCondition.Identifier.Group,
 					"version", manifestCondition.Identifier.Version, "kind", manifestCondition.Identifier.Kind)
 			}
-			failedManifest := fleetv1beta1.FailedResourcePlacement{
-				ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
-					Group:     manifestCondition.Identifier.Group,
-					Version:   manifestCondition.Identifier.Version,
-					Kind:      manifestCondition.Identifier.Kind,
-					Name:      manifestCondition.Identifier.Name,
-					Namespace: manifestCondition.Identifier.Namespace,
-				},
-				Condition: *appliedCond,
-			}
-			if isEnveloped {
-				failedManifest.ResourceIdentifier.Envelope = &fleetv1beta1.EnvelopeIdentifier{
-					Name:      envelopObjName,
-					Namespace: envelopObjNamespace,
-					Type:      fleetv1beta1.EnvelopeType(envelopeType),
-				}
-			}
-			res = append(res, failedManifest)
+			res = append(res, fleetv1beta1.FailedResourcePlacement{
+				ResourceIdentifier: resourceIdentifier,
+				Condition:          *appliedCond,
+			})
 		}
 	}
 	return false, res
 }
+
+// buildBackReportedStatuses returns the statuses of the resources reported back by the member agent in the work.
+func buildBackReportedStatuses(work *fleetv1beta1.Work) []fleetv1beta1.BackReportedResourceStatus {
+	var res []fleetv1beta1.BackReportedResourceStatus
+	for _, manifestCondition := range work.Status.ManifestConditions {
+		if manifestCondition.BackReportedStatus == nil {
+			continue
+		}
+		res = append(res, fleetv1beta1.BackReportedResourceStatus{
+			ResourceIdentifier: buildResourceIdentifier(work, manifestCondition.Identifier),
+			BackReportedStatus: *manifestCondition.BackReportedStatus,
+		})
+	}
+	return res
+}
+
+// buildResourceIdentifier builds the identifier of a resource placed by the work, including the envelope object
+// if the work is generated by an enveloped object.
+func buildResourceIdentifier(work *fleetv1beta1.Work, identifier fleetv1beta1.WorkResourceIdentifier) fleetv1beta1.ResourceIdentifier {
+	res := fleetv1beta1.ResourceIdentifier{
+		Group:     identifier.Group,
+		Version:   identifier.Version,
+		Kind:      identifier.Kind,
+		Name:      identifier.Name,
+		Namespace: identifier.Namespace,
+	}
+	// check if the work is generated by an enveloped object
+	if envelopeType, isEnveloped := work.GetLabels()[fleetv1beta1.EnvelopeTypeLabel]; isEnveloped {
+		// If the work  generated by an enveloped object, it must contain those labels.
+		res.Envelope = &fleetv1beta1.EnvelopeIdentifier{
+			Name:      work.GetLabels()[fleetv1beta1.EnvelopeNameLabel],
+			Namespace: work.GetLabels()[fleetv1beta1.EnvelopeNamespaceLabel],
+			Type:      fleetv1beta1.EnvelopeType(envelopeType),
+		}
+	}
+	return res
+}
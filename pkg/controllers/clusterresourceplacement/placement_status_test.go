@@ -16,6 +16,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -2427,6 +2428,76 @@ func TestBuildFailedResourcePlacements(t *testing.T) {
 				},
 			},
 		},
+		"report only the failed manifest but not the ones waiting for the previous apply waves": {
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               fleetv1beta1.WorkConditionTypeApplied,
+							ObservedGeneration: 1,
+							Status:             metav1.ConditionFalse,
+						},
+					},
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   0,
+								Group:     corev1.GroupName,
+								Version:   "v1",
+								Kind:      "Namespace",
+								Name:      "app",
+							},
+							Conditions: []metav1.Condition{
+								{
+									Type:               fleetv1beta1.WorkConditionTypeApplied,
+									ObservedGeneration: 1,
+									Status:             metav1.ConditionFalse,
+									Reason:             "ManifestApplyFailed",
+								},
+							},
+						},
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   1,
+								Group:     corev1.GroupName,
+								Version:   "v1",
+								Kind:      "ServiceAccount",
+								Name:      "app-sa",
+								Namespace: "app",
+							},
+							Conditions: []metav1.Condition{
+								{
+									Type:               fleetv1beta1.WorkConditionTypeApplied,
+									ObservedGeneration: 1,
+									Status:             metav1.ConditionUnknown,
+									Reason:             "ManifestApplyWaiting",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantIsPending: false,
+			wantRes: []fleetv1beta1.FailedResourcePlacement{
+				{
+					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
+						Group:   corev1.GroupName,
+						Version: "v1",
+						Kind:    "Namespace",
+						Name:    "app",
+					},
+					Condition: metav1.Condition{
+						Type:               fleetv1beta1.WorkConditionTypeApplied,
+						ObservedGeneration: 1,
+						Status:             metav1.ConditionFalse,
+						Reason:             "ManifestApplyFailed",
+					},
+				},
+			},
+		},
 		"report failure if applied failed with an envelop object": {
 			work: &fleetv1beta1.Work{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2505,3 +2576,176 @@ func TestBuildFailedResourcePlacements(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildBackReportedStatuses(t *testing.T) {
+	observedStatus := runtime.RawExtension{Raw: []byte(`{"readyReplicas":3}`)}
+	tests := map[string]struct {
+		work *fleetv1beta1.Work
+		want []fleetv1beta1.BackReportedResourceStatus
+	}{
+		"no status reported back": {
+			work: &fleetv1beta1.Work{
+				Status: fleetv1beta1.WorkStatus{
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal: 0,
+								Group:   "apps",
+								Version: "v1",
+								Kind:    "Deployment",
+								Name:    "test-deployment",
+							},
+						},
+					},
+				},
+			},
+		},
+		"status reported back": {
+			work: &fleetv1beta1.Work{
+				Status: fleetv1beta1.WorkStatus{
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   0,
+								Group:     "apps",
+								Version:   "v1",
+								Kind:      "Deployment",
+								Name:      "test-deployment",
+								Namespace: "test-namespace",
+							},
+							BackReportedStatus: &fleetv1beta1.BackReportedStatus{
+								ObservedStatus: observedStatus,
+							},
+						},
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal: 1,
+								Version: "v1",
+								Kind:    "ConfigMap",
+								Name:    "test-cm",
+							},
+						},
+					},
+				},
+			},
+			want: []fleetv1beta1.BackReportedResourceStatus{
+				{
+					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
+						Group:     "apps",
+						Version:   "v1",
+						Kind:      "Deployment",
+						Name:      "test-deployment",
+						Namespace: "test-namespace",
+					},
+					BackReportedStatus: fleetv1beta1.BackReportedStatus{
+						ObservedStatus: observedStatus,
+					},
+				},
+			},
+		},
+		"status reported back by an enveloped object": {
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						fleetv1beta1.EnvelopeTypeLabel:      string(fleetv1beta1.ConfigMapEnvelopeType),
+						fleetv1beta1.EnvelopeNameLabel:      "test-env",
+						fleetv1beta1.EnvelopeNamespaceLabel: "test-env-ns",
+					},
+				},
+				Status: fleetv1beta1.WorkStatus{
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   0,
+								Group:     "apps",
+								Version:   "v1",
+								Kind:      "Deployment",
+								Name:      "test-deployment",
+								Namespace: "test-namespace",
+							},
+							BackReportedStatus: &fleetv1beta1.BackReportedStatus{
+								ObservedStatus: observedStatus,
+							},
+						},
+					},
+				},
+			},
+			want: []fleetv1beta1.BackReportedResourceStatus{
+				{
+					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
+						Group:     "apps",
+						Version:   "v1",
+						Kind:      "Deployment",
+						Name:      "test-deployment",
+						Namespace: "test-namespace",
+						Envelope: &fleetv1beta1.EnvelopeIdentifier{
+							Name:      "test-env",
+							Namespace: "test-env-ns",
+							Type:      fleetv1beta1.ConfigMapEnvelopeType,
+						},
+					},
+					BackReportedStatus: fleetv1beta1.BackReportedStatus{
+						ObservedStatus: observedStatus,
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := buildBackReportedStatuses(tt.work)
+			if diff := cmp.Diff(tt.want, got, statusCmpOptions...); diff != "" {
+				t.Errorf("buildBackReportedStatuses() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetRolloutPausedCondition(t *testing.T) {
+	scheduledCondition := metav1.Condition{
+		Status:             metav1.ConditionTrue,
+		Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+		Reason:             "Scheduled",
+		ObservedGeneration: 1,
+	}
+	pausedCondition := metav1.Condition{
+		Status:             metav1.ConditionTrue,
+		Type:               string(fleetv1beta1.ClusterResourcePlacementRolloutPausedConditionType),
+		Reason:             RolloutPausedReason,
+		ObservedGeneration: 1,
+	}
+	tests := map[string]struct {
+		paused     bool
+		conditions []metav1.Condition
+		want       []metav1.Condition
+	}{
+		"the rollout is paused": {
+			paused:     true,
+			conditions: []metav1.Condition{scheduledCondition},
+			want:       []metav1.Condition{scheduledCondition, pausedCondition},
+		},
+		"the rollout is resumed": {
+			conditions: []metav1.Condition{scheduledCondition, pausedCondition},
+			want:       []metav1.Condition{scheduledCondition},
+		},
+		"the rollout is not paused": {
+			conditions: []metav1.Condition{scheduledCondition},
+			want:       []metav1.Condition{scheduledCondition},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: testName, Generation: 1},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{Paused: tt.paused},
+				},
+				Status: fleetv1beta1.ClusterResourcePlacementStatus{Conditions: tt.conditions},
+			}
+			setRolloutPausedCondition(crp)
+			if diff := cmp.Diff(tt.want, crp.Status.Conditions, statusCmpOptions...); diff != "" {
+				t.Errorf("setRolloutPausedCondition() conditions mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,18 +6,21 @@ Licensed under the MIT license.
 package clusterresourceplacement
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	workv1alpha1 "sigs.k8s.io/work-api/pkg/apis/v1alpha1"
@@ -64,7 +67,13 @@ func (r *Reconciler) selectResources(placement *fleetv1alpha1.ClusterResourcePla
 func convertResourceSelector(old []fleetv1alpha1.ClusterResourceSelector) []fleetv1beta1.ClusterResourceSelector {
 	res := make([]fleetv1beta1.ClusterResourceSelector, len(old))
 	for i, item := range old {
-		res[i] = fleetv1beta1.ClusterResourceSelector(item)
+		res[i] = fleetv1beta1.ClusterResourceSelector{
+			Group:         item.Group,
+			Version:       item.Version,
+			Kind:          item.Kind,
+			Name:          item.Name,
+			LabelSelector: item.LabelSelector,
+		}
 	}
 	return res
 }
@@ -95,8 +104,13 @@ func (r *Reconciler) gatherSelectedResource(placement string, selectors []fleetv
 		}
 		resources = append(resources, objs...)
 	}
-	// sort the resources in strict order so that we will get the stable list of manifest so that
-	// the generated work object doesn't change between reconcile loops
+	sortResources(resources)
+	return resources, nil
+}
+
+// sortResources sorts the resources in strict order so that we will get the stable list of manifest so that
+// the generated work object doesn't change between reconcile loops.
+func sortResources(resources []runtime.Object) {
 	sort.Slice(resources, func(i, j int) bool {
 		obj1 := resources[i].DeepCopyObject().(*unstructured.Unstructured)
 		obj2 := resources[j].DeepCopyObject().(*unstructured.Unstructured)
@@ -112,9 +126,175 @@ func (r *Reconciler) gatherSelectedResource(placement string, selectors []fleetv
 		return strings.Compare(fmt.Sprintf("%s/%s", obj1.GetNamespace(), obj1.GetName()),
 			fmt.Sprintf("%s/%s", obj2.GetNamespace(), obj2.GetName())) > 0
 	})
+}
+
+// gatherSelectedNamespacedResource gets the namespace scoped resources in the namespace of a resource placement according
+// to the resource selectors of the cluster resource placement backing it.
+// Neither the namespace itself nor the other resources in the namespace are selected, and the selected envelope objects
+// can only wrap the namespace scoped resources in the same namespace.
+func (r *Reconciler) gatherSelectedNamespacedResource(placement, namespace string, selectors []fleetv1beta1.ClusterResourceSelector) ([]runtime.Object, error) {
+	if !utils.ShouldPropagateNamespace(namespace, r.SkippedNamespaces) {
+		err := fmt.Errorf("invalid resourcePlacement %s: namespace %s is not allowed to propagate", placement, namespace)
+		return nil, controller.NewUserError(err)
+	}
+	namespacedSelectors := make([]fleetv1beta1.NamespacedResourceSelector, len(selectors))
+	for i, selector := range selectors {
+		namespacedSelectors[i] = fleetv1beta1.NamespacedResourceSelector{
+			Group:         selector.Group,
+			Version:       selector.Version,
+			Kind:          selector.Kind,
+			Name:          selector.Name,
+			LabelSelector: selector.LabelSelector,
+		}
+	}
+	resources, err := r.fetchSelectedResourcesInNamespace(namespace, placement, namespacedSelectors)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.validateEnvelopedResourcesInNamespace(placement, namespace, resources); err != nil {
+		return nil, err
+	}
+	sortResources(resources)
 	return resources, nil
 }
 
+// validateEnvelopedResourcesInNamespace checks that the resources wrapped in the selected envelope objects are namespace
+// scoped resources in the given namespace, so that a resource placement cannot place the resources outside its own
+// namespace through an envelope.
+func (r *Reconciler) validateEnvelopedResourcesInNamespace(placement, namespace string, resources []runtime.Object) error {
+	for _, obj := range resources {
+		uObj := obj.(*unstructured.Unstructured)
+		envelopeType, isEnvelope := utils.GetEnvelopeType(uObj)
+		if !isEnvelope {
+			continue
+		}
+		manifests, err := utils.ExtractResFromEnvelope(uObj, envelopeType)
+		if err != nil {
+			return controller.NewUserError(fmt.Errorf("invalid resourcePlacement %s: envelope %s has invalid content: %w", placement, klog.KObj(uObj), err))
+		}
+		for _, manifest := range manifests {
+			var wrappedObj unstructured.Unstructured
+			if err := wrappedObj.UnmarshalJSON(manifest.Raw); err != nil {
+				return controller.NewUserError(fmt.Errorf("invalid resourcePlacement %s: envelope %s has invalid content: %w", placement, klog.KObj(uObj), err))
+			}
+			gvk := wrappedObj.GroupVersionKind()
+			restMapping, err := r.RestMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+			if err != nil {
+				return controller.NewUserError(fmt.Errorf("invalid resourcePlacement %s: failed to get GVR of the resource %s wrapped in envelope %s: %w",
+					placement, klog.KObj(&wrappedObj), klog.KObj(uObj), err))
+			}
+			if restMapping.Scope.Name() != meta.RESTScopeNameNamespace {
+				return controller.NewUserError(fmt.Errorf("invalid resourcePlacement %s: envelope %s wraps the cluster scoped resource %s %s",
+					placement, klog.KObj(uObj), gvk, wrappedObj.GetName()))
+			}
+			if wrappedObj.GetNamespace() != namespace {
+				return controller.NewUserError(fmt.Errorf("invalid resourcePlacement %s: envelope %s wraps the resource %s %s outside namespace %s",
+					placement, klog.KObj(uObj), gvk, klog.KObj(&wrappedObj), namespace))
+			}
+		}
+	}
+	return nil
+}
+
+// fetchSelectedResourcesInNamespace retrieves the namespace scoped objects in one namespace which match any of the selectors.
+func (r *Reconciler) fetchSelectedResourcesInNamespace(namespace, placeName string, selectors []fleetv1beta1.NamespacedResourceSelector) ([]runtime.Object, error) {
+	var resources []runtime.Object
+	// a resource can be selected by more than one selector, e.g., by its name and by its labels
+	selected := make(map[string]bool)
+	for _, selector := range selectors {
+		gvk := schema.GroupVersionKind{
+			Group:   selector.Group,
+			Version: selector.Version,
+			Kind:    selector.Kind,
+		}
+		if r.ResourceConfig.IsResourceDisabled(gvk) {
+			klog.V(2).InfoS("Skip select resource", "group version kind", gvk.String())
+			continue
+		}
+		objs, err := r.fetchNamespacedResources(selector, namespace, placeName)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			uObj := obj.(*unstructured.Unstructured)
+			key := fmt.Sprintf("%s/%s", uObj.GroupVersionKind().String(), uObj.GetName())
+			if selected[key] {
+				continue
+			}
+			selected[key] = true
+			resources = append(resources, obj)
+		}
+	}
+	return resources, nil
+}
+
+// fetchNamespacedResources retrieves the namespace scoped objects in one namespace based on the selector.
+func (r *Reconciler) fetchNamespacedResources(selector fleetv1beta1.NamespacedResourceSelector, namespace, placeName string) ([]runtime.Object, error) {
+	klog.V(2).InfoS("start to fetch the namespace scoped resources by the selector", "selector", selector, "namespace", namespace)
+	gk := schema.GroupKind{
+		Group: selector.Group,
+		Kind:  selector.Kind,
+	}
+	restMapping, err := r.RestMapper.RESTMapping(gk, selector.Version)
+	if err != nil {
+		return nil, controller.NewUserError(fmt.Errorf("invalid placement %s, failed to get GVR of the selector: %w", placeName, err))
+	}
+	gvr := restMapping.Resource
+	gvk := schema.GroupVersionKind{
+		Group:   selector.Group,
+		Version: selector.Version,
+		Kind:    selector.Kind,
+	}
+	if r.InformerManager.IsClusterScopedResources(gvk) {
+		return nil, controller.NewUserError(fmt.Errorf("invalid placement %s: %+v is not a namespace scoped resource", placeName, restMapping.Resource))
+	}
+	if !r.InformerManager.IsInformerSynced(gvr) {
+		return nil, controller.NewExpectedBehaviorError(fmt.Errorf("informer cache for %+v is not synced yet", restMapping.Resource))
+	}
+
+	lister := r.InformerManager.Lister(gvr).ByNamespace(namespace)
+	var objects []runtime.Object
+	if len(selector.Name) != 0 {
+		obj, err := lister.Get(selector.Name)
+		if err != nil {
+			klog.ErrorS(err, "cannot get the resource", "gvr", gvr, "namespace", namespace, "name", selector.Name)
+			return nil, controller.NewAPIServerError(true, client.IgnoreNotFound(err))
+		}
+		objects = []runtime.Object{obj}
+	} else {
+		labelSelector := labels.Everything()
+		if selector.LabelSelector != nil {
+			labelSelector, err = metav1.LabelSelectorAsSelector(selector.LabelSelector)
+			if err != nil {
+				return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("cannot convert the label selector to a selector: %w", err))
+			}
+		}
+		objects, err = lister.List(labelSelector)
+		if err != nil {
+			return nil, controller.NewAPIServerError(true, fmt.Errorf("cannot list all the objects of type %+v in namespace %s: %w", gvr, namespace, err))
+		}
+	}
+
+	var selectedObjs []runtime.Object
+	for _, obj := range objects {
+		uObj := obj.DeepCopyObject().(*unstructured.Unstructured)
+		if uObj.GetDeletionTimestamp() != nil {
+			klog.V(2).InfoS("skip the deleting namespace scoped resources by the selector",
+				"selector", selector, "placeName", placeName, "namespace", namespace, "resource name", uObj.GetName())
+			continue
+		}
+		shouldInclude, err := utils.ShouldPropagateObj(r.InformerManager, uObj)
+		if err != nil {
+			klog.ErrorS(err, "cannot determine if we should propagate an object", "object", klog.KObj(uObj))
+			return nil, err
+		}
+		if shouldInclude {
+			selectedObjs = append(selectedObjs, uObj)
+		}
+	}
+	return selectedObjs, nil
+}
+
 // fetchClusterScopedResources retrieves the objects based on the selector.
 func (r *Reconciler) fetchClusterScopedResources(selector fleetv1beta1.ClusterResourceSelector, placeName string) ([]runtime.Object, error) {
 	klog.V(2).InfoS("start to fetch the cluster scoped resources by the selector", "selector", selector)
@@ -194,7 +374,7 @@ func (r *Reconciler) fetchNamespaceResources(selector fleetv1beta1.ClusterResour
 
 	if len(selector.Name) != 0 {
 		// just a single namespace
-		objs, err := r.fetchAllResourcesInOneNamespace(selector.Name, placeName)
+		objs, err := r.fetchAllResourcesInOneNamespace(selector.Name, placeName, selector)
 		if err != nil {
 			klog.ErrorS(err, "failed to fetch all the selected resource in a namespace", "namespace", selector.Name)
 			return nil, err
@@ -223,7 +403,7 @@ func (r *Reconciler) fetchNamespaceResources(selector fleetv1beta1.ClusterResour
 		if err != nil {
 			return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("cannot get the name of a namespace object: %w", err))
 		}
-		objs, err := r.fetchAllResourcesInOneNamespace(ns.GetName(), placeName)
+		objs, err := r.fetchAllResourcesInOneNamespace(ns.GetName(), placeName, selector)
 		if err != nil {
 			klog.ErrorS(err, "failed to fetch all the selected resource in a namespace", "namespace", ns.GetName())
 			return nil, err
@@ -234,7 +414,9 @@ func (r *Reconciler) fetchNamespaceResources(selector fleetv1beta1.ClusterResour
 }
 
 // fetchAllResourcesInOneNamespace retrieves all the objects inside a single namespace which includes the namespace itself.
-func (r *Reconciler) fetchAllResourcesInOneNamespace(namespaceName string, placeName string) ([]runtime.Object, error) {
+// Only the namespace itself is selected in the NamespaceOnly selection scope, and only the objects matching any of the
+// namespaced resource selectors are selected with the namespace if they are specified.
+func (r *Reconciler) fetchAllResourcesInOneNamespace(namespaceName string, placeName string, selector fleetv1beta1.ClusterResourceSelector) ([]runtime.Object, error) {
 	var resources []runtime.Object
 
 	if !utils.ShouldPropagateNamespace(namespaceName, r.SkippedNamespaces) {
@@ -258,6 +440,17 @@ func (r *Reconciler) fetchAllResourcesInOneNamespace(namespaceName string, place
 	}
 	resources = append(resources, obj)
 
+	if selector.SelectionScope == fleetv1beta1.NamespaceOnly {
+		return resources, nil
+	}
+	if len(selector.NamespacedResourceSelectors) != 0 {
+		objs, err := r.fetchSelectedResourcesInNamespace(namespaceName, placeName, selector.NamespacedResourceSelectors)
+		if err != nil {
+			return nil, err
+		}
+		return append(resources, objs...), nil
+	}
+
 	trackedResource := r.InformerManager.GetNameSpaceScopedResources()
 	for _, gvr := range trackedResource {
 		if !r.shouldSelectResource(gvr) {
@@ -388,7 +581,10 @@ func generateManifest(object *unstructured.Unstructured) (*workv1alpha1.Manifest
 }
 
 // generateResourceContent creates a resource content from the unstructured obj.
-func generateResourceContent(object *unstructured.Unstructured) (*fleetv1beta1.ResourceContent, error) {
+// The fields matching the strip rules are removed before the content is generated, so that they are neither
+// propagated nor part of the resource snapshot hash.
+func generateResourceContent(object *unstructured.Unstructured, stripRules *utils.StripRules) (*fleetv1beta1.ResourceContent, error) {
+	stripRules.Apply(object)
 	rawContent, err := generateRawContent(object)
 	if err != nil {
 		return nil, controller.NewUnexpectedBehaviorError(err)
@@ -400,10 +596,20 @@ func generateResourceContent(object *unstructured.Unstructured) (*fleetv1beta1.R
 
 // selectResourcesForPlacement selects the resources according to the placement resourceSelectors.
 // It also generates an array of resource content and resource identifier based on the selected resources.
-// It also returns the number of envelope configmaps so the CRP controller can have the right expectation of the number of work objects.
-func (r *Reconciler) selectResourcesForPlacement(placement *fleetv1beta1.ClusterResourcePlacement) (int, []fleetv1beta1.ResourceContent, []fleetv1beta1.ResourceIdentifier, error) {
+// It also returns the number of envelope objects so the CRP controller can have the right expectation of the number of work objects.
+func (r *Reconciler) selectResourcesForPlacement(ctx context.Context, placement *fleetv1beta1.ClusterResourcePlacement) (int, []fleetv1beta1.ResourceContent, []fleetv1beta1.ResourceIdentifier, error) {
 	envelopeObjCount := 0
-	selectedObjects, err := r.gatherSelectedResource(placement.GetName(), placement.Spec.ResourceSelectors)
+	var selectedObjects []runtime.Object
+	namespace, isOwnedByRP, err := r.resourcePlacementNamespace(ctx, placement)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if isOwnedByRP {
+		// the placement backs a resource placement which selects the resources in its own namespace
+		selectedObjects, err = r.gatherSelectedNamespacedResource(placement.GetName(), namespace, placement.Spec.ResourceSelectors)
+	} else {
+		selectedObjects, err = r.gatherSelectedResource(placement.GetName(), placement.Spec.ResourceSelectors)
+	}
 	if err != nil {
 		return 0, nil, nil, err
 	}
@@ -412,12 +618,11 @@ func (r *Reconciler) selectResourcesForPlacement(placement *fleetv1beta1.Cluster
 	resourcesIDs := make([]fleetv1beta1.ResourceIdentifier, len(selectedObjects))
 	for i, obj := range selectedObjects {
 		unstructuredObj := obj.DeepCopyObject().(*unstructured.Unstructured)
-		rc, err := generateResourceContent(unstructuredObj)
+		rc, err := generateResourceContent(unstructuredObj, r.StripRules)
 		if err != nil {
 			return 0, nil, nil, err
 		}
-		if unstructuredObj.GetObjectKind().GroupVersionKind() == utils.ConfigMapGVK &&
-			len(unstructuredObj.GetAnnotations()[fleetv1beta1.EnvelopeConfigMapAnnotation]) != 0 {
+		if _, isEnvelope := utils.GetEnvelopeType(unstructuredObj); isEnvelope {
 			envelopeObjCount++
 		}
 		resources[i] = *rc
@@ -432,3 +637,31 @@ func (r *Reconciler) selectResourcesForPlacement(placement *fleetv1beta1.Cluster
 	}
 	return envelopeObjCount, resources, resourcesIDs, nil
 }
+
+// resourcePlacementNamespace returns the namespace of the resourcePlacement owning the placement and whether the
+// placement is owned by a resourcePlacement.
+// The owner is looked up by the name in the owner reference and the namespace label, and its UID must match the owner
+// reference, so that neither a stale owner nor a label set by the users can change the scope of the placement.
+func (r *Reconciler) resourcePlacementNamespace(ctx context.Context, placement *fleetv1beta1.ClusterResourcePlacement) (string, bool, error) {
+	owner, ok := utils.GetResourcePlacementOwner(placement)
+	if !ok {
+		if _, ok := placement.Labels[fleetv1beta1.ResourcePlacementNamespaceLabel]; ok {
+			return "", false, controller.NewUserError(fmt.Errorf("the placement has the %s label but is not owned by a resourcePlacement",
+				fleetv1beta1.ResourcePlacementNamespaceLabel))
+		}
+		return "", false, nil
+	}
+	namespace := placement.Labels[fleetv1beta1.ResourcePlacementNamespaceLabel]
+	rp := &fleetv1beta1.ResourcePlacement{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: owner.Name}, rp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", false, controller.NewUserError(fmt.Errorf("the owner resourcePlacement %s is not found in namespace %q", owner.Name, namespace))
+		}
+		klog.ErrorS(err, "Failed to get the owner resourcePlacement", "clusterResourcePlacement", klog.KObj(placement), "resourcePlacement", klog.KRef(namespace, owner.Name))
+		return "", false, controller.NewAPIServerError(true, err)
+	}
+	if rp.UID != owner.UID {
+		return "", false, controller.NewUserError(fmt.Errorf("the owner resourcePlacement %s is not found in namespace %q", owner.Name, namespace))
+	}
+	return namespace, true, nil
+}
@@ -6,6 +6,8 @@ Licensed under the MIT license.
 package clusterresourceplacement
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -14,15 +16,20 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	workv1alpha1 "sigs.k8s.io/work-api/pkg/apis/v1alpha1"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/controller"
 )
 
 func TestGenerateManifest(t *testing.T) {
@@ -763,7 +770,7 @@ func TestGenerateResourceContent(t *testing.T) {
 			if err != nil {
 				t.Fatalf("ToUnstructured failed: %v", err)
 			}
-			got, err := generateResourceContent(&unstructured.Unstructured{Object: object})
+			got, err := generateResourceContent(&unstructured.Unstructured{Object: object}, nil)
 			if err != nil {
 				t.Fatalf("failed to generateResourceContent(): %v", err)
 			}
@@ -775,6 +782,64 @@ func TestGenerateResourceContent(t *testing.T) {
 	}
 }
 
+func TestGenerateResourceContentWithStripRules(t *testing.T) {
+	stripRules, err := utils.NewStripRules([]utils.StripRule{
+		{
+			Version: "v1",
+			Kind:    "PersistentVolumeClaim",
+			Paths:   []string{"/spec/volumeName", "/metadata/annotations/pv.kubernetes.io~1bind-completed"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewStripRules() failed: %v", err)
+	}
+	pendingPVC := corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data",
+			Namespace: "app",
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: pointer.String("default"),
+		},
+	}
+	boundPVC := pendingPVC.DeepCopy()
+	boundPVC.Annotations = map[string]string{"pv.kubernetes.io/bind-completed": "yes"}
+	boundPVC.Spec.VolumeName = "pvc-1234"
+
+	generate := func(pvc *corev1.PersistentVolumeClaim) *fleetv1beta1.ResourceContent {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+		if err != nil {
+			t.Fatalf("ToUnstructured failed: %v", err)
+		}
+		got, err := generateResourceContent(&unstructured.Unstructured{Object: object}, stripRules)
+		if err != nil {
+			t.Fatalf("failed to generateResourceContent(): %v", err)
+		}
+		return got
+	}
+	pendingContent := generate(&pendingPVC)
+	boundContent := generate(boundPVC)
+	if diff := cmp.Diff(pendingContent, boundContent); diff != "" {
+		t.Errorf("generateResourceContent() mismatch (-want, +got):\n%s", diff)
+	}
+
+	pendingHash, err := generateResourceHash(&fleetv1beta1.ResourceSnapshotSpec{SelectedResources: []fleetv1beta1.ResourceContent{*pendingContent}})
+	if err != nil {
+		t.Fatalf("failed to generateResourceHash(): %v", err)
+	}
+	boundHash, err := generateResourceHash(&fleetv1beta1.ResourceSnapshotSpec{SelectedResources: []fleetv1beta1.ResourceContent{*boundContent}})
+	if err != nil {
+		t.Fatalf("failed to generateResourceHash(): %v", err)
+	}
+	if pendingHash != boundHash {
+		t.Errorf("generateResourceHash() = %s, want %s", boundHash, pendingHash)
+	}
+}
+
 func createResourceContentForTest(t *testing.T, obj interface{}) *fleetv1beta1.ResourceContent {
 	want, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
 	if err != nil {
@@ -794,3 +859,154 @@ func createResourceContentForTest(t *testing.T, obj interface{}) *fleetv1beta1.R
 		},
 	}
 }
+
+func TestValidateEnvelopedResourcesInNamespace(t *testing.T) {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	envelope := func(data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":        "envelope",
+					"namespace":   "app",
+					"annotations": map[string]interface{}{fleetv1beta1.EnvelopeConfigMapAnnotation: "true"},
+				},
+				"data": data,
+			},
+		}
+	}
+	tests := map[string]struct {
+		resources []runtime.Object
+		wantErr   bool
+	}{
+		"no envelope": {
+			resources: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "config", "namespace": "app"},
+					},
+				},
+			},
+		},
+		"envelope wraps the resources in the same namespace": {
+			resources: []runtime.Object{
+				envelope(map[string]interface{}{
+					"config.json": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"app"}}`,
+				}),
+			},
+		},
+		"envelope wraps a resource in another namespace": {
+			resources: []runtime.Object{
+				envelope(map[string]interface{}{
+					"config.json": `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"other"}}`,
+				}),
+			},
+			wantErr: true,
+		},
+		"envelope wraps a cluster scoped resource": {
+			resources: []runtime.Object{
+				envelope(map[string]interface{}{
+					"clusterrole.json": `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"admin"}}`,
+				}),
+			},
+			wantErr: true,
+		},
+		"envelope wraps a resource of an unknown kind": {
+			resources: []runtime.Object{
+				envelope(map[string]interface{}{
+					"unknown.json": `{"apiVersion":"example.com/v1","kind":"Unknown","metadata":{"name":"unknown","namespace":"app"}}`,
+				}),
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &Reconciler{RestMapper: restMapper}
+			err := r.validateEnvelopedResourcesInNamespace("test-placement", "app", tt.resources)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateEnvelopedResourcesInNamespace() error = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourcePlacementNamespace(t *testing.T) {
+	rp := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app", UID: "rp-uid"},
+	}
+	rpOwner := metav1.OwnerReference{
+		APIVersion: fleetv1beta1.GroupVersion.String(),
+		Kind:       fleetv1beta1.ResourcePlacementKind,
+		Name:       "web",
+		UID:        "rp-uid",
+		Controller: pointer.Bool(true),
+	}
+	tests := map[string]struct {
+		labels        map[string]string
+		owners        []metav1.OwnerReference
+		wantNamespace string
+		wantOwnedByRP bool
+		wantErr       error
+	}{
+		"placement which is not owned by a resourcePlacement": {
+			wantOwnedByRP: false,
+		},
+		"placement owned by a resourcePlacement": {
+			labels:        map[string]string{fleetv1beta1.ResourcePlacementNamespaceLabel: "app"},
+			owners:        []metav1.OwnerReference{rpOwner},
+			wantNamespace: "app",
+			wantOwnedByRP: true,
+		},
+		"placement with the namespace label but without the owner": {
+			labels:  map[string]string{fleetv1beta1.ResourcePlacementNamespaceLabel: "app"},
+			wantErr: controller.ErrUserError,
+		},
+		"placement with the namespace label of another namespace": {
+			labels:  map[string]string{fleetv1beta1.ResourcePlacementNamespaceLabel: "other"},
+			owners:  []metav1.OwnerReference{rpOwner},
+			wantErr: controller.ErrUserError,
+		},
+		"placement owned by a resourcePlacement with another UID": {
+			labels: map[string]string{fleetv1beta1.ResourcePlacementNamespaceLabel: "app"},
+			owners: []metav1.OwnerReference{
+				{
+					APIVersion: fleetv1beta1.GroupVersion.String(),
+					Kind:       fleetv1beta1.ResourcePlacementKind,
+					Name:       "web",
+					UID:        "stale-uid",
+					Controller: pointer.Bool(true),
+				},
+			},
+			wantErr: controller.ErrUserError,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			placement := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "rp-placement", Labels: tc.labels, OwnerReferences: tc.owners},
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(rp).Build(),
+			}
+			gotNamespace, gotOwnedByRP, err := r.resourcePlacementNamespace(context.Background(), placement)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("resourcePlacementNamespace() got error %v, want error %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resourcePlacementNamespace() got error %v, want no error", err)
+			}
+			if gotNamespace != tc.wantNamespace || gotOwnedByRP != tc.wantOwnedByRP {
+				t.Errorf("resourcePlacementNamespace() = (%q, %t), want (%q, %t)", gotNamespace, gotOwnedByRP, tc.wantNamespace, tc.wantOwnedByRP)
+			}
+		})
+	}
+}
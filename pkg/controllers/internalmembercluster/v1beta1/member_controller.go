@@ -12,7 +12,6 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/tools/record"
@@ -26,6 +25,7 @@ import (
 	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	"go.goms.io/fleet/pkg/controllers/work"
 	"go.goms.io/fleet/pkg/metrics"
+	"go.goms.io/fleet/pkg/propertyprovider"
 	"go.goms.io/fleet/pkg/utils/condition"
 )
 
@@ -39,6 +39,9 @@ type Reconciler struct {
 	// before updating the internal member cluster CR status
 	workController *work.ApplyWorkReconciler
 
+	// propertyProvider collects the properties and the resource usage of the member cluster.
+	propertyProvider propertyprovider.PropertyProvider
+
 	recorder record.EventRecorder
 }
 
@@ -56,16 +59,24 @@ const (
 	// EventReasonInternalMemberClusterLeft is the event type and reason string when the agent left.
 	EventReasonInternalMemberClusterLeft = "InternalMemberClusterLeft"
 
+	// PropertyCollectionSucceededReason is the reason string of the property collection condition when the
+	// properties are collected.
+	PropertyCollectionSucceededReason = "PropertyCollectionSucceeded"
+	// PropertyCollectionFailedReason is the reason string of the property collection condition when the
+	// property provider fails to collect the properties.
+	PropertyCollectionFailedReason = "PropertyCollectionFailed"
+
 	// we add +-5% jitter
 	jitterPercent = 10
 )
 
 // NewReconciler creates a new reconciler for the internalMemberCluster CR
-func NewReconciler(hubClient client.Client, memberClient client.Client, workController *work.ApplyWorkReconciler) *Reconciler {
+func NewReconciler(hubClient client.Client, memberClient client.Client, workController *work.ApplyWorkReconciler, propertyProvider propertyprovider.PropertyProvider) *Reconciler {
 	return &Reconciler{
-		hubClient:      hubClient,
-		memberClient:   memberClient,
-		workController: workController,
+		hubClient:        hubClient,
+		memberClient:     memberClient,
+		workController:   workController,
+		propertyProvider: propertyProvider,
 	}
 }
 
@@ -84,7 +95,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return ctrl.Result{}, err
 		}
 		updateMemberAgentHeartBeat(&imc)
-		updateHealthErr := r.updateHealth(ctx, &imc)
+		r.updateHealth(ctx, &imc)
 		r.markInternalMemberClusterJoined(&imc)
 		if err := r.updateInternalMemberClusterWithRetry(ctx, &imc); err != nil {
 			if apierrors.IsConflict(err) {
@@ -94,10 +105,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			}
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
-		if updateHealthErr != nil {
-			klog.ErrorS(updateHealthErr, "failed to update health", "imc", klog.KObj(&imc))
-			return ctrl.Result{}, updateHealthErr
-		}
 		// add jitter to the heart beat to mitigate the herding of multiple agents
 		hbinterval := 1000 * imc.Spec.HeartbeatPeriodSeconds
 		jitterRange := int64(hbinterval*jitterPercent) / 100
@@ -149,47 +156,46 @@ func (r *Reconciler) stopAgents(ctx context.Context, imc *clusterv1beta1.Interna
 	return nil
 }
 
-// updateHealth collects and updates member cluster resource stats and set ConditionTypeInternalMemberClusterHealth.
-func (r *Reconciler) updateHealth(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
+// updateHealth collects and updates member cluster properties and resource usage, and sets ConditionTypeInternalMemberClusterHealth.
+func (r *Reconciler) updateHealth(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) {
 	klog.V(2).InfoS("updateHealth", "InternalMemberCluster", klog.KObj(imc))
 
-	if err := r.updateResourceStats(ctx, imc); err != nil {
-		r.markInternalMemberClusterUnhealthy(imc, fmt.Errorf("failed to update resource stats %s: %w", klog.KObj(imc), err))
-		return err
-	}
-
+	r.updateProperties(ctx, imc)
 	r.markInternalMemberClusterHealthy(imc)
-	return nil
 }
 
-// updateResourceStats collects and updates resource usage stats of the member cluster.
-func (r *Reconciler) updateResourceStats(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
-	klog.V(2).InfoS("updateResourceStats", "InternalMemberCluster", klog.KObj(imc))
-	var nodes corev1.NodeList
-	if err := r.memberClient.List(ctx, &nodes); err != nil {
-		return fmt.Errorf("failed to list nodes for member cluster %s: %w", klog.KObj(imc), err)
-	}
-
-	var capacityCPU, capacityMemory, allocatableCPU, allocatableMemory resource.Quantity
-
-	for _, node := range nodes.Items {
-		capacityCPU.Add(*(node.Status.Capacity.Cpu()))
-		capacityMemory.Add(*(node.Status.Capacity.Memory()))
-		allocatableCPU.Add(*(node.Status.Allocatable.Cpu()))
-		allocatableMemory.Add(*(node.Status.Allocatable.Memory()))
+// updateProperties collects and updates the properties and the resource usage of the member cluster
+// via the property provider, and sets the AgentPropertyCollectionSucceeded condition.
+//
+// A failed collection does not fail the heartbeat, as the member agent is still healthy; the properties
+// collected before are kept, whose observation time tells how stale they are.
+func (r *Reconciler) updateProperties(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) {
+	klog.V(2).InfoS("updateProperties", "InternalMemberCluster", klog.KObj(imc))
+	if r.propertyProvider == nil {
+		// No property provider is set up; the member cluster reports no property.
+		return
 	}
 
-	imc.Status.ResourceUsage.Capacity = corev1.ResourceList{
-		corev1.ResourceCPU:    capacityCPU,
-		corev1.ResourceMemory: capacityMemory,
-	}
-	imc.Status.ResourceUsage.Allocatable = corev1.ResourceList{
-		corev1.ResourceCPU:    allocatableCPU,
-		corev1.ResourceMemory: allocatableMemory,
+	res, err := r.propertyProvider.Collect(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect the properties", "InternalMemberCluster", klog.KObj(imc))
+		imc.SetConditionsWithType(clusterv1beta1.MemberAgent, metav1.Condition{
+			Type:               string(clusterv1beta1.AgentPropertyCollectionSucceeded),
+			Status:             metav1.ConditionFalse,
+			Reason:             PropertyCollectionFailedReason,
+			Message:            fmt.Sprintf("failed to collect the properties: %v", err),
+			ObservedGeneration: imc.GetGeneration(),
+		})
+		return
 	}
-	imc.Status.ResourceUsage.ObservationTime = metav1.Now()
-
-	return nil
+	imc.Status.Properties = res.Properties
+	imc.Status.ResourceUsage = res.Resources
+	imc.SetConditionsWithType(clusterv1beta1.MemberAgent, metav1.Condition{
+		Type:               string(clusterv1beta1.AgentPropertyCollectionSucceeded),
+		Status:             metav1.ConditionTrue,
+		Reason:             PropertyCollectionSucceededReason,
+		ObservedGeneration: imc.GetGeneration(),
+	})
 }
 
 // updateInternalMemberClusterWithRetry updates InternalMemberCluster status.
@@ -14,10 +14,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	"go.goms.io/fleet/pkg/controllers/work"
+	nodeprovider "go.goms.io/fleet/pkg/propertyprovider/nodes"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
 	"go.goms.io/fleet/pkg/utils"
 )
 
@@ -59,9 +62,11 @@ var _ = Describe("Test Internal Member Cluster Controller", func() {
 
 		By("create the internalMemberCluster reconciler")
 		workController := work.NewApplyWorkReconciler(
-			k8sClient, nil, k8sClient, nil, nil, 5, memberClusterNamespace)
-		r = NewReconciler(k8sClient, k8sClient, workController)
-		err := r.SetupWithManager(mgr)
+			k8sClient, nil, k8sClient, nil, nil, 5, parallelizer.DefaultNumOfWorkers, memberClusterNamespace)
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		r = NewReconciler(k8sClient, k8sClient, workController, nodeprovider.New(k8sClient, discoveryClient))
+		err = r.SetupWithManager(mgr)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
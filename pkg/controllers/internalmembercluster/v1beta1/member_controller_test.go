@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	"go.goms.io/fleet/pkg/propertyprovider"
 	"go.goms.io/fleet/pkg/utils"
 )
 
@@ -108,6 +109,69 @@ func TestMarkInternalMemberClusterHeartbeatUnhealthy(t *testing.T) {
 	assert.Equal(t, "", cmp.Diff(expectedCondition, *(actualCondition), cmpopts.IgnoreTypes(time.Time{})), utils.TestCaseMsg, "TestMarkInternalMemberClusterHeartbeatUnhealthy")
 }
 
+// fakePropertyProvider is a property provider which returns the given response or error.
+type fakePropertyProvider struct {
+	res *propertyprovider.PropertyCollectionResponse
+	err error
+}
+
+func (p *fakePropertyProvider) Collect(_ context.Context) (*propertyprovider.PropertyCollectionResponse, error) {
+	return p.res, p.err
+}
+
+func TestUpdateProperties(t *testing.T) {
+	oldProperties := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		clusterv1beta1.NodeCountProperty: {Value: "1"},
+	}
+	newProperties := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		clusterv1beta1.NodeCountProperty: {Value: "2"},
+	}
+	tests := map[string]struct {
+		propertyProvider propertyprovider.PropertyProvider
+		wantProperties   map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+		wantCondition    *metav1.Condition
+	}{
+		"no property provider": {
+			wantProperties: oldProperties,
+		},
+		"properties are collected": {
+			propertyProvider: &fakePropertyProvider{res: &propertyprovider.PropertyCollectionResponse{Properties: newProperties}},
+			wantProperties:   newProperties,
+			wantCondition: &metav1.Condition{
+				Type:   string(clusterv1beta1.AgentPropertyCollectionSucceeded),
+				Status: metav1.ConditionTrue,
+				Reason: PropertyCollectionSucceededReason,
+			},
+		},
+		"failed to collect the properties": {
+			propertyProvider: &fakePropertyProvider{err: errors.New("list error")},
+			wantProperties:   oldProperties,
+			wantCondition: &metav1.Condition{
+				Type:    string(clusterv1beta1.AgentPropertyCollectionSucceeded),
+				Status:  metav1.ConditionFalse,
+				Reason:  PropertyCollectionFailedReason,
+				Message: "failed to collect the properties: list error",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			imc := &clusterv1beta1.InternalMemberCluster{
+				Status: clusterv1beta1.InternalMemberClusterStatus{Properties: oldProperties},
+			}
+			r := Reconciler{propertyProvider: tt.propertyProvider}
+			r.updateProperties(context.Background(), imc)
+			if diff := cmp.Diff(tt.wantProperties, imc.Status.Properties); diff != "" {
+				t.Errorf("updateProperties() properties mismatch (-want, +got):\n%s", diff)
+			}
+			gotCondition := imc.GetConditionWithType(clusterv1beta1.MemberAgent, string(clusterv1beta1.AgentPropertyCollectionSucceeded))
+			if diff := cmp.Diff(tt.wantCondition, gotCondition, cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
+				t.Errorf("updateProperties() condition mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 	lessRetriesForRetriable := 0
 	lessRetriesForNonRetriable := 0
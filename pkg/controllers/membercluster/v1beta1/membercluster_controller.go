@@ -443,6 +443,8 @@ func (r *Reconciler) syncInternalMemberClusterStatus(imc *clusterv1beta1.Interna
 	r.aggregateJoinedCondition(mc)
 	// Copy resource usages.
 	mc.Status.ResourceUsage = imc.Status.ResourceUsage
+	// Copy properties.
+	mc.Status.Properties = imc.Status.Properties
 }
 
 // updateMemberClusterStatus is used to update member cluster status.
@@ -701,8 +701,17 @@ func TestSyncInternalMemberClusterStatus(t *testing.T) {
 						Allocatable: corev1.ResourceList{
 							corev1.ResourceMemory: resource.MustParse("1Gi"),
 						},
+						Available: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
 						ObservationTime: now,
 					},
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						clusterv1beta1.NodeCountProperty: {
+							Value:           "3",
+							ObservationTime: now,
+						},
+					},
 					AgentStatus: []clusterv1beta1.AgentStatus{
 						{
 							Type: clusterv1beta1.MemberAgent,
@@ -746,8 +755,17 @@ func TestSyncInternalMemberClusterStatus(t *testing.T) {
 						Allocatable: corev1.ResourceList{
 							corev1.ResourceMemory: resource.MustParse("1Gi"),
 						},
+						Available: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
 						ObservationTime: now,
 					},
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						clusterv1beta1.NodeCountProperty: {
+							Value:           "3",
+							ObservationTime: now,
+						},
+					},
 					AgentStatus: []clusterv1beta1.AgentStatus{
 						{
 							Type: clusterv1beta1.MemberAgent,
@@ -0,0 +1,100 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package overrider
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/controller"
+	"go.goms.io/fleet/pkg/utils/validator"
+)
+
+// ClusterResourceReconciler reconciles a clusterResourceOverride object.
+type ClusterResourceReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile creates a new clusterResourceOverrideSnapshot when the spec of the clusterResourceOverride has changed.
+func (r *ClusterResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	name := req.NamespacedName
+	cro := fleetv1beta1.ClusterResourceOverride{}
+	croKRef := klog.KRef(name.Namespace, name.Name)
+
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation starts", "clusterResourceOverride", croKRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation ends", "clusterResourceOverride", croKRef, "latency", latency)
+	}()
+
+	if err := r.Client.Get(ctx, name, &cro); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound clusterResourceOverride", "clusterResourceOverride", croKRef)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get clusterResourceOverride", "clusterResourceOverride", croKRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if cro.DeletionTimestamp != nil {
+		// The snapshots are owned by the clusterResourceOverride and will be deleted by the garbage collector.
+		klog.V(4).InfoS("Ignoring the clusterResourceOverride which is being deleted", "clusterResourceOverride", croKRef)
+		return ctrl.Result{}, nil
+	}
+	// validate the clusterResourceOverride just in case the validation webhook is not enabled, so that an invalid
+	// override is never applied to the placed resources
+	if err := validator.ValidateClusterResourceOverride(&cro); err != nil {
+		klog.ErrorS(controller.NewUserError(err), "Ignoring the invalid clusterResourceOverride", "clusterResourceOverride", croKRef)
+		return ctrl.Result{}, nil
+	}
+	if _, err := r.getOrCreateClusterResourceOverrideSnapshot(ctx, &cro, int(fleetv1beta1.RevisionHistoryLimitDefaultValue)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// clusterResourceOverrideSnapshotType describes the snapshots of the clusterResourceOverrides.
+var clusterResourceOverrideSnapshotType = snapshotType{
+	overrideKind:    "clusterResourceOverride",
+	snapshotKind:    "clusterResourceOverrideSnapshot",
+	newSnapshotList: func() client.ObjectList { return &fleetv1beta1.ClusterResourceOverrideSnapshotList{} },
+	newSnapshot: func(override client.Object, hash string) client.Object {
+		return &fleetv1beta1.ClusterResourceOverrideSnapshot{
+			Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+				OverrideSpec: *override.(*fleetv1beta1.ClusterResourceOverride).Spec.DeepCopy(),
+				OverrideHash: []byte(hash),
+			},
+		}
+	},
+	snapshotHash: func(snapshot client.Object) string {
+		return string(snapshot.(*fleetv1beta1.ClusterResourceOverrideSnapshot).Spec.OverrideHash)
+	},
+}
+
+func (r *ClusterResourceReconciler) getOrCreateClusterResourceOverrideSnapshot(ctx context.Context, cro *fleetv1beta1.ClusterResourceOverride, revisionHistoryLimit int) (*fleetv1beta1.ClusterResourceOverrideSnapshot, error) {
+	snapshot, err := getOrCreateOverrideSnapshot(ctx, r.Client, r.Scheme, cro, &cro.Spec, clusterResourceOverrideSnapshotType, revisionHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.(*fleetv1beta1.ClusterResourceOverrideSnapshot), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&fleetv1beta1.ClusterResourceOverride{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package overrider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+const (
+	testOverrideName = "override-1"
+	testNamespace    = "app"
+	fleetAPIVersion  = "placement.kubernetes-fleet.io/v1beta1"
+)
+
+var (
+	cmpOptions = []cmp.Option{
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+	}
+)
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	return scheme
+}
+
+func overridePolicyForTest() *fleetv1beta1.OverridePolicy {
+	return &fleetv1beta1.OverridePolicy{
+		OverrideRules: []fleetv1beta1.OverrideRule{
+			{
+				JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+					{
+						Operator: fleetv1beta1.JSONPatchOverrideOpRemove,
+						Path:     "/metadata/labels/app",
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterResourceOverrideForTest() *fleetv1beta1.ClusterResourceOverride {
+	return &fleetv1beta1.ClusterResourceOverride{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       fleetv1beta1.ClusterResourceOverrideKind,
+			APIVersion: fleetAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testOverrideName,
+		},
+		Spec: fleetv1beta1.ClusterResourceOverrideSpec{
+			ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+					Name:    "clusterrole-name",
+				},
+			},
+			Policy: overridePolicyForTest(),
+		},
+	}
+}
+
+func overrideHash(t *testing.T, spec interface{}) []byte {
+	jsonBytes, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("failed to create the override hash: %v", err)
+	}
+	return []byte(fmt.Sprintf("%x", sha256.Sum256(jsonBytes)))
+}
+
+func clusterResourceOverrideSnapshotForTest(index int, isLatest bool, hash []byte) fleetv1beta1.ClusterResourceOverrideSnapshot {
+	return fleetv1beta1.ClusterResourceOverrideSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf(fleetv1beta1.OverrideSnapshotNameFmt, testOverrideName, index),
+			Labels: map[string]string{
+				fleetv1beta1.OverrideTrackingLabel: testOverrideName,
+				fleetv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(isLatest),
+				fleetv1beta1.OverrideIndexLabel:    strconv.Itoa(index),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:               testOverrideName,
+					BlockOwnerDeletion: pointer.Bool(true),
+					Controller:         pointer.Bool(true),
+					APIVersion:         fleetAPIVersion,
+					Kind:               fleetv1beta1.ClusterResourceOverrideKind,
+				},
+			},
+		},
+		Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+			OverrideSpec: clusterResourceOverrideForTest().Spec,
+			OverrideHash: hash,
+		},
+	}
+}
+
+func TestGetOrCreateClusterResourceOverrideSnapshot(t *testing.T) {
+	hash := overrideHash(t, clusterResourceOverrideForTest().Spec)
+	oldHash := []byte("old-hash")
+	tests := []struct {
+		name                 string
+		revisionHistoryLimit int
+		snapshots            []fleetv1beta1.ClusterResourceOverrideSnapshot
+		wantSnapshots        []fleetv1beta1.ClusterResourceOverrideSnapshot
+		wantLatestIndex      int
+	}{
+		{
+			name:                 "new override and no existing snapshots",
+			revisionHistoryLimit: 10,
+			wantSnapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, true, hash),
+			},
+			wantLatestIndex: 0,
+		},
+		{
+			name:                 "override has not been changed",
+			revisionHistoryLimit: 10,
+			snapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, true, hash),
+			},
+			wantSnapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, true, hash),
+			},
+			wantLatestIndex: 1,
+		},
+		{
+			name:                 "override has been changed",
+			revisionHistoryLimit: 10,
+			snapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, true, oldHash),
+			},
+			wantSnapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, true, hash),
+			},
+			wantLatestIndex: 1,
+		},
+		{
+			name:                 "override has been reverted and the latest snapshot is not marked as latest",
+			revisionHistoryLimit: 10,
+			snapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, false, hash),
+			},
+			wantSnapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, true, hash),
+			},
+			wantLatestIndex: 1,
+		},
+		{
+			name:                 "override has been changed and the snapshots exceed the revision history limit",
+			revisionHistoryLimit: 2,
+			snapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(1, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(2, true, oldHash),
+			},
+			wantSnapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(2, false, oldHash),
+				clusterResourceOverrideSnapshotForTest(3, true, hash),
+			},
+			wantLatestIndex: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cro := clusterResourceOverrideForTest()
+			objects := []client.Object{cro}
+			for i := range tc.snapshots {
+				objects = append(objects, &tc.snapshots[i])
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				Build()
+			r := ClusterResourceReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+			}
+			got, err := r.getOrCreateClusterResourceOverrideSnapshot(ctx, cro, tc.revisionHistoryLimit)
+			if err != nil {
+				t.Fatalf("getOrCreateClusterResourceOverrideSnapshot() failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantSnapshots[tc.wantLatestIndex], *got, cmpOptions...); diff != "" {
+				t.Errorf("getOrCreateClusterResourceOverrideSnapshot() mismatch (-want, +got):\n%s", diff)
+			}
+			snapshotList := &fleetv1beta1.ClusterResourceOverrideSnapshotList{}
+			if err := fakeClient.List(ctx, snapshotList); err != nil {
+				t.Fatalf("clusterResourceOverrideSnapshot List() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantSnapshots, snapshotList.Items, cmpOptions...); diff != "" {
+				t.Errorf("clusterResourceOverrideSnapshot List() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetOrCreateClusterResourceOverrideSnapshot_failure(t *testing.T) {
+	tests := []struct {
+		name      string
+		snapshots []fleetv1beta1.ClusterResourceOverrideSnapshot
+	}{
+		{
+			name: "multiple active snapshots",
+			snapshots: []fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterResourceOverrideSnapshotForTest(0, true, []byte("hash-0")),
+				clusterResourceOverrideSnapshotForTest(1, true, []byte("hash-1")),
+			},
+		},
+		{
+			// Should never hit this case unless there is a bug in the controller or customers manually modify the snapshot.
+			name: "existing active snapshot has an invalid override index label",
+			snapshots: func() []fleetv1beta1.ClusterResourceOverrideSnapshot {
+				s := clusterResourceOverrideSnapshotForTest(0, true, []byte("hash-0"))
+				s.Labels[fleetv1beta1.OverrideIndexLabel] = "-1"
+				return []fleetv1beta1.ClusterResourceOverrideSnapshot{s}
+			}(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cro := clusterResourceOverrideForTest()
+			objects := []client.Object{cro}
+			for i := range tc.snapshots {
+				objects = append(objects, &tc.snapshots[i])
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				Build()
+			r := ClusterResourceReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+			}
+			if _, err := r.getOrCreateClusterResourceOverrideSnapshot(ctx, cro, 10); err == nil {
+				t.Errorf("getOrCreateClusterResourceOverrideSnapshot() got no error, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package overrider features controllers to reconcile the override objects and create the immutable override snapshots.
+package overrider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/controller"
+)
+
+// parseOverrideIndexFromLabel returns error when parsing the label which should never return error in production.
+func parseOverrideIndexFromLabel(s metav1.Object) (int, error) {
+	indexLabel := s.GetLabels()[fleetv1beta1.OverrideIndexLabel]
+	v, err := strconv.Atoi(indexLabel)
+	if err != nil || v < 0 {
+		return -1, fmt.Errorf("invalid override index %q, error: %w", indexLabel, err)
+	}
+	return v, nil
+}
+
+func generateOverrideHash(spec interface{}) (string, error) {
+	jsonBytes, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(jsonBytes)), nil
+}
+
+// snapshotType describes the override snapshots of one type of override objects, so that the snapshots of both
+// clusterResourceOverrides and resourceOverrides are managed in the same way.
+type snapshotType struct {
+	// overrideKind and snapshotKind are the kinds used in the logs, e.g., clusterResourceOverride.
+	overrideKind string
+	snapshotKind string
+	// newSnapshotList returns an empty list of the snapshots.
+	newSnapshotList func() client.ObjectList
+	// newSnapshot builds the snapshot of the spec of the override with the given hash.
+	newSnapshot func(override client.Object, hash string) client.Object
+	// snapshotHash returns the override hash recorded in the snapshot.
+	snapshotHash func(snapshot client.Object) string
+}
+
+// getOrCreateOverrideSnapshot returns the latest snapshot of the override, and creates a new one when the spec of the
+// override has changed. The snapshots are created in the namespace of the override, which is empty for the cluster
+// scoped ones.
+func getOrCreateOverrideSnapshot(ctx context.Context, c client.Client, scheme *runtime.Scheme, override client.Object,
+	spec interface{}, t snapshotType, revisionHistoryLimit int) (client.Object, error) {
+	overrideKObj := klog.KObj(override)
+	overrideHash, err := generateOverrideHash(spec)
+	if err != nil {
+		klog.ErrorS(err, "Failed to generate override hash", t.overrideKind, overrideKObj)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+
+	// latestSnapshotIndex should be -1 when there is no snapshot.
+	latestSnapshot, latestSnapshotIndex, err := lookupLatestOverrideSnapshot(ctx, c, override, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if latestSnapshot != nil && t.snapshotHash(latestSnapshot) == overrideHash {
+		if latestSnapshot.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel] != strconv.FormatBool(true) {
+			// It could happen when the controller just sets the latest label to false for the old snapshot, and fails to
+			// create a new override snapshot.
+			// And then the customers revert back their override to the old one again.
+			latestSnapshot.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel] = strconv.FormatBool(true)
+			if err := c.Update(ctx, latestSnapshot); err != nil {
+				klog.ErrorS(err, "Failed to update the override snapshot", t.snapshotKind, klog.KObj(latestSnapshot))
+				return nil, controller.NewUpdateIgnoreConflictError(err)
+			}
+		}
+		klog.V(2).InfoS("Override has not been changed", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(latestSnapshot))
+		return latestSnapshot, nil
+	}
+
+	// Need to create new snapshot when 1) there is no snapshots or 2) the latest snapshot hash != current one.
+	// mark the last override snapshot as inactive if it is different from what we have now
+	if latestSnapshot != nil && latestSnapshot.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel] == strconv.FormatBool(true) {
+		// set the latest label to false first to make sure there is only one or none active override snapshot
+		latestSnapshot.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel] = strconv.FormatBool(false)
+		if err := c.Update(ctx, latestSnapshot); err != nil {
+			klog.ErrorS(err, "Failed to set the isLatestSnapshot label to false", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(latestSnapshot))
+			return nil, controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Marked the existing override snapshot as inactive", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(latestSnapshot))
+	}
+
+	// delete redundant snapshot revisions before creating a new snapshot to guarantee that the number of snapshots
+	// won't exceed the limit.
+	if err := deleteRedundantOverrideSnapshots(ctx, c, override, t, revisionHistoryLimit); err != nil {
+		return nil, err
+	}
+
+	latestSnapshotIndex++
+	latestSnapshot = t.newSnapshot(override, overrideHash)
+	latestSnapshot.SetName(fmt.Sprintf(fleetv1beta1.OverrideSnapshotNameFmt, override.GetName(), latestSnapshotIndex))
+	latestSnapshot.SetNamespace(override.GetNamespace())
+	latestSnapshot.SetLabels(map[string]string{
+		fleetv1beta1.OverrideTrackingLabel: override.GetName(),
+		fleetv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(true),
+		fleetv1beta1.OverrideIndexLabel:    strconv.Itoa(latestSnapshotIndex),
+	})
+	snapshotKObj := klog.KObj(latestSnapshot)
+	if err := controllerutil.SetControllerReference(override, latestSnapshot, scheme); err != nil {
+		klog.ErrorS(err, "Failed to set owner reference", t.snapshotKind, snapshotKObj)
+		// should never happen
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	if err := c.Create(ctx, latestSnapshot); err != nil {
+		klog.ErrorS(err, "Failed to create new override snapshot", t.snapshotKind, snapshotKObj)
+		return nil, controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created new override snapshot", t.overrideKind, overrideKObj, t.snapshotKind, snapshotKObj)
+	return latestSnapshot, nil
+}
+
+func deleteRedundantOverrideSnapshots(ctx context.Context, c client.Client, override client.Object, t snapshotType, revisionHistoryLimit int) error {
+	sortedSnapshots, err := listSortedOverrideSnapshots(ctx, c, override, t)
+	if err != nil {
+		return err
+	}
+	// In normal situation, The max of len(sortedSnapshots) should be revisionHistoryLimit.
+	// We just need to delete one snapshot before creating a new one.
+	// As a result of defensive programming, it will delete any redundant snapshots which could be more than one.
+	for i := 0; i <= len(sortedSnapshots)-revisionHistoryLimit; i++ { // need to reserve one slot for the new snapshot
+		if err := c.Delete(ctx, sortedSnapshots[i]); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete override snapshot", t.overrideKind, klog.KObj(override), t.snapshotKind, klog.KObj(sortedSnapshots[i]))
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+	return nil
+}
+
+// lookupLatestOverrideSnapshot finds the latest snapshot and its override index.
+// There will be only one active override snapshot if exists.
+// It first checks whether there is an active override snapshot.
+// If not, it finds the one whose override index label is the largest.
+// The override index will always start from 0.
+func lookupLatestOverrideSnapshot(ctx context.Context, c client.Client, override client.Object, t snapshotType) (client.Object, int, error) {
+	snapshotList := t.newSnapshotList()
+	latestSnapshotLabelMatcher := client.MatchingLabels{
+		fleetv1beta1.OverrideTrackingLabel: override.GetName(),
+		fleetv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(true),
+	}
+	overrideKObj := klog.KObj(override)
+	if err := c.List(ctx, snapshotList, client.InNamespace(override.GetNamespace()), latestSnapshotLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list active override snapshots", t.overrideKind, overrideKObj)
+		return nil, -1, controller.NewAPIServerError(true, err)
+	}
+	snapshots, err := extractOverrideSnapshots(snapshotList)
+	if err != nil {
+		return nil, -1, err
+	}
+	var latestSnapshot client.Object
+	switch {
+	case len(snapshots) == 1:
+		latestSnapshot = snapshots[0]
+	case len(snapshots) > 1:
+		// It means there are multiple active snapshots and should never happen.
+		err := fmt.Errorf("there are %d active override snapshots owned by %s %v", len(snapshots), t.overrideKind, overrideKObj)
+		klog.ErrorS(err, "Invalid override snapshots", t.overrideKind, overrideKObj)
+		return nil, -1, controller.NewUnexpectedBehaviorError(err)
+	default:
+		// When there are no active snapshots, find the one who has the largest override index.
+		sortedSnapshots, err := listSortedOverrideSnapshots(ctx, c, override, t)
+		if err != nil {
+			return nil, -1, err
+		}
+		if len(sortedSnapshots) == 0 {
+			// The override index of the first snapshot will start from 0.
+			return nil, -1, nil
+		}
+		latestSnapshot = sortedSnapshots[len(sortedSnapshots)-1]
+	}
+	overrideIndex, err := parseOverrideIndexFromLabel(latestSnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse the override index label", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(latestSnapshot))
+		return nil, -1, controller.NewUnexpectedBehaviorError(err)
+	}
+	return latestSnapshot, overrideIndex, nil
+}
+
+// listSortedOverrideSnapshots returns the override snapshots sorted by the override index.
+func listSortedOverrideSnapshots(ctx context.Context, c client.Client, override client.Object, t snapshotType) ([]client.Object, error) {
+	snapshotList := t.newSnapshotList()
+	overrideKObj := klog.KObj(override)
+	if err := c.List(ctx, snapshotList, client.InNamespace(override.GetNamespace()), client.MatchingLabels{fleetv1beta1.OverrideTrackingLabel: override.GetName()}); err != nil {
+		klog.ErrorS(err, "Failed to list all override snapshots", t.overrideKind, overrideKObj)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	snapshots, err := extractOverrideSnapshots(snapshotList)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	sort.Slice(snapshots, func(i, j int) bool {
+		ii, err := parseOverrideIndexFromLabel(snapshots[i])
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse the override index label", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(snapshots[i]))
+			errs = append(errs, err)
+		}
+		ji, err := parseOverrideIndexFromLabel(snapshots[j])
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse the override index label", t.overrideKind, overrideKObj, t.snapshotKind, klog.KObj(snapshots[j]))
+			errs = append(errs, err)
+		}
+		return ii < ji
+	})
+	if len(errs) > 0 {
+		return nil, controller.NewUnexpectedBehaviorError(utilerrors.NewAggregate(errs))
+	}
+	return snapshots, nil
+}
+
+// extractOverrideSnapshots returns the snapshots in the list, which point to the items of the list.
+func extractOverrideSnapshots(snapshotList client.ObjectList) ([]client.Object, error) {
+	items, err := meta.ExtractList(snapshotList)
+	if err != nil {
+		// should never happen
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	snapshots := make([]client.Object, len(items))
+	for i := range items {
+		snapshot, ok := items[i].(client.Object)
+		if !ok {
+			return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("unexpected override snapshot type %T", items[i]))
+		}
+		snapshots[i] = snapshot
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package overrider
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/controller"
+)
+
+// ResourceReconciler reconciles a resourceOverride object.
+type ResourceReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile creates a new resourceOverrideSnapshot when the spec of the resourceOverride has changed.
+func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	name := req.NamespacedName
+	ro := fleetv1beta1.ResourceOverride{}
+	roKRef := klog.KRef(name.Namespace, name.Name)
+
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation starts", "resourceOverride", roKRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation ends", "resourceOverride", roKRef, "latency", latency)
+	}()
+
+	if err := r.Client.Get(ctx, name, &ro); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound resourceOverride", "resourceOverride", roKRef)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get resourceOverride", "resourceOverride", roKRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if ro.DeletionTimestamp != nil {
+		// The snapshots are owned by the resourceOverride and will be deleted by the garbage collector.
+		klog.V(4).InfoS("Ignoring the resourceOverride which is being deleted", "resourceOverride", roKRef)
+		return ctrl.Result{}, nil
+	}
+	if _, err := r.getOrCreateResourceOverrideSnapshot(ctx, &ro, int(fleetv1beta1.RevisionHistoryLimitDefaultValue)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// resourceOverrideSnapshotType describes the snapshots of the resourceOverrides.
+var resourceOverrideSnapshotType = snapshotType{
+	overrideKind:    "resourceOverride",
+	snapshotKind:    "resourceOverrideSnapshot",
+	newSnapshotList: func() client.ObjectList { return &fleetv1beta1.ResourceOverrideSnapshotList{} },
+	newSnapshot: func(override client.Object, hash string) client.Object {
+		return &fleetv1beta1.ResourceOverrideSnapshot{
+			Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+				OverrideSpec: *override.(*fleetv1beta1.ResourceOverride).Spec.DeepCopy(),
+				OverrideHash: []byte(hash),
+			},
+		}
+	},
+	snapshotHash: func(snapshot client.Object) string {
+		return string(snapshot.(*fleetv1beta1.ResourceOverrideSnapshot).Spec.OverrideHash)
+	},
+}
+
+func (r *ResourceReconciler) getOrCreateResourceOverrideSnapshot(ctx context.Context, ro *fleetv1beta1.ResourceOverride, revisionHistoryLimit int) (*fleetv1beta1.ResourceOverrideSnapshot, error) {
+	snapshot, err := getOrCreateOverrideSnapshot(ctx, r.Client, r.Scheme, ro, &ro.Spec, resourceOverrideSnapshotType, revisionHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.(*fleetv1beta1.ResourceOverrideSnapshot), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&fleetv1beta1.ResourceOverride{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package overrider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func resourceOverrideForTest() *fleetv1beta1.ResourceOverride {
+	return &fleetv1beta1.ResourceOverride{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       fleetv1beta1.ResourceOverrideKind,
+			APIVersion: fleetAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOverrideName,
+			Namespace: testNamespace,
+		},
+		Spec: fleetv1beta1.ResourceOverrideSpec{
+			ResourceSelectors: []fleetv1beta1.ResourceSelector{
+				{
+					Group:   "apps",
+					Version: "v1",
+					Kind:    "Deployment",
+					Name:    "deployment-name",
+				},
+			},
+			Policy: overridePolicyForTest(),
+		},
+	}
+}
+
+func resourceOverrideSnapshotForTest(namespace string, index int, isLatest bool, hash []byte) fleetv1beta1.ResourceOverrideSnapshot {
+	return fleetv1beta1.ResourceOverrideSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(fleetv1beta1.OverrideSnapshotNameFmt, testOverrideName, index),
+			Namespace: namespace,
+			Labels: map[string]string{
+				fleetv1beta1.OverrideTrackingLabel: testOverrideName,
+				fleetv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(isLatest),
+				fleetv1beta1.OverrideIndexLabel:    strconv.Itoa(index),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:               testOverrideName,
+					BlockOwnerDeletion: pointer.Bool(true),
+					Controller:         pointer.Bool(true),
+					APIVersion:         fleetAPIVersion,
+					Kind:               fleetv1beta1.ResourceOverrideKind,
+				},
+			},
+		},
+		Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+			OverrideSpec: resourceOverrideForTest().Spec,
+			OverrideHash: hash,
+		},
+	}
+}
+
+func TestGetOrCreateResourceOverrideSnapshot(t *testing.T) {
+	hash := overrideHash(t, resourceOverrideForTest().Spec)
+	oldHash := []byte("old-hash")
+	tests := []struct {
+		name                 string
+		revisionHistoryLimit int
+		snapshots            []fleetv1beta1.ResourceOverrideSnapshot
+		wantSnapshots        []fleetv1beta1.ResourceOverrideSnapshot
+		wantLatestIndex      int
+	}{
+		{
+			name:                 "new override and no existing snapshots",
+			revisionHistoryLimit: 10,
+			wantSnapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 0, true, hash),
+			},
+			wantLatestIndex: 0,
+		},
+		{
+			name:                 "snapshots of the override with the same name in another namespace are ignored",
+			revisionHistoryLimit: 10,
+			snapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest("other", 0, true, oldHash),
+			},
+			wantSnapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 0, true, hash),
+				resourceOverrideSnapshotForTest("other", 0, true, oldHash),
+			},
+			wantLatestIndex: 0,
+		},
+		{
+			name:                 "override has not been changed",
+			revisionHistoryLimit: 10,
+			snapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 0, true, hash),
+			},
+			wantSnapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 0, true, hash),
+			},
+			wantLatestIndex: 0,
+		},
+		{
+			name:                 "override has been changed and the snapshots reach the revision history limit",
+			revisionHistoryLimit: 2,
+			snapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 0, false, oldHash),
+				resourceOverrideSnapshotForTest(testNamespace, 1, true, oldHash),
+			},
+			wantSnapshots: []fleetv1beta1.ResourceOverrideSnapshot{
+				resourceOverrideSnapshotForTest(testNamespace, 1, false, oldHash),
+				resourceOverrideSnapshotForTest(testNamespace, 2, true, hash),
+			},
+			wantLatestIndex: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			ro := resourceOverrideForTest()
+			objects := []client.Object{ro}
+			for i := range tc.snapshots {
+				objects = append(objects, &tc.snapshots[i])
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				Build()
+			r := ResourceReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+			}
+			got, err := r.getOrCreateResourceOverrideSnapshot(ctx, ro, tc.revisionHistoryLimit)
+			if err != nil {
+				t.Fatalf("getOrCreateResourceOverrideSnapshot() failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantSnapshots[tc.wantLatestIndex], *got, cmpOptions...); diff != "" {
+				t.Errorf("getOrCreateResourceOverrideSnapshot() mismatch (-want, +got):\n%s", diff)
+			}
+			snapshotList := &fleetv1beta1.ResourceOverrideSnapshotList{}
+			if err := fakeClient.List(ctx, snapshotList); err != nil {
+				t.Fatalf("resourceOverrideSnapshot List() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantSnapshots, snapshotList.Items, cmpOptions...); diff != "" {
+				t.Errorf("resourceOverrideSnapshot List() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -84,6 +84,15 @@ func (r *Reconciler) Reconcile(_ context.Context, key controller.QueueKey) (ctrl
 	}
 	// we will use the parent namespace object to search for the affected placements
 	if !isClusterScoped {
+		// the placements backing the resource placements select the namespace scoped resources directly
+		var res *unstructured.Unstructured
+		if clusterObj != nil {
+			res = clusterObj.(*unstructured.Unstructured)
+		}
+		if err := r.triggerAffectedResourcePlacements(clusterWideKey, res); err != nil {
+			klog.ErrorS(err, "Failed to trigger the resource placements in the namespace", "obj", clusterWideKey)
+			return ctrl.Result{}, err
+		}
 		clusterObj, err = r.InformerManager.Lister(utils.NamespaceGVR).Get(clusterWideKey.Namespace)
 		if err != nil {
 			klog.ErrorS(err, "Failed to find the namespace the resource belongs to", "obj", clusterWideKey)
@@ -181,6 +190,46 @@ func (r *Reconciler) findPlacementsSelectedDeletedResV1Beta1(res keys.ClusterWid
 	}
 }
 
+// triggerAffectedResourcePlacements finds the v1beta1 placements backing the resource placements in the namespace of a
+// given namespace scoped resource, which select the resource or have selected it before. The res is nil if the resource
+// has been deleted.
+func (r *Reconciler) triggerAffectedResourcePlacements(key keys.ClusterWideKey, res *unstructured.Unstructured) error {
+	if r.PlacementControllerV1Beta1 == nil {
+		return nil
+	}
+	labelSelector := labels.SelectorFromSet(labels.Set{placementv1beta1.ResourcePlacementNamespaceLabel: key.Namespace})
+	labeledCRPs, err := r.InformerManager.Lister(utils.ClusterResourcePlacementGVR).List(labelSelector)
+	if err != nil {
+		return fmt.Errorf("failed to list the v1beta1 cluster placements in namespace %s: %w", key.Namespace, err)
+	}
+	// only the placements owned by the resource placements select the resources in the namespace
+	crpList := make([]runtime.Object, 0, len(labeledCRPs))
+	for _, crp := range labeledCRPs {
+		crpMeta, err := meta.Accessor(crp)
+		if err != nil {
+			return fmt.Errorf("failed to access the metadata of the v1beta1 cluster placement: %w", err)
+		}
+		if _, ok := utils.GetResourcePlacementOwner(crpMeta); ok {
+			crpList = append(crpList, crp)
+		}
+	}
+	if res == nil {
+		r.findPlacementsSelectedDeletedResV1Beta1(key, crpList)
+		return nil
+	}
+
+	matchedCRPs := collectAllAffectedPlacementsV1Beta1(res, crpList)
+	if len(matchedCRPs) == 0 {
+		klog.V(2).InfoS("change in object does not affect any v1beta1 resource placement", "obj", key)
+		return nil
+	}
+	for crp := range matchedCRPs {
+		klog.V(2).InfoS("Change in object triggered v1beta1 resource placement reconcile", "obj", key, "crp", crp)
+		r.PlacementControllerV1Beta1.Enqueue(crp)
+	}
+	return nil
+}
+
 // getUnstructuredObject retrieves an unstructured object by its gvknn key, this will hit the informer cache
 func (r *Reconciler) getUnstructuredObject(objectKey keys.ClusterWideKey) (runtime.Object, bool, error) {
 	restMapping, err := r.RestMapper.RESTMapping(objectKey.GroupKind(), objectKey.Version)
@@ -0,0 +1,275 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package resourceplacement features a controller to reconcile the resourcePlacement object by creating a
+// clusterResourcePlacement to place the resources it selects in its namespace.
+package resourceplacement
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/controller"
+	"go.goms.io/fleet/pkg/utils/validator"
+)
+
+const (
+	// clusterResourcePlacementNameFmt is the format of the name of the clusterResourcePlacement backing a resourcePlacement.
+	clusterResourcePlacementNameFmt = "rp-%s"
+
+	// clusterResourcePlacementNameHashLength is the length of the hash of the resourcePlacement namespace and name in the
+	// name of the clusterResourcePlacement backing it.
+	clusterResourcePlacementNameHashLength = 16
+)
+
+// Reconciler reconciles a resourcePlacement object.
+type Reconciler struct {
+	client.Client
+}
+
+// Reconcile creates or updates the clusterResourcePlacement backing the resourcePlacement and reports its status back
+// to the resourcePlacement.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	name := req.NamespacedName
+	rp := fleetv1beta1.ResourcePlacement{}
+	rpKRef := klog.KRef(name.Namespace, name.Name)
+
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation starts", "resourcePlacement", rpKRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation ends", "resourcePlacement", rpKRef, "latency", latency)
+	}()
+
+	if err := r.Client.Get(ctx, name, &rp); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound resourcePlacement", "resourcePlacement", rpKRef)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get resourcePlacement", "resourcePlacement", rpKRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	if rp.DeletionTimestamp != nil {
+		return ctrl.Result{}, r.handleDelete(ctx, &rp)
+	}
+
+	// register finalizer
+	// The cluster scoped clusterResourcePlacement cannot be garbage collected with the namespaced resourcePlacement
+	// owning it, so it is deleted by the controller instead of the garbage collector.
+	if !controllerutil.ContainsFinalizer(&rp, fleetv1beta1.ResourcePlacementCleanupFinalizer) {
+		controllerutil.AddFinalizer(&rp, fleetv1beta1.ResourcePlacementCleanupFinalizer)
+		if err := r.Client.Update(ctx, &rp); err != nil {
+			klog.ErrorS(err, "Failed to add resourcePlacement finalizer", "resourcePlacement", rpKRef)
+			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+	}
+
+	// validate the resourcePlacement just in case the validation webhook is not enabled, so that the clusterResourcePlacement
+	// backing it never places the resources beyond its namespace
+	if err := validator.ValidateResourcePlacement(&rp); err != nil {
+		klog.ErrorS(controller.NewUserError(err), "Ignoring the invalid resourcePlacement", "resourcePlacement", rpKRef)
+		return ctrl.Result{}, nil
+	}
+
+	crp, err := r.syncClusterResourcePlacement(ctx, &rp)
+	if err != nil || crp == nil {
+		return ctrl.Result{}, err
+	}
+
+	status := buildResourcePlacementStatus(&rp, crp)
+	if equality.Semantic.DeepEqual(rp.Status, status) {
+		return ctrl.Result{}, nil
+	}
+	rp.Status = status
+	if err := r.Client.Status().Update(ctx, &rp); err != nil {
+		klog.ErrorS(err, "Failed to update the resourcePlacement status", "resourcePlacement", rpKRef)
+		return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the resourcePlacement status", "resourcePlacement", rpKRef, "clusterResourcePlacement", klog.KObj(crp))
+	return ctrl.Result{}, nil
+}
+
+// handleDelete deletes the clusterResourcePlacement backing the resourcePlacement and removes the finalizer, so that
+// the placed resources are removed from the member clusters with the resourcePlacement.
+func (r *Reconciler) handleDelete(ctx context.Context, rp *fleetv1beta1.ResourcePlacement) error {
+	rpKObj := klog.KObj(rp)
+	if !controllerutil.ContainsFinalizer(rp, fleetv1beta1.ResourcePlacementCleanupFinalizer) {
+		klog.V(4).InfoS("resourcePlacement is being deleted and no cleanup work needs to be done", "resourcePlacement", rpKObj)
+		return nil
+	}
+	crp := &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterResourcePlacementName(rp.Namespace, rp.Name)},
+	}
+	if err := r.Client.Delete(ctx, crp); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Deleted the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+
+	controllerutil.RemoveFinalizer(rp, fleetv1beta1.ResourcePlacementCleanupFinalizer)
+	if err := r.Client.Update(ctx, rp); err != nil {
+		klog.ErrorS(err, "Failed to remove resourcePlacement finalizer", "resourcePlacement", rpKObj)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Removed rp-cleanup finalizer", "resourcePlacement", rpKObj)
+	return nil
+}
+
+// syncClusterResourcePlacement creates the clusterResourcePlacement backing the resourcePlacement or updates its spec
+// when the spec of the resourcePlacement has changed. It returns nil if the clusterResourcePlacement is being deleted,
+// and the resourcePlacement will be reconciled again once it's gone.
+func (r *Reconciler) syncClusterResourcePlacement(ctx context.Context, rp *fleetv1beta1.ResourcePlacement) (*fleetv1beta1.ClusterResourcePlacement, error) {
+	rpKObj := klog.KObj(rp)
+	desired := buildClusterResourcePlacement(rp)
+	crp := &fleetv1beta1.ClusterResourcePlacement{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: desired.Name}, crp); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(desired))
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		if err := r.Client.Create(ctx, desired); err != nil {
+			klog.ErrorS(err, "Failed to create the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(desired))
+			return nil, controller.NewCreateIgnoreAlreadyExistError(err)
+		}
+		klog.V(2).InfoS("Created the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(desired))
+		return desired, nil
+	}
+
+	if owner, ok := utils.GetResourcePlacementOwner(crp); !ok || owner.UID != rp.UID || crp.Labels[fleetv1beta1.ResourcePlacementNamespaceLabel] != rp.Namespace {
+		err := fmt.Errorf("clusterResourcePlacement %s already exists and does not belong to resourcePlacement %s", crp.Name, rpKObj)
+		klog.ErrorS(err, "Name conflict of the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+		return nil, controller.NewUserError(err)
+	}
+	if crp.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Waiting for the deleting clusterResourcePlacement to be gone", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+		return nil, nil
+	}
+	if equality.Semantic.DeepEqual(crp.Spec, desired.Spec) {
+		return crp, nil
+	}
+	crp.Spec = desired.Spec
+	if err := r.Client.Update(ctx, crp); err != nil {
+		klog.ErrorS(err, "Failed to update the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+		return nil, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the clusterResourcePlacement", "resourcePlacement", rpKObj, "clusterResourcePlacement", klog.KObj(crp))
+	return crp, nil
+}
+
+// clusterResourcePlacementName returns the name of the clusterResourcePlacement backing a resourcePlacement.
+// The name is derived from the hash of the namespace and the name of the resourcePlacement so that it's unique in the
+// cluster and fits in the length limit of the clusterResourcePlacement name.
+func clusterResourcePlacementName(namespace, name string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", namespace, name)))
+	return fmt.Sprintf(clusterResourcePlacementNameFmt, hex.EncodeToString(hash[:])[:clusterResourcePlacementNameHashLength])
+}
+
+// buildClusterResourcePlacement builds the clusterResourcePlacement backing the resourcePlacement.
+// The clusterResourcePlacement is controlled by the resourcePlacement, which scopes the selected resources to the
+// namespace of the resourcePlacement.
+func buildClusterResourcePlacement(rp *fleetv1beta1.ResourcePlacement) *fleetv1beta1.ClusterResourcePlacement {
+	selectors := make([]fleetv1beta1.ClusterResourceSelector, len(rp.Spec.ResourceSelectors))
+	for i, selector := range rp.Spec.ResourceSelectors {
+		selectors[i] = fleetv1beta1.ClusterResourceSelector{
+			Group:         selector.Group,
+			Version:       selector.Version,
+			Kind:          selector.Kind,
+			Name:          selector.Name,
+			LabelSelector: selector.LabelSelector.DeepCopy(),
+		}
+	}
+	return &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterResourcePlacementName(rp.Namespace, rp.Name),
+			Labels: map[string]string{
+				fleetv1beta1.ResourcePlacementNamespaceLabel: rp.Namespace,
+				fleetv1beta1.ResourcePlacementNameLabel:      rp.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: fleetv1beta1.GroupVersion.String(),
+					Kind:       fleetv1beta1.ResourcePlacementKind,
+					Name:       rp.Name,
+					UID:        rp.UID,
+					Controller: pointer.Bool(true),
+				},
+			},
+		},
+		Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+			ResourceSelectors:    selectors,
+			Policy:               rp.Spec.Policy.DeepCopy(),
+			Strategy:             *rp.Spec.Strategy.DeepCopy(),
+			RevisionHistoryLimit: rp.Spec.RevisionHistoryLimit,
+		},
+	}
+}
+
+// buildResourcePlacementStatus builds the status of the resourcePlacement from the status of the clusterResourcePlacement
+// backing it.
+// The conditions observing the current generation of the clusterResourcePlacement observe the current generation of
+// the resourcePlacement, as the spec of the clusterResourcePlacement is in sync with the resourcePlacement, while the
+// outdated conditions are dropped.
+func buildResourcePlacementStatus(rp *fleetv1beta1.ResourcePlacement, crp *fleetv1beta1.ClusterResourcePlacement) fleetv1beta1.ClusterResourcePlacementStatus {
+	status := crp.Status.DeepCopy()
+	status.Conditions = convertConditions(status.Conditions, crp.Generation, rp.Generation)
+	for i := range status.PlacementStatuses {
+		status.PlacementStatuses[i].Conditions = convertConditions(status.PlacementStatuses[i].Conditions, crp.Generation, rp.Generation)
+	}
+	return *status
+}
+
+// convertConditions converts the observed generation of the clusterResourcePlacement conditions to the one of the
+// resourcePlacement and drops the outdated conditions.
+func convertConditions(conditions []metav1.Condition, crpGeneration, rpGeneration int64) []metav1.Condition {
+	var res []metav1.Condition
+	for _, cond := range conditions {
+		if cond.ObservedGeneration != crpGeneration {
+			continue
+		}
+		cond.ObservedGeneration = rpGeneration
+		res = append(res, cond)
+	}
+	return res
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The resourcePlacement controller watches the clusterResourcePlacements backing the resourcePlacements to sync their
+// spec and report their status.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("resource_placement_controller").
+		For(&fleetv1beta1.ResourcePlacement{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &fleetv1beta1.ClusterResourcePlacement{}}, handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+			owner, ok := utils.GetResourcePlacementOwner(o)
+			if !ok {
+				return nil
+			}
+			klog.V(2).InfoS("Handling a clusterResourcePlacement event", "clusterResourcePlacement", klog.KObj(o))
+			return []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: o.GetLabels()[fleetv1beta1.ResourcePlacementNamespaceLabel], Name: owner.Name}},
+			}
+		})).
+		Complete(r)
+}
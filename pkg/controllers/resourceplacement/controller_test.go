@@ -0,0 +1,236 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package resourceplacement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/validator"
+)
+
+func TestClusterResourcePlacementName(t *testing.T) {
+	name := clusterResourcePlacementName("app", "web")
+	if errs := validation.IsDNS1035Label(name); len(errs) != 0 {
+		t.Errorf("clusterResourcePlacementName() = %s, not a valid DNS1035 label: %v", name, errs)
+	}
+	if got := clusterResourcePlacementName("app", "web"); got != name {
+		t.Errorf("clusterResourcePlacementName() = %s, want %s", got, name)
+	}
+	// the namespace and the name should not be simply concatenated
+	if got := clusterResourcePlacementName("app-web", ""); got == clusterResourcePlacementName("app", "-web") {
+		t.Errorf("clusterResourcePlacementName() got the same name %s for different resource placements", got)
+	}
+	if got := clusterResourcePlacementName("other", "web"); got == name {
+		t.Errorf("clusterResourcePlacementName() got the same name %s for different resource placements", got)
+	}
+}
+
+func TestBuildClusterResourcePlacement(t *testing.T) {
+	rp := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app", UID: "rp-uid"},
+		Spec: fleetv1beta1.ResourcePlacementSpec{
+			ResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web"},
+				{Version: "v1", Kind: "ConfigMap", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			},
+			Policy: &fleetv1beta1.PlacementPolicy{
+				PlacementType:    fleetv1beta1.PickNPlacementType,
+				NumberOfClusters: pointer.Int32(2),
+			},
+			Strategy: fleetv1beta1.RolloutStrategy{
+				Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+			},
+			RevisionHistoryLimit: pointer.Int32(5),
+		},
+	}
+	want := &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterResourcePlacementName("app", "web"),
+			Labels: map[string]string{
+				fleetv1beta1.ResourcePlacementNamespaceLabel: "app",
+				fleetv1beta1.ResourcePlacementNameLabel:      "web",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: fleetv1beta1.GroupVersion.String(),
+					Kind:       fleetv1beta1.ResourcePlacementKind,
+					Name:       "web",
+					UID:        "rp-uid",
+					Controller: pointer.Bool(true),
+				},
+			},
+		},
+		Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+			ResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web"},
+				{Version: "v1", Kind: "ConfigMap", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			},
+			Policy: &fleetv1beta1.PlacementPolicy{
+				PlacementType:    fleetv1beta1.PickNPlacementType,
+				NumberOfClusters: pointer.Int32(2),
+			},
+			Strategy: fleetv1beta1.RolloutStrategy{
+				Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+			},
+			RevisionHistoryLimit: pointer.Int32(5),
+		},
+	}
+	got := buildClusterResourcePlacement(rp)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildClusterResourcePlacement() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBuildResourcePlacementStatus(t *testing.T) {
+	rp := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app", Generation: 2},
+	}
+	crp := &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterResourcePlacementName("app", "web"), Generation: 5},
+		Status: fleetv1beta1.ClusterResourcePlacementStatus{
+			SelectedResources: []fleetv1beta1.ResourceIdentifier{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: "app"},
+			},
+			ObservedResourceIndex: "1",
+			PlacementStatuses: []fleetv1beta1.ResourcePlacementStatus{
+				{
+					ClusterName: "member-1",
+					Conditions: []metav1.Condition{
+						{Type: string(fleetv1beta1.ResourceScheduledConditionType), Status: metav1.ConditionTrue, ObservedGeneration: 5},
+						{Type: string(fleetv1beta1.ResourceWorkSynchronizedConditionType), Status: metav1.ConditionFalse, ObservedGeneration: 4},
+					},
+				},
+			},
+			Conditions: []metav1.Condition{
+				{Type: string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType), Status: metav1.ConditionTrue, ObservedGeneration: 5},
+				{Type: string(fleetv1beta1.ClusterResourcePlacementSynchronizedConditionType), Status: metav1.ConditionFalse, ObservedGeneration: 4},
+			},
+		},
+	}
+	want := fleetv1beta1.ClusterResourcePlacementStatus{
+		SelectedResources: []fleetv1beta1.ResourceIdentifier{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: "app"},
+		},
+		ObservedResourceIndex: "1",
+		PlacementStatuses: []fleetv1beta1.ResourcePlacementStatus{
+			{
+				ClusterName: "member-1",
+				Conditions: []metav1.Condition{
+					{Type: string(fleetv1beta1.ResourceScheduledConditionType), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+				},
+			},
+		},
+		Conditions: []metav1.Condition{
+			{Type: string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+		},
+	}
+	got := buildResourcePlacementStatus(rp, crp)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildResourcePlacementStatus() mismatch (-want, +got):\n%s", diff)
+	}
+	if crp.Status.Conditions[0].ObservedGeneration != 5 {
+		t.Errorf("buildResourcePlacementStatus() mutated the status of the clusterResourcePlacement")
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	validator.ResourceInformer = validator.MockResourceInformer{}
+	resourcePlacement := func(strategy fleetv1beta1.RolloutStrategy) *fleetv1beta1.ResourcePlacement {
+		return &fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "web",
+				Namespace:  "app",
+				Finalizers: []string{fleetv1beta1.ResourcePlacementCleanupFinalizer},
+			},
+			Spec: fleetv1beta1.ResourcePlacementSpec{
+				ResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+					{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role", Name: "web"},
+				},
+				Strategy: strategy,
+			},
+		}
+	}
+	tests := map[string]struct {
+		rp          *fleetv1beta1.ResourcePlacement
+		existingCRP *fleetv1beta1.ClusterResourcePlacement
+		wantCreate  bool
+		wantErr     bool
+	}{
+		"create the clusterResourcePlacement of a valid resourcePlacement": {
+			rp:         resourcePlacement(fleetv1beta1.RolloutStrategy{}),
+			wantCreate: true,
+		},
+		"refuse to create the clusterResourcePlacement of an invalid resourcePlacement": {
+			rp: resourcePlacement(fleetv1beta1.RolloutStrategy{
+				RolloutGates: []fleetv1beta1.RolloutGate{{Name: "gate", Type: fleetv1beta1.RolloutGateTypeHTTPProbe}},
+			}),
+		},
+		"refuse to take over a clusterResourcePlacement which is not owned by the resourcePlacement": {
+			rp: resourcePlacement(fleetv1beta1.RolloutStrategy{}),
+			existingCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterResourcePlacementName("app", "web"),
+					Labels: map[string]string{
+						fleetv1beta1.ResourcePlacementNamespaceLabel: "app",
+						fleetv1beta1.ResourcePlacementNameLabel:      "web",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotCreate bool
+			r := &Reconciler{
+				Client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if rp, ok := obj.(*fleetv1beta1.ResourcePlacement); ok {
+							tt.rp.DeepCopyInto(rp)
+							return nil
+						}
+						if crp, ok := obj.(*fleetv1beta1.ClusterResourcePlacement); ok && tt.existingCRP != nil {
+							tt.existingCRP.DeepCopyInto(crp)
+							return nil
+						}
+						return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+					},
+					MockCreate: func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+						gotCreate = true
+						return nil
+					},
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						t.Errorf("Reconcile() updated %s, want no update", obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+			}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "app", Name: "web"}})
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("Reconcile() got error %v, want error %t", err, tt.wantErr)
+			}
+			if gotCreate != tt.wantCreate {
+				t.Errorf("Reconcile() created the clusterResourcePlacement %t, want %t", gotCreate, tt.wantCreate)
+			}
+		})
+	}
+}
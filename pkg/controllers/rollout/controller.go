@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,9 +33,28 @@ import (
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	"go.goms.io/fleet/pkg/utils/condition"
 	"go.goms.io/fleet/pkg/utils/controller"
+	"go.goms.io/fleet/pkg/utils/overrider"
 	"go.goms.io/fleet/pkg/utils/validator"
 )
 
+const (
+	// RolloutStartedReason is the reason string of placement condition when the rollout has started.
+	RolloutStartedReason = "RolloutStarted"
+	// PinnedSnapshotNotFoundReason is the reason string of placement condition when the resource snapshot which the
+	// placement is pinned to does not exist.
+	PinnedSnapshotNotFoundReason = "PinnedSnapshotNotFound"
+
+	// pinnedSnapshotRecheckInterval is the interval at which a placement pinned to a missing resource snapshot is
+	// checked again.
+	pinnedSnapshotRecheckInterval = time.Minute
+)
+
+// bindingOverrides records the override snapshots which should be applied on the resources placed on a target cluster.
+type bindingOverrides struct {
+	clusterResourceOverrideSnapshots []string
+	resourceOverrideSnapshots        []fleetv1beta1.NamespacedName
+}
+
 // Reconciler recomputes the cluster resource binding.
 type Reconciler struct {
 	client.Client
@@ -69,9 +89,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	// check that it's actually rollingUpdate strategy
+	// check that it's actually rollingUpdate or stagedUpdate strategy
 	// TODO: support the rollout all at once type of RolloutStrategy
-	if crp.Spec.Strategy.Type != fleetv1beta1.RollingUpdateRolloutStrategyType {
+	if crp.Spec.Strategy.Type != fleetv1beta1.RollingUpdateRolloutStrategyType && crp.Spec.Strategy.Type != fleetv1beta1.StagedUpdateRolloutStrategyType {
 		klog.V(2).InfoS("Ignoring clusterResourcePlacement with non-rolling-update strategy", "clusterResourcePlacement", crpName)
 		return ctrl.Result{}, nil
 	}
@@ -104,14 +124,44 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
-	// find the latest clusterResourceSnapshot.
-	latestResourceSnapshotName, err := r.fetchLatestResourceSnapshot(ctx, crpName)
+	// find the latest clusterResourceSnapshot, or the pinned one which the bindings are rolled back to.
+	// The rest of the rollout treats the pinned resource snapshot as the latest one.
+	var latestResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot
+	if crp.Spec.PinnedResourceIndex != nil {
+		latestResourceSnapshot, err = r.fetchPinnedResourceSnapshot(ctx, crpName, int(*crp.Spec.PinnedResourceIndex))
+		if err == nil && latestResourceSnapshot == nil {
+			// the bindings stay where they are until the placement is pinned to an existing resource snapshot
+			notFoundErr := fmt.Errorf("crp `%s` is pinned to resource index %d which has no clusterResourceSnapshot", crpName, *crp.Spec.PinnedResourceIndex)
+			klog.ErrorS(controller.NewUserError(notFoundErr),
+				"Failed to find the pinned clusterResourceSnapshot for the clusterResourcePlacement", "clusterResourcePlacement", crpName)
+			if err := r.setRolloutStartedCondition(ctx, &crp, metav1.ConditionFalse, PinnedSnapshotNotFoundReason,
+				fmt.Sprintf("The placement is pinned to resource index %d which has no resource snapshot", *crp.Spec.PinnedResourceIndex)); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: pinnedSnapshotRecheckInterval}, nil
+		}
+	} else {
+		latestResourceSnapshot, err = r.fetchLatestResourceSnapshot(ctx, crpName)
+	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to find the latest clusterResourceSnapshot for the clusterResourcePlacement",
 			"clusterResourcePlacement", crpName)
 		return ctrl.Result{}, err
 	}
+	latestResourceSnapshotName := latestResourceSnapshot.Name
 	klog.V(2).InfoS("Found the latest resourceSnapshot for the clusterResourcePlacement", "clusterResourcePlacement", crpName, "latestResourceSnapshotName", latestResourceSnapshotName)
+	if err := r.setRolloutStartedCondition(ctx, &crp, metav1.ConditionTrue, RolloutStartedReason,
+		"The selected resources are being rolled out to the target clusters"); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// find the latest override snapshots which select any of the resources in the latest resource snapshot and pick the
+	// ones which apply to each target cluster.
+	desiredOverrides, err := r.pickOverridesForBindings(ctx, crpName, latestResourceSnapshot, allBindings)
+	if err != nil {
+		klog.ErrorS(err, "Failed to find the overrides for the clusterResourcePlacement", "clusterResourcePlacement", crpName)
+		return ctrl.Result{}, err
+	}
 
 	// fill out all the default values for CRP just in case the mutation webhook is not enabled.
 	fleetv1beta1.SetDefaultsClusterResourcePlacement(&crp)
@@ -121,9 +171,40 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	if crp.Spec.Strategy.DryRunBeforeRollout {
+		// ask the member agents to validate the latest resources before the bindings are rolled to them
+		requested, err := r.requestDryRuns(ctx, latestResourceSnapshotName, allBindings)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if requested {
+			// the binding update events will trigger the rollout again with the latest binding status
+			klog.V(2).InfoS("Requested the dry-run of the latest resources, wait for the bindings to be updated", "clusterResourcePlacement", crpName)
+			return ctrl.Result{}, nil
+		}
+	}
+
+	// find the clusters which can be updated to the latest resources by the current stage of the staged update
+	updatableClusters, stageWaitTime, err := r.syncStagedUpdate(ctx, &crp, latestResourceSnapshot, allBindings)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// the bindings are not rolled at all until the rollout is resumed, while the dry-run results and the staged update
+	// status are still kept up to date so that the users can decide whether to resume it.
+	if crp.Spec.Strategy.Paused {
+		klog.V(2).InfoS("The rollout of the clusterResourcePlacement is paused, skip updating the bindings", "clusterResourcePlacement", crpName)
+		return ctrl.Result{RequeueAfter: stageWaitTime}, nil
+	}
+
 	// pick the bindings to be updated according to the rollout plan
-	toBeUpdatedBindings, needRoll := pickBindingsToRoll(allBindings, latestResourceSnapshotName, &crp)
+	toBeUpdatedBindings, needRoll := pickBindingsToRoll(allBindings, latestResourceSnapshotName, desiredOverrides, updatableClusters, &crp)
 	if !needRoll {
+		if stageWaitTime > 0 {
+			// recheck when the current stage is done waiting so that its status is updated
+			klog.V(2).InfoS("No bindings are out of date, wait for the current stage to complete", "clusterResourcePlacement", crpName, "waitTime", stageWaitTime)
+			return ctrl.Result{RequeueAfter: stageWaitTime}, nil
+		}
 		klog.V(2).InfoS("No bindings are out of date, stop rolling", "clusterResourcePlacement", crpName)
 		return ctrl.Result{}, nil
 	}
@@ -135,12 +216,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// We wait for 1/5 of the UnavailablePeriodSeconds so we can catch the next ready one early.
 	// TODO: only wait the time we need to wait for the first applied but not ready binding to be ready
 	return ctrl.Result{RequeueAfter: time.Duration(*crp.Spec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second / 5},
-		r.updateBindings(ctx, latestResourceSnapshotName, toBeUpdatedBindings)
+		r.updateBindings(ctx, latestResourceSnapshotName, desiredOverrides, crp.Spec.Strategy.ApplyStrategy, crp.Spec.Strategy.DeletionPolicy,
+			crp.Spec.Strategy.RolloutGates, toBeUpdatedBindings)
 }
 
-// fetchLatestResourceSnapshot lists all the latest clusterResourceSnapshots associated with a CRP and returns the name of the master clusterResourceSnapshot.
-func (r *Reconciler) fetchLatestResourceSnapshot(ctx context.Context, crpName string) (string, error) {
-	var latestResourceSnapshotName string
+// fetchLatestResourceSnapshot lists all the latest clusterResourceSnapshots associated with a CRP and returns the master clusterResourceSnapshot.
+func (r *Reconciler) fetchLatestResourceSnapshot(ctx context.Context, crpName string) (*fleetv1beta1.ClusterResourceSnapshot, error) {
+	var latestResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot
 	latestResourceLabelMatcher := client.MatchingLabels{
 		fleetv1beta1.IsLatestSnapshotLabel: "true",
 		fleetv1beta1.CRPTrackingLabel:      crpName,
@@ -149,25 +231,128 @@ func (r *Reconciler) fetchLatestResourceSnapshot(ctx context.Context, crpName st
 	if err := r.Client.List(ctx, resourceSnapshotList, latestResourceLabelMatcher); err != nil {
 		klog.ErrorS(err, "Failed to list the latest clusterResourceSnapshot associated with the clusterResourcePlacement",
 			"clusterResourcePlacement", crpName)
-		return "", controller.NewAPIServerError(true, err)
+		return nil, controller.NewAPIServerError(true, err)
 	}
 	// try to find the master clusterResourceSnapshot.
-	for _, resourceSnapshot := range resourceSnapshotList.Items {
+	for i := range resourceSnapshotList.Items {
 		// only master has this annotation
-		if len(resourceSnapshot.Annotations[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
-			latestResourceSnapshotName = resourceSnapshot.Name
+		if len(resourceSnapshotList.Items[i].Annotations[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
+			latestResourceSnapshot = &resourceSnapshotList.Items[i]
 			break
 		}
 	}
 	// no clusterResourceSnapshot found, it's possible since we remove the label from the last one first before
 	// creating a new clusterResourceSnapshot.
-	if len(latestResourceSnapshotName) == 0 {
+	if latestResourceSnapshot == nil {
 		klog.V(2).InfoS("Cannot find the latest associated clusterResourceSnapshot", "clusterResourcePlacement", crpName)
-		return "", controller.NewExpectedBehaviorError(fmt.Errorf("crp `%s` has no latest clusterResourceSnapshot", crpName))
+		return nil, controller.NewExpectedBehaviorError(fmt.Errorf("crp `%s` has no latest clusterResourceSnapshot", crpName))
 	}
 	klog.V(2).InfoS("Found the latest associated clusterResourceSnapshot", "clusterResourcePlacement", crpName,
-		"latestClusterResourceSnapshotName", latestResourceSnapshotName)
-	return latestResourceSnapshotName, nil
+		"latestClusterResourceSnapshotName", latestResourceSnapshot.Name)
+	return latestResourceSnapshot, nil
+}
+
+// setRolloutStartedCondition updates the rollout started condition of a CRP when it is changed.
+func (r *Reconciler) setRolloutStartedCondition(ctx context.Context, crp *fleetv1beta1.ClusterResourcePlacement,
+	status metav1.ConditionStatus, reason, message string) error {
+	cond := metav1.Condition{
+		Type:               string(fleetv1beta1.ClusterResourcePlacementRolloutStartedConditionType),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: crp.Generation,
+	}
+	if condition.EqualCondition(crp.GetCondition(cond.Type), &cond) {
+		return nil
+	}
+	crp.SetConditions(cond)
+	if err := r.Client.Status().Update(ctx, crp); err != nil {
+		klog.ErrorS(err, "Failed to update the rollout started condition", "clusterResourcePlacement", klog.KObj(crp), "status", status)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the rollout started condition", "clusterResourcePlacement", klog.KObj(crp), "status", status, "reason", reason)
+	return nil
+}
+
+// fetchPinnedResourceSnapshot returns the master clusterResourceSnapshot with the resource index which a CRP is pinned to,
+// or nil if there is no such clusterResourceSnapshot, e.g., it was deleted as it exceeded the revision history limit
+// before being pinned.
+func (r *Reconciler) fetchPinnedResourceSnapshot(ctx context.Context, crpName string, resourceIndex int) (*fleetv1beta1.ClusterResourceSnapshot, error) {
+	resourceSnapshotList := &fleetv1beta1.ClusterResourceSnapshotList{}
+	pinnedResourceLabelMatcher := client.MatchingLabels{
+		fleetv1beta1.ResourceIndexLabel: strconv.Itoa(resourceIndex),
+		fleetv1beta1.CRPTrackingLabel:   crpName,
+	}
+	if err := r.Client.List(ctx, resourceSnapshotList, pinnedResourceLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list the pinned clusterResourceSnapshot associated with the clusterResourcePlacement",
+			"clusterResourcePlacement", crpName, "resourceIndex", resourceIndex)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	for i := range resourceSnapshotList.Items {
+		// only master has this annotation
+		if len(resourceSnapshotList.Items[i].Annotations[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
+			klog.V(2).InfoS("Found the pinned clusterResourceSnapshot", "clusterResourcePlacement", crpName,
+				"pinnedClusterResourceSnapshotName", resourceSnapshotList.Items[i].Name)
+			return &resourceSnapshotList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// pickOverridesForBindings finds the override snapshots which should be applied on the resources placed on the target
+// cluster of each scheduled or bound binding. The result is keyed by the target cluster name and a cluster without
+// any entry does not have any override.
+func (r *Reconciler) pickOverridesForBindings(ctx context.Context, crpName string, latestResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot,
+	allBindings []*fleetv1beta1.ClusterResourceBinding) (map[string]*bindingOverrides, error) {
+	matchedCROs, matchedROs, err := overrider.FetchAllMatchingOverridesForResourceSnapshot(ctx, r.Client, crpName, latestResourceSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	desiredOverrides := make(map[string]*bindingOverrides)
+	if len(matchedCROs) == 0 && len(matchedROs) == 0 {
+		return desiredOverrides, nil
+	}
+	for _, binding := range allBindings {
+		if binding.Spec.State != fleetv1beta1.BindingStateScheduled && binding.Spec.State != fleetv1beta1.BindingStateBound {
+			continue
+		}
+		cros, ros, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, binding.Spec.TargetCluster, matchedCROs, matchedROs)
+		if err != nil {
+			return nil, err
+		}
+		if len(cros) == 0 && len(ros) == 0 {
+			continue
+		}
+		overrides := &bindingOverrides{}
+		if len(cros) > 0 {
+			overrides.clusterResourceOverrideSnapshots = cros
+		}
+		if len(ros) > 0 {
+			overrides.resourceOverrideSnapshots = ros
+		}
+		desiredOverrides[binding.Spec.TargetCluster] = overrides
+	}
+	return desiredOverrides, nil
+}
+
+// isBindingOverridesUpToDate checks if the binding already points to the desired override snapshots.
+func isBindingOverridesUpToDate(binding *fleetv1beta1.ClusterResourceBinding, desired *bindingOverrides) bool {
+	if desired == nil {
+		return len(binding.Spec.ClusterResourceOverrideSnapshots) == 0 && len(binding.Spec.ResourceOverrideSnapshots) == 0
+	}
+	return equality.Semantic.DeepEqual(binding.Spec.ClusterResourceOverrideSnapshots, desired.clusterResourceOverrideSnapshots) &&
+		equality.Semantic.DeepEqual(binding.Spec.ResourceOverrideSnapshots, desired.resourceOverrideSnapshots)
+}
+
+// setBindingOverrides sets the desired override snapshots on the binding.
+func setBindingOverrides(binding *fleetv1beta1.ClusterResourceBinding, desired *bindingOverrides) {
+	if desired == nil {
+		binding.Spec.ClusterResourceOverrideSnapshots = nil
+		binding.Spec.ResourceOverrideSnapshots = nil
+		return
+	}
+	binding.Spec.ClusterResourceOverrideSnapshots = desired.clusterResourceOverrideSnapshots
+	binding.Spec.ResourceOverrideSnapshots = desired.resourceOverrideSnapshots
 }
 
 // waitForResourcesToCleanUp checks if there are any cluster that has a binding that is both being deleted and another one that needs rollout.
@@ -221,7 +406,12 @@ func waitForResourcesToCleanUp(allBindings []*fleetv1beta1.ClusterResourceBindin
 // pickBindingsToRoll go through all bindings associated with a CRP and returns the bindings that are ready to be updated.
 // There could be cases that no bindings are ready to be updated because of the maxSurge/maxUnavailable constraints even if there are out of sync bindings.
 // Thus, it also returns a bool indicating whether there are out of sync bindings to be rolled to differentiate those two cases.
-func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, latestResourceSnapshotName string, crp *fleetv1beta1.ClusterResourcePlacement) ([]*fleetv1beta1.ClusterResourceBinding, bool) {
+// A bound binding is out of sync if it does not point to the latest resource snapshot or the desired override snapshots
+// of its target cluster.
+// A bound binding is only updated to the latest resource snapshot if its target cluster is in the updatable clusters,
+// where nil means all the clusters are updatable.
+func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, latestResourceSnapshotName string, desiredOverrides map[string]*bindingOverrides,
+	updatableClusters map[string]bool, crp *fleetv1beta1.ClusterResourcePlacement) ([]*fleetv1beta1.ClusterResourceBinding, bool) {
 	// Those are the bindings that are chosen by the scheduler to be applied to selected clusters.
 	// They include the bindings that are already applied to the clusters and the bindings that are newly selected by the scheduler.
 	schedulerTargetedBinds := make([]*fleetv1beta1.ClusterResourceBinding, 0)
@@ -246,6 +436,14 @@ func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, late
 	// Those are the bindings that are candidates to be updated to latest resources during the rolling phase.
 	updateCandidates := make([]*fleetv1beta1.ClusterResourceBinding, 0)
 
+	// Those are the bindings that are out of date but cannot be updated to the latest resources until the latest
+	// resources pass the dry-run on their target clusters.
+	dryRunGatedBindings := make([]*fleetv1beta1.ClusterResourceBinding, 0)
+
+	// Those are the bindings that are out of date but cannot be updated to the latest resources until the staged
+	// update reaches the stage of their target clusters.
+	stageGatedBindings := make([]*fleetv1beta1.ClusterResourceBinding, 0)
+
 	// Those are the bindings that are a sub-set of the candidates to be updated to latest resources but also are failed to apply.
 	// We can safely update those bindings to latest resources even if we can't update the rest of the bindings when we don't meet the
 	// minimum AvailableNumber of copies as we won't reduce the total unavailable number of bindings.
@@ -300,8 +498,25 @@ func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, late
 			} else {
 				canBeReadyBindings = append(canBeReadyBindings, binding)
 			}
-			// The binding needs update if it's not pointing to the latest resource resourceBinding
-			if binding.Spec.ResourceSnapshotName != latestResourceSnapshotName {
+			// The binding needs update if it's not pointing to the latest resource resourceBinding, the latest overrides,
+			// the latest apply strategy, the latest deletion policy or the latest rollout gates
+			if binding.Spec.ResourceSnapshotName != latestResourceSnapshotName ||
+				!isBindingOverridesUpToDate(binding, desiredOverrides[binding.Spec.TargetCluster]) ||
+				!equality.Semantic.DeepEqual(binding.Spec.ApplyStrategy, crp.Spec.Strategy.ApplyStrategy) ||
+				!equality.Semantic.DeepEqual(binding.Spec.DeletionPolicy, crp.Spec.Strategy.DeletionPolicy) ||
+				!equality.Semantic.DeepEqual(binding.Spec.RolloutGates, crp.Spec.Strategy.RolloutGates) {
+				if crp.Spec.Strategy.DryRunBeforeRollout && binding.Spec.ResourceSnapshotName != latestResourceSnapshotName &&
+					!isBindingDryRunSucceeded(binding, latestResourceSnapshotName) {
+					klog.V(3).InfoS("Found a bound binding waiting for the latest resources to pass the dry-run", "clusterResourcePlacement", klog.KObj(crp), "binding", klog.KObj(binding))
+					dryRunGatedBindings = append(dryRunGatedBindings, binding)
+					continue
+				}
+				if updatableClusters != nil && binding.Spec.ResourceSnapshotName != latestResourceSnapshotName &&
+					!updatableClusters[binding.Spec.TargetCluster] {
+					klog.V(3).InfoS("Found a bound binding waiting for the staged update to reach its cluster", "clusterResourcePlacement", klog.KObj(crp), "binding", klog.KObj(binding))
+					stageGatedBindings = append(stageGatedBindings, binding)
+					continue
+				}
 				updateCandidates = append(updateCandidates, binding)
 				if bindingFailed {
 					// the binding has been applied but failed to apply, we can safely update it to latest resources without affecting max unavailable count
@@ -334,11 +549,13 @@ func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, late
 	klog.V(2).InfoS("Calculated the targetNumber", "clusterResourcePlacement", klog.KObj(crp),
 		"targetNumber", targetNumber, "readyBindingNumber", len(readyBindings), "canBeUnavailableBindingNumber", len(canBeUnavailableBindings),
 		"canBeReadyBindingNumber", len(canBeReadyBindings), "boundingCandidateNumber", len(boundingCandidates),
-		"removeCandidateNumber", len(removeCandidates), "updateCandidateNumber", len(updateCandidates), "applyFailedUpdateCandidateNumber", len(applyFailedUpdateCandidates))
+		"removeCandidateNumber", len(removeCandidates), "updateCandidateNumber", len(updateCandidates), "applyFailedUpdateCandidateNumber", len(applyFailedUpdateCandidates),
+		"dryRunGatedBindingNumber", len(dryRunGatedBindings), "stageGatedBindingNumber", len(stageGatedBindings))
 
 	// the list of bindings that are to be updated by this rolling phase
 	toBeUpdatedBinding := make([]*fleetv1beta1.ClusterResourceBinding, 0)
-	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates) == 0 {
+	// the bindings waiting for the dry-run or the staged update are still out of sync, so we keep rolling until they are updated
+	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(dryRunGatedBindings)+len(stageGatedBindings) == 0 {
 		return toBeUpdatedBinding, false
 	}
 
@@ -390,24 +607,70 @@ func pickBindingsToRoll(allBindings []*fleetv1beta1.ClusterResourceBinding, late
 }
 
 // isBindingReady checks if a binding is considered ready.
-// A binding is considered ready if the binding's current spec has been applied before the ready cutoff time.
+// A binding is considered ready if all the resources in the binding's current spec are available on the target cluster.
+// For the resources whose availability cannot be tracked, the binding is considered ready if it has been available,
+// which means applied, before the ready cutoff time.
 func isBindingReady(binding *fleetv1beta1.ClusterResourceBinding, readyTimeCutOff time.Time) (time.Duration, bool) {
-	// find the latest applied condition that has the same generation as the binding
-	appliedCondition := binding.GetCondition(string(fleetv1beta1.ResourceBindingApplied))
-	if condition.IsConditionStatusTrue(appliedCondition, binding.GetGeneration()) {
-		waitTime := appliedCondition.LastTransitionTime.Time.Sub(readyTimeCutOff)
+	// the binding is not ready until all of its rollout gates pass on the target cluster
+	if len(binding.Spec.RolloutGates) != 0 &&
+		!condition.IsConditionStatusTrue(binding.GetCondition(string(fleetv1beta1.ResourceBindingRolloutGatesPassed)), binding.GetGeneration()) {
+		return -1, false
+	}
+	// find the latest available condition that has the same generation as the binding
+	availableCondition := binding.GetCondition(string(fleetv1beta1.ResourceBindingAvailable))
+	if condition.IsConditionStatusTrue(availableCondition, binding.GetGeneration()) {
+		if availableCondition.Reason != fleetv1beta1.WorkNotTrackableReason {
+			return 0, true
+		}
+		// the available condition of a not trackable binding is set to true as soon as its resources are applied,
+		// so we fall back to wait for the unavailable period since it became available.
+		waitTime := availableCondition.LastTransitionTime.Time.Sub(readyTimeCutOff)
 		if waitTime < 0 {
 			return 0, true
 		}
 		// return the time we need to wait for it to be ready in this case
 		return waitTime, false
 	}
-	// we don't know when the current spec is applied yet, return a negative wait time
+	// we don't know when the current spec is available yet, return a negative wait time
 	return -1, false
 }
 
+// requestDryRuns asks for a dry-run of the latest resources on the target clusters of the bound bindings which are
+// not pointing to the latest resource snapshot yet. It does not change the resources placed on the target clusters,
+// so it is not limited by the rollout plan. It returns true if any dry-run is requested.
+func (r *Reconciler) requestDryRuns(ctx context.Context, latestResourceSnapshotName string, allBindings []*fleetv1beta1.ClusterResourceBinding) (bool, error) {
+	requested := false
+	errs, cctx := errgroup.WithContext(ctx)
+	for i := range allBindings {
+		binding := allBindings[i]
+		if binding.Spec.State != fleetv1beta1.BindingStateBound || binding.Spec.ResourceSnapshotName == latestResourceSnapshotName ||
+			binding.Spec.DryRunResourceSnapshotName == latestResourceSnapshotName {
+			continue
+		}
+		binding.Spec.DryRunResourceSnapshotName = latestResourceSnapshotName
+		requested = true
+		errs.Go(func() error {
+			if err := r.Client.Update(cctx, binding); err != nil {
+				klog.ErrorS(err, "Failed to request a dry-run of the latest resource on a binding", "resourceBinding", klog.KObj(binding))
+				return controller.NewUpdateIgnoreConflictError(err)
+			}
+			klog.V(2).InfoS("Requested a dry-run of the latest resource on a binding", "resourceBinding", klog.KObj(binding), "latestResourceSnapshotName", latestResourceSnapshotName)
+			return nil
+		})
+	}
+	return requested, errs.Wait()
+}
+
+// isBindingDryRunSucceeded checks if the latest resources pass the dry-run on the target cluster of the binding.
+func isBindingDryRunSucceeded(binding *fleetv1beta1.ClusterResourceBinding, latestResourceSnapshotName string) bool {
+	return binding.Spec.DryRunResourceSnapshotName == latestResourceSnapshotName &&
+		condition.IsConditionStatusTrue(binding.GetCondition(string(fleetv1beta1.ResourceBindingDryRunSucceeded)), binding.GetGeneration())
+}
+
 // updateBindings updates the bindings according to its state.
-func (r *Reconciler) updateBindings(ctx context.Context, latestResourceSnapshotName string, toBeUpgradedBinding []*fleetv1beta1.ClusterResourceBinding) error {
+func (r *Reconciler) updateBindings(ctx context.Context, latestResourceSnapshotName string, desiredOverrides map[string]*bindingOverrides,
+	applyStrategy *fleetv1beta1.ApplyStrategy, deletionPolicy *fleetv1beta1.DeletionPolicy, rolloutGates []fleetv1beta1.RolloutGate,
+	toBeUpgradedBinding []*fleetv1beta1.ClusterResourceBinding) error {
 	// issue all the update requests in parallel
 	errs, cctx := errgroup.WithContext(ctx)
 	// handle the bindings depends on its state
@@ -415,9 +678,16 @@ func (r *Reconciler) updateBindings(ctx context.Context, latestResourceSnapshotN
 		binding := toBeUpgradedBinding[i]
 		bindObj := klog.KObj(binding)
 		switch binding.Spec.State {
-		// The only thing we can do on a bound binding is to update its resource resourceBinding
+		// The only thing we can do on a bound binding is to update its resource resourceBinding, overrides, apply strategy,
+		// deletion policy and rollout gates
 		case fleetv1beta1.BindingStateBound:
 			binding.Spec.ResourceSnapshotName = latestResourceSnapshotName
+			// the binding does not wait for any dry-run once it points to the latest resource snapshot
+			binding.Spec.DryRunResourceSnapshotName = ""
+			setBindingOverrides(binding, desiredOverrides[binding.Spec.TargetCluster])
+			binding.Spec.ApplyStrategy = applyStrategy
+			binding.Spec.DeletionPolicy = deletionPolicy
+			binding.Spec.RolloutGates = rolloutGates
 			errs.Go(func() error {
 				if err := r.Client.Update(cctx, binding); err != nil {
 					klog.ErrorS(err, "Failed to update a binding to the latest resource", "resourceBinding", bindObj)
@@ -430,6 +700,10 @@ func (r *Reconciler) updateBindings(ctx context.Context, latestResourceSnapshotN
 		case fleetv1beta1.BindingStateScheduled:
 			binding.Spec.State = fleetv1beta1.BindingStateBound
 			binding.Spec.ResourceSnapshotName = latestResourceSnapshotName
+			setBindingOverrides(binding, desiredOverrides[binding.Spec.TargetCluster])
+			binding.Spec.ApplyStrategy = applyStrategy
+			binding.Spec.DeletionPolicy = deletionPolicy
+			binding.Spec.RolloutGates = rolloutGates
 			errs.Go(func() error {
 				if err := r.Client.Update(cctx, binding); err != nil {
 					klog.ErrorS(err, "Failed to mark a binding bound", "resourceBinding", bindObj)
@@ -456,8 +730,10 @@ func (r *Reconciler) updateBindings(ctx context.Context, latestResourceSnapshotN
 }
 
 // SetupWithManager sets up the rollout controller with the Manager.
-// The rollout controller watches resource snapshots and resource bindings.
-// It reconciles on the CRP when a new resource resourceBinding is created or an existing resource binding is created/updated.
+// The rollout controller watches resource snapshots, override snapshots, stage approvals, resource bindings and CRPs.
+// It reconciles on the CRP when a new resource resourceBinding is created, a stage of its staged update is approved,
+// an override snapshot is created/deleted, an existing resource binding is created/updated or the rollout
+// strategy of the CRP is updated.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.recorder = mgr.GetEventRecorderFor("rollout-controller")
 	return ctrl.NewControllerManagedBy(mgr).Named("rollout_controller").
@@ -471,6 +747,36 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 				handleResourceSnapshot(e.Object, q)
 			},
 		}).
+		Watches(&source.Kind{Type: &fleetv1beta1.ClusterResourceOverrideSnapshot{}}, handler.Funcs{
+			CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a clusterResourceOverrideSnapshot create event", "clusterResourceOverrideSnapshot", klog.KObj(e.Object))
+				r.handleOverrideSnapshot(e.Object, q)
+			},
+			DeleteFunc: func(e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a clusterResourceOverrideSnapshot delete event", "clusterResourceOverrideSnapshot", klog.KObj(e.Object))
+				r.handleOverrideSnapshot(e.Object, q)
+			},
+		}).
+		Watches(&source.Kind{Type: &fleetv1beta1.ResourceOverrideSnapshot{}}, handler.Funcs{
+			CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a resourceOverrideSnapshot create event", "resourceOverrideSnapshot", klog.KObj(e.Object))
+				r.handleOverrideSnapshot(e.Object, q)
+			},
+			DeleteFunc: func(e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a resourceOverrideSnapshot delete event", "resourceOverrideSnapshot", klog.KObj(e.Object))
+				r.handleOverrideSnapshot(e.Object, q)
+			},
+		}).
+		Watches(&source.Kind{Type: &fleetv1beta1.ClusterStageApproval{}}, handler.Funcs{
+			CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a clusterStageApproval create event", "clusterStageApproval", klog.KObj(e.Object))
+				handleStageApproval(e.Object, q)
+			},
+			UpdateFunc: func(e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a clusterStageApproval update event", "clusterStageApproval", klog.KObj(e.ObjectNew))
+				handleStageApproval(e.ObjectNew, q)
+			},
+		}).
 		Watches(&source.Kind{Type: &fleetv1beta1.ClusterResourceBinding{}}, handler.Funcs{
 			CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) {
 				klog.V(2).InfoS("Handling a resourceBinding create event", "resourceBinding", klog.KObj(e.Object))
@@ -485,6 +791,12 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 				handleResourceBinding(e.Object, q)
 			},
 		}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &fleetv1beta1.ClusterResourcePlacement{}}, handler.Funcs{
+			UpdateFunc: func(e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+				klog.V(2).InfoS("Handling a clusterResourcePlacement update event", "clusterResourcePlacement", klog.KObj(e.ObjectNew))
+				handleClusterResourcePlacement(e.ObjectOld, e.ObjectNew, q)
+			},
+		}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
 
@@ -525,6 +837,35 @@ func handleResourceSnapshot(snapshot client.Object, q workqueue.RateLimitingInte
 	})
 }
 
+// handleOverrideSnapshot enqueues all the CRPs as an override snapshot may select the resources placed by any of them.
+func (r *Reconciler) handleOverrideSnapshot(snapshot client.Object, q workqueue.RateLimitingInterface) {
+	crpList := &fleetv1beta1.ClusterResourcePlacementList{}
+	if err := r.Client.List(context.Background(), crpList); err != nil {
+		klog.ErrorS(controller.NewAPIServerError(true, err), "Failed to list all the clusterResourcePlacements", "overrideSnapshot", klog.KObj(snapshot))
+		return
+	}
+	for i := range crpList.Items {
+		// enqueue the CRP to the rollout controller queue
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: crpList.Items[i].Name},
+		})
+	}
+}
+
+// handleStageApproval enqueues the CRP whose stage is approved by the clusterStageApproval.
+func handleStageApproval(approvalObj client.Object, q workqueue.RateLimitingInterface) {
+	approval, ok := approvalObj.(*fleetv1beta1.ClusterStageApproval)
+	if !ok {
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("non clusterStageApproval type object: %+v", approvalObj)),
+			"Failed to process an event for clusterStageApproval object")
+		return
+	}
+	// enqueue the CRP to the rollout controller queue
+	q.Add(reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: approval.Spec.PlacementName},
+	})
+}
+
 // handleResourceBinding parse the binding label and enqueue the CRP name associated with the resource binding
 func handleResourceBinding(binding client.Object, q workqueue.RateLimitingInterface) {
 	bindingRef := klog.KObj(binding)
@@ -541,3 +882,31 @@ func handleResourceBinding(binding client.Object, q workqueue.RateLimitingInterf
 		NamespacedName: types.NamespacedName{Name: crp},
 	})
 }
+
+// handleClusterResourcePlacement enqueues the CRP when its pinned resource index, rollout strategy type, staged update
+// stages, apply strategy, deletion policy, dry-run setting, paused setting or rollout gates are changed so that the
+// change can be rolled out to the bindings.
+func handleClusterResourcePlacement(oldCRPObj, newCRPObj client.Object, q workqueue.RateLimitingInterface) {
+	oldCRP, oldOK := oldCRPObj.(*fleetv1beta1.ClusterResourcePlacement)
+	newCRP, newOK := newCRPObj.(*fleetv1beta1.ClusterResourcePlacement)
+	if !oldOK || !newOK {
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to cast runtime objects in update event to clusterResourcePlacement objects")),
+			"Failed to process an update event for clusterResourcePlacement object")
+		return
+	}
+	if equality.Semantic.DeepEqual(oldCRP.Spec.PinnedResourceIndex, newCRP.Spec.PinnedResourceIndex) &&
+		oldCRP.Spec.Strategy.Type == newCRP.Spec.Strategy.Type &&
+		equality.Semantic.DeepEqual(oldCRP.Spec.Strategy.StagedUpdate, newCRP.Spec.Strategy.StagedUpdate) &&
+		equality.Semantic.DeepEqual(oldCRP.Spec.Strategy.ApplyStrategy, newCRP.Spec.Strategy.ApplyStrategy) &&
+		equality.Semantic.DeepEqual(oldCRP.Spec.Strategy.DeletionPolicy, newCRP.Spec.Strategy.DeletionPolicy) &&
+		oldCRP.Spec.Strategy.DryRunBeforeRollout == newCRP.Spec.Strategy.DryRunBeforeRollout &&
+		oldCRP.Spec.Strategy.Paused == newCRP.Spec.Strategy.Paused &&
+		equality.Semantic.DeepEqual(oldCRP.Spec.Strategy.RolloutGates, newCRP.Spec.Strategy.RolloutGates) {
+		klog.V(2).InfoS("The rollout strategy of the clusterResourcePlacement is not changed", "clusterResourcePlacement", klog.KObj(newCRP))
+		return
+	}
+	// enqueue the CRP to the rollout controller queue
+	q.Add(reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: newCRP.GetName()},
+	})
+}
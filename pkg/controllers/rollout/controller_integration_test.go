@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -92,6 +93,37 @@ var _ = Describe("Test the rollout Controller", func() {
 		}, timeout, interval).Should(BeTrue(), "rollout controller should roll all the bindings to Bound state")
 	})
 
+	It("Should not rollout and report it when the pinned resource snapshot does not exist", func() {
+		// create CRP pinned to a resource index which has no resource snapshot
+		rolloutCRP = clusterResourcePlacementForTest(testCRPName, createPlacementPolicyForTest(fleetv1beta1.PickNPlacementType, 1))
+		var pinnedResourceIndex int32 = 5
+		rolloutCRP.Spec.PinnedResourceIndex = &pinnedResourceIndex
+		Expect(k8sClient.Create(ctx, rolloutCRP)).Should(Succeed())
+		// create master resource snapshot that is latest
+		masterSnapshot := generateResourceSnapshot(rolloutCRP.Name, 0, true)
+		Expect(k8sClient.Create(ctx, masterSnapshot)).Should(Succeed())
+		resourceSnapshots = append(resourceSnapshots, masterSnapshot)
+		binding := generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, masterSnapshot.Name, "cluster-"+utils.RandStr())
+		Expect(k8sClient.Create(ctx, binding)).Should(Succeed())
+		bindings = append(bindings, binding)
+		// check that the CRP reports the missing pinned resource snapshot
+		Eventually(func() bool {
+			crp := &fleetv1beta1.ClusterResourcePlacement{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: testCRPName}, crp); err != nil {
+				return false
+			}
+			cond := crp.GetCondition(string(fleetv1beta1.ClusterResourcePlacementRolloutStartedConditionType))
+			return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == PinnedSnapshotNotFoundReason
+		}, timeout, interval).Should(BeTrue(), "rollout controller should report the missing pinned resource snapshot")
+		// check that the binding is not rolled
+		Consistently(func() bool {
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: binding.GetName()}, binding); err != nil {
+				return false
+			}
+			return binding.Spec.State == fleetv1beta1.BindingStateScheduled
+		}, consistentInterval*2, interval).Should(BeTrue(), "rollout controller should not roll the binding")
+	})
+
 	It("Should rollout all the selected bindings when the rollout strategy is not set", func() {
 		// create CRP
 		var targetCluster int32 = 11
@@ -541,6 +573,17 @@ func markBindingApplied(binding *fleetv1beta1.ClusterResourceBinding, success bo
 	Eventually(func() error {
 		applyCondition.ObservedGeneration = binding.Generation
 		binding.SetConditions(applyCondition)
+		if success {
+			// the rollout controller only considers the binding ready once it is available
+			binding.SetConditions(metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Status:             metav1.ConditionTrue,
+				Reason:             "availableSucceeded",
+				ObservedGeneration: binding.Generation,
+			})
+		} else {
+			meta.RemoveStatusCondition(&binding.Status.Conditions, string(fleetv1beta1.ResourceBindingAvailable))
+		}
 		if err := k8sClient.Status().Update(ctx, binding); err != nil {
 			if apierrors.IsConflict(err) {
 				// get the binding again to avoid conflict
@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -154,6 +156,195 @@ func TestReconcilerHandleResourceBinding(t *testing.T) {
 	}
 }
 
+func TestHandleStageApproval(t *testing.T) {
+	tests := map[string]struct {
+		approval      client.Object
+		shouldEnqueue bool
+	}{
+		"test enqueue the placement of a stage approval": {
+			approval: &fleetv1beta1.ClusterStageApproval{
+				Spec: fleetv1beta1.ClusterStageApprovalSpec{
+					PlacementName:        "placement",
+					StageName:            "canary",
+					ResourceSnapshotName: "placement-1-snapshot",
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test skip a non stage approval object": {
+			approval: &fleetv1beta1.ClusterResourceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{},
+			},
+			shouldEnqueue: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := controllertest.Queue{Interface: workqueue.New()}
+			handleStageApproval(tt.approval, queue)
+			if tt.shouldEnqueue && queue.Len() == 0 {
+				t.Errorf("handleStageApproval test `%s` didn't queue the object when it should enqueue", name)
+			}
+			if !tt.shouldEnqueue && queue.Len() != 0 {
+				t.Errorf("handleStageApproval test `%s` queue the object when it should not enqueue", name)
+			}
+		})
+	}
+}
+
+func TestHandleClusterResourcePlacement(t *testing.T) {
+	ifNotDrifted := &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted}
+	tests := map[string]struct {
+		oldCRP        client.Object
+		newCRP        client.Object
+		shouldEnqueue bool
+	}{
+		"test enqueue a clusterResourcePlacement with the apply strategy changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{ApplyStrategy: ifNotDrifted},
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the deletion policy changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{ApplyStrategy: ifNotDrifted},
+				},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						ApplyStrategy:  ifNotDrifted,
+						DeletionPolicy: &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan},
+					},
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the dry-run setting changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{DryRunBeforeRollout: true},
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the rollout resumed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{Paused: true},
+				},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the pinned resource index changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					PinnedResourceIndex: pointer.Int32(1),
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the rollout gates changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						RolloutGates: []fleetv1beta1.RolloutGate{
+							{
+								Name:      "health",
+								Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+								HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: "http://app/healthz"},
+							},
+						},
+					},
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test enqueue a clusterResourcePlacement with the stages changed": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &fleetv1beta1.StagedUpdateStrategy{
+							Stages: []fleetv1beta1.StageConfig{{Name: "canary", LabelSelector: &metav1.LabelSelector{}}},
+						},
+					},
+				},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &fleetv1beta1.StagedUpdateStrategy{
+							Stages: []fleetv1beta1.StageConfig{{Name: "canary", LabelSelector: &metav1.LabelSelector{}, RequireApproval: true}},
+						},
+					},
+				},
+			},
+			shouldEnqueue: true,
+		},
+		"test skip a clusterResourcePlacement with the apply strategy unchanged": {
+			oldCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{ApplyStrategy: ifNotDrifted},
+				},
+			},
+			newCRP: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement"},
+				Spec: fleetv1beta1.ClusterResourcePlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{ApplyStrategy: ifNotDrifted},
+				},
+			},
+			shouldEnqueue: false,
+		},
+		"test skip a malformatted clusterResourcePlacement": {
+			oldCRP:        &fleetv1beta1.ClusterResourceBinding{},
+			newCRP:        &fleetv1beta1.ClusterResourceBinding{},
+			shouldEnqueue: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := controllertest.Queue{Interface: workqueue.New()}
+			handleClusterResourcePlacement(tt.oldCRP, tt.newCRP, queue)
+			if tt.shouldEnqueue && queue.Len() == 0 {
+				t.Errorf("handleClusterResourcePlacement test `%s` didn't queue the object when it should enqueue", name)
+			}
+			if !tt.shouldEnqueue && queue.Len() != 0 {
+				t.Errorf("handleClusterResourcePlacement test `%s` queue the object when it should not enqueue", name)
+			}
+		})
+	}
+}
+
 func TestWaitForResourcesToCleanUp(t *testing.T) {
 	tests := map[string]struct {
 		allBindings []*fleetv1beta1.ClusterResourceBinding
@@ -225,6 +416,9 @@ func TestReconcilerUpdateBindings(t *testing.T) {
 		name                       string
 		Client                     client.Client
 		latestResourceSnapshotName string
+		desiredOverrides           map[string]*bindingOverrides
+		applyStrategy              *fleetv1beta1.ApplyStrategy
+		deletionPolicy             *fleetv1beta1.DeletionPolicy
 		toBeUpgradedBinding        []*fleetv1beta1.ClusterResourceBinding
 		wantErr                    bool
 	}{
@@ -272,6 +466,68 @@ func TestReconcilerUpdateBindings(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"test update binding with the desired overrides": {
+			name: "Bound and scheduled state with overrides",
+			Client: &test.MockClient{
+				MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					binding := obj.(*fleetv1beta1.ClusterResourceBinding)
+					if binding.Spec.TargetCluster == cluster1 && !reflect.DeepEqual(binding.Spec.ClusterResourceOverrideSnapshots, []string{"cro-1-0"}) {
+						return errors.New("binding is not updated with the desired clusterResourceOverrideSnapshots")
+					}
+					if binding.Spec.TargetCluster == cluster2 && len(binding.Spec.ClusterResourceOverrideSnapshots) != 0 {
+						return errors.New("binding is not updated with the desired clusterResourceOverrideSnapshots")
+					}
+					return nil
+				},
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			desiredOverrides: map[string]*bindingOverrides{
+				cluster1: {clusterResourceOverrideSnapshots: []string{"cro-1-0"}},
+			},
+			toBeUpgradedBinding: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, "snapshot-1", cluster1),
+				generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2, []string{"cro-1-0"}),
+			},
+			wantErr: false,
+		},
+		"test update binding with the apply strategy": {
+			name: "Bound and scheduled state with apply strategy",
+			Client: &test.MockClient{
+				MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					binding := obj.(*fleetv1beta1.ClusterResourceBinding)
+					if binding.Spec.ApplyStrategy == nil || binding.Spec.ApplyStrategy.WhenToApply != fleetv1beta1.WhenToApplyTypeIfNotDrifted {
+						return errors.New("binding is not updated with the desired apply strategy")
+					}
+					return nil
+				},
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			applyStrategy:              &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted},
+			toBeUpgradedBinding: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, "snapshot-1", cluster1),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2),
+			},
+			wantErr: false,
+		},
+		"test update binding with the deletion policy": {
+			name: "Bound and scheduled state with deletion policy",
+			Client: &test.MockClient{
+				MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					binding := obj.(*fleetv1beta1.ClusterResourceBinding)
+					if binding.Spec.DeletionPolicy == nil || binding.Spec.DeletionPolicy.Type != fleetv1beta1.DeletionPolicyTypeOrphan {
+						return errors.New("binding is not updated with the desired deletion policy")
+					}
+					return nil
+				},
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			deletionPolicy:             &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan},
+			toBeUpgradedBinding: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, "snapshot-1", cluster1),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2),
+			},
+			wantErr: false,
+		},
 		"test update binding with unscheduled state": {
 			name: "Delete unscheduled state",
 			Client: &test.MockClient{
@@ -317,13 +573,82 @@ func TestReconcilerUpdateBindings(t *testing.T) {
 			r := &Reconciler{
 				Client: tt.Client,
 			}
-			if err := r.updateBindings(context.TODO(), tt.latestResourceSnapshotName, tt.toBeUpgradedBinding); (err != nil) != tt.wantErr {
+			if err := r.updateBindings(context.TODO(), tt.latestResourceSnapshotName, tt.desiredOverrides, tt.applyStrategy, tt.deletionPolicy, nil, tt.toBeUpgradedBinding); (err != nil) != tt.wantErr {
 				t.Errorf("updateBindings() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestReconcilerRequestDryRuns(t *testing.T) {
+	tests := map[string]struct {
+		allBindings   []*fleetv1beta1.ClusterResourceBinding
+		updateErr     error
+		wantRequested []string
+		wantErr       bool
+	}{
+		"request the dry-run on the out of date bound bindings": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-2", cluster2),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, "snapshot-1", cluster3),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateUnscheduled, "snapshot-1", cluster4),
+				func() *fleetv1beta1.ClusterResourceBinding {
+					binding := generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster5)
+					binding.Spec.DryRunResourceSnapshotName = "snapshot-2"
+					return binding
+				}(),
+			},
+			wantRequested: []string{cluster1},
+		},
+		"no dry-run to request": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-2", cluster1),
+			},
+		},
+		"failed to request the dry-run": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1),
+			},
+			updateErr:     errors.New("failed to update the binding"),
+			wantRequested: []string{cluster1},
+			wantErr:       true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var gotRequested []string
+			r := &Reconciler{
+				Client: &test.MockClient{
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						binding := obj.(*fleetv1beta1.ClusterResourceBinding)
+						if binding.Spec.DryRunResourceSnapshotName != "snapshot-2" {
+							t.Errorf("requestDryRuns() updated binding %s with dry-run snapshot %s, want snapshot-2", binding.Name, binding.Spec.DryRunResourceSnapshotName)
+						}
+						mu.Lock()
+						defer mu.Unlock()
+						gotRequested = append(gotRequested, binding.Spec.TargetCluster)
+						return tt.updateErr
+					},
+				},
+			}
+			requested, err := r.requestDryRuns(context.Background(), "snapshot-2", tt.allBindings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("requestDryRuns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if requested != (len(tt.wantRequested) != 0) {
+				t.Errorf("requestDryRuns() = %t, want %t", requested, len(tt.wantRequested) != 0)
+			}
+			// the bindings are updated in parallel
+			sort.Strings(gotRequested)
+			if !reflect.DeepEqual(gotRequested, tt.wantRequested) {
+				t.Errorf("requestDryRuns() requested the dry-run on %v, want %v", gotRequested, tt.wantRequested)
+			}
+		})
+	}
+}
+
 func TestIsBindingReady(t *testing.T) {
 	tests := map[string]struct {
 		binding         *fleetv1beta1.ClusterResourceBinding
@@ -331,7 +656,7 @@ func TestIsBindingReady(t *testing.T) {
 		wantReady       bool
 		wantWaitTime    time.Duration
 	}{
-		"binding applied before the ready time cut off should return ready": {
+		"binding available should return ready regardless of the ready time cut off": {
 			binding: &fleetv1beta1.ClusterResourceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Generation: 10,
@@ -343,7 +668,16 @@ func TestIsBindingReady(t *testing.T) {
 							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 10,
 							LastTransitionTime: metav1.Time{
-								Time: now.Add(-time.Millisecond),
+								Time: now.Add(time.Millisecond),
+							},
+						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							Reason:             "AllWorkAreAvailable",
+							LastTransitionTime: metav1.Time{
+								Time: now.Add(time.Millisecond),
 							},
 						},
 					},
@@ -353,7 +687,37 @@ func TestIsBindingReady(t *testing.T) {
 			wantReady:       true,
 			wantWaitTime:    0,
 		},
-		"binding applied after the ready time cut off should return not ready with a wait time": {
+		"binding applied but not available should return not ready with a negative wait time": {
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							LastTransitionTime: metav1.Time{
+								Time: now.Add(-time.Hour),
+							},
+						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 10,
+							LastTransitionTime: metav1.Time{
+								Time: now.Add(-time.Hour),
+							},
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       false,
+			wantWaitTime:    -1,
+		},
+		"binding applied without an available condition should return not ready with a negative wait time": {
 			binding: &fleetv1beta1.ClusterResourceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Generation: 10,
@@ -364,6 +728,52 @@ func TestIsBindingReady(t *testing.T) {
 							Type:               string(fleetv1beta1.ResourceBindingApplied),
 							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 10,
+							LastTransitionTime: metav1.Time{
+								Time: now.Add(-time.Hour),
+							},
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       false,
+			wantWaitTime:    -1,
+		},
+		"not trackable binding available before the ready time cut off should return ready": {
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							Reason:             fleetv1beta1.WorkNotTrackableReason,
+							LastTransitionTime: metav1.Time{
+								Time: now.Add(-time.Millisecond),
+							},
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       true,
+			wantWaitTime:    0,
+		},
+		"not trackable binding available after the ready time cut off should return not ready with a wait time": {
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							Reason:             fleetv1beta1.WorkNotTrackableReason,
 							LastTransitionTime: metav1.Time{
 								Time: now.Add(time.Millisecond),
 							},
@@ -385,7 +795,7 @@ func TestIsBindingReady(t *testing.T) {
 			wantReady:       false,
 			wantWaitTime:    -1,
 		},
-		"binding applied for a previous generation should return not ready with a negative wait time": {
+		"binding available for a previous generation should return not ready with a negative wait time": {
 			binding: &fleetv1beta1.ClusterResourceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Generation: 10,
@@ -393,7 +803,7 @@ func TestIsBindingReady(t *testing.T) {
 				Status: fleetv1beta1.ResourceBindingStatus{
 					Conditions: []metav1.Condition{
 						{
-							Type:               string(fleetv1beta1.ResourceBindingApplied),
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
 							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 9, //not the current generation
 							LastTransitionTime: metav1.Time{
@@ -407,6 +817,60 @@ func TestIsBindingReady(t *testing.T) {
 			wantReady:       false,
 			wantWaitTime:    -1,
 		},
+		"available binding with the rollout gates passed should return ready": {
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					RolloutGates: []fleetv1beta1.RolloutGate{{Name: "health", Type: fleetv1beta1.RolloutGateTypeHTTPProbe}},
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       true,
+			wantWaitTime:    0,
+		},
+		"available binding with the rollout gates failed should return not ready with a negative wait time": {
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					RolloutGates: []fleetv1beta1.RolloutGate{{Name: "health", Type: fleetv1beta1.RolloutGateTypeHTTPProbe}},
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 10,
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       false,
+			wantWaitTime:    -1,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -428,15 +892,54 @@ func TestPickBindingsToRoll(t *testing.T) {
 		Type:   intstr.Int,
 		IntVal: 0,
 	}
+	maxUnavailableCRP := clusterResourcePlacementForTest("test",
+		createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+	maxUnavailableCRP.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 3,
+	}
 	noMaxSurgeCRP := clusterResourcePlacementForTest("test",
 		createPlacementPolicyForTest(fleetv1beta1.PickNPlacementType, 5))
 	noMaxSurgeCRP.Spec.Strategy.RollingUpdate.MaxSurge = &intstr.IntOrString{
 		Type:   intstr.Int,
 		IntVal: 0,
 	}
+	ifNotDriftedCRP := clusterResourcePlacementForTest("test",
+		createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+	ifNotDriftedCRP.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 3,
+	}
+	ifNotDriftedCRP.Spec.Strategy.ApplyStrategy = &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted}
+	orphanCRP := clusterResourcePlacementForTest("test",
+		createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+	orphanCRP.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 3,
+	}
+	orphanCRP.Spec.Strategy.DeletionPolicy = &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan}
+	dryRunCRP := clusterResourcePlacementForTest("test",
+		createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+	dryRunCRP.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 3,
+	}
+	dryRunCRP.Spec.Strategy.DryRunBeforeRollout = true
+	dryRunBinding := func(cluster, dryRunSnapshotName string, status metav1.ConditionStatus) *fleetv1beta1.ClusterResourceBinding {
+		binding := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster))
+		binding.Spec.DryRunResourceSnapshotName = dryRunSnapshotName
+		binding.SetConditions(metav1.Condition{
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Status:             status,
+			ObservedGeneration: binding.Generation,
+		})
+		return binding
+	}
 	tests := map[string]struct {
 		allBindings                []*fleetv1beta1.ClusterResourceBinding
 		latestResourceSnapshotName string
+		desiredOverrides           map[string]*bindingOverrides
+		updatableClusters          map[string]bool
 		crp                        *fleetv1beta1.ClusterResourcePlacement
 		tobeUpdatedBindings        []int
 		needRoll                   bool
@@ -524,10 +1027,123 @@ func TestPickBindingsToRoll(t *testing.T) {
 			tobeUpdatedBindings: []int{0, 2},
 			needRoll:            true,
 		},
+		"test bound bindings with out of date overrides": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1)),
+				generateReadyClusterResourceBinding(generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2, []string{"cro-1-0"})),
+				generateReadyClusterResourceBinding(generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster3, []string{"cro-1-0"})),
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			desiredOverrides: map[string]*bindingOverrides{
+				cluster1: {clusterResourceOverrideSnapshots: []string{"cro-1-0"}},
+				cluster2: {clusterResourceOverrideSnapshots: []string{"cro-1-0"}},
+			},
+			crp:                 maxUnavailableCRP,
+			tobeUpdatedBindings: []int{0, 2},
+			needRoll:            true,
+		},
+		"test bound bindings with up to date overrides": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1, []string{"cro-1-0"})),
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2)),
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			desiredOverrides: map[string]*bindingOverrides{
+				cluster1: {clusterResourceOverrideSnapshots: []string{"cro-1-0"}},
+			},
+			crp:                 maxUnavailableCRP,
+			tobeUpdatedBindings: []int{},
+			needRoll:            false,
+		},
+		"test bound bindings with out of date apply strategy": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1)),
+				func() *fleetv1beta1.ClusterResourceBinding {
+					binding := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2))
+					binding.Spec.ApplyStrategy = &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted}
+					return binding
+				}(),
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			crp:                        ifNotDriftedCRP,
+			tobeUpdatedBindings:        []int{0},
+			needRoll:                   true,
+		},
+		"test bound bindings with out of date deletion policy": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1)),
+				func() *fleetv1beta1.ClusterResourceBinding {
+					binding := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2))
+					binding.Spec.DeletionPolicy = &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan}
+					return binding
+				}(),
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			crp:                        orphanCRP,
+			tobeUpdatedBindings:        []int{0},
+			needRoll:                   true,
+		},
+		"test bound bindings gated by the dry-run": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				dryRunBinding(cluster1, "snapshot-2", metav1.ConditionTrue),
+				dryRunBinding(cluster2, "snapshot-2", metav1.ConditionFalse),
+				dryRunBinding(cluster3, "snapshot-2", metav1.ConditionUnknown),
+				dryRunBinding(cluster4, "snapshot-1", metav1.ConditionTrue),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp:                        dryRunCRP,
+			tobeUpdatedBindings:        []int{0},
+			needRoll:                   true,
+		},
+		"test bound bindings waiting for the dry-run still need to roll": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				dryRunBinding(cluster1, "snapshot-2", metav1.ConditionFalse),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp:                        dryRunCRP,
+			tobeUpdatedBindings:        []int{},
+			needRoll:                   true,
+		},
+		"test bound bindings not gated by the dry-run without resource changes": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				func() *fleetv1beta1.ClusterResourceBinding {
+					binding := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1))
+					binding.Spec.DeletionPolicy = &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan}
+					return binding
+				}(),
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			crp:                        dryRunCRP,
+			tobeUpdatedBindings:        []int{0},
+			needRoll:                   true,
+		},
+		"test bound bindings gated by the staged update": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1)),
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2)),
+				generateClusterResourceBinding(fleetv1beta1.BindingStateScheduled, "", cluster3),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			updatableClusters:          map[string]bool{cluster1: true},
+			crp:                        maxUnavailableCRP,
+			tobeUpdatedBindings:        []int{0, 2},
+			needRoll:                   true,
+		},
+		"test bound bindings waiting for the staged update still need to roll": {
+			allBindings: []*fleetv1beta1.ClusterResourceBinding{
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-2", cluster1)),
+				generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2)),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			updatableClusters:          map[string]bool{cluster1: true},
+			crp:                        maxUnavailableCRP,
+			tobeUpdatedBindings:        []int{},
+			needRoll:                   true,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotUpdatedBindings, gotNeedRoll := pickBindingsToRoll(tt.allBindings, tt.latestResourceSnapshotName, tt.crp)
+			gotUpdatedBindings, gotNeedRoll := pickBindingsToRoll(tt.allBindings, tt.latestResourceSnapshotName, tt.desiredOverrides, tt.updatableClusters, tt.crp)
 			tobeUpdatedBindings := make([]*fleetv1beta1.ClusterResourceBinding, 0)
 			for _, index := range tt.tobeUpdatedBindings {
 				tobeUpdatedBindings = append(tobeUpdatedBindings, tt.allBindings[index])
@@ -542,6 +1158,47 @@ func TestPickBindingsToRoll(t *testing.T) {
 	}
 }
 
+func TestIsBindingOverridesUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		binding *fleetv1beta1.ClusterResourceBinding
+		desired *bindingOverrides
+		want    bool
+	}{
+		"no overrides": {
+			binding: generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1),
+			want:    true,
+		},
+		"binding has stale overrides": {
+			binding: generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1, []string{"cro-1-0"}),
+			want:    false,
+		},
+		"binding does not have the desired overrides": {
+			binding: generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1),
+			desired: &bindingOverrides{
+				resourceOverrideSnapshots: []fleetv1beta1.NamespacedName{{Namespace: "app", Name: "ro-1-0"}},
+			},
+			want: false,
+		},
+		"binding points to an old override snapshot": {
+			binding: generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1, []string{"cro-1-0"}),
+			desired: &bindingOverrides{clusterResourceOverrideSnapshots: []string{"cro-1-1"}},
+			want:    false,
+		},
+		"binding has the desired overrides": {
+			binding: generateClusterResourceBindingWithOverrides(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1, []string{"cro-1-0", "cro-2-0"}),
+			desired: &bindingOverrides{clusterResourceOverrideSnapshots: []string{"cro-1-0", "cro-2-0"}},
+			want:    true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isBindingOverridesUpToDate(tt.binding, tt.desired); got != tt.want {
+				t.Errorf("isBindingOverridesUpToDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func createPlacementPolicyForTest(placementType fleetv1beta1.PlacementType, numberOfClusters int32) *fleetv1beta1.PlacementPolicy {
 	return &fleetv1beta1.PlacementPolicy{
 		PlacementType:    placementType,
@@ -599,6 +1256,25 @@ func clusterResourcePlacementForTest(crpName string, policy *fleetv1beta1.Placem
 	}
 }
 
+func generateClusterResourceBindingWithOverrides(state fleetv1beta1.BindingState, resourceSnapshotName, targetCluster string, cros []string) *fleetv1beta1.ClusterResourceBinding {
+	binding := generateClusterResourceBinding(state, resourceSnapshotName, targetCluster)
+	binding.Spec.ClusterResourceOverrideSnapshots = cros
+	return binding
+}
+
+func generateReadyClusterResourceBinding(binding *fleetv1beta1.ClusterResourceBinding) *fleetv1beta1.ClusterResourceBinding {
+	binding.Status.Conditions = append(binding.Status.Conditions, metav1.Condition{
+		Type:               string(fleetv1beta1.ResourceBindingApplied),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+	}, metav1.Condition{
+		Type:               string(fleetv1beta1.ResourceBindingAvailable),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+	})
+	return binding
+}
+
 func generateFailedToApplyClusterResourceBinding(state fleetv1beta1.BindingState, resourceSnapshotName, targetCluster string) *fleetv1beta1.ClusterResourceBinding {
 	binding := generateClusterResourceBinding(state, resourceSnapshotName, targetCluster)
 	binding.Status.Conditions = append(binding.Status.Conditions, metav1.Condition{
@@ -0,0 +1,286 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/controller"
+	"go.goms.io/fleet/pkg/utils/overrider"
+)
+
+const (
+	// stageUpdatingReason is the reason string of the stage condition when the clusters in the stage are being updated.
+	stageUpdatingReason = "StageUpdating"
+	// stageWaitingReason is the reason string of the stage condition when the stage waits for the wait time to pass.
+	stageWaitingReason = "StageWaiting"
+	// stageWaitingForApprovalReason is the reason string of the stage condition when the stage waits for the approval.
+	stageWaitingForApprovalReason = "StageWaitingForApproval"
+	// stageSucceededReason is the reason string of the stage condition when the stage completes.
+	stageSucceededReason = "StageSucceeded"
+)
+
+// syncStagedUpdate works out the progress of the staged update of the latest resource snapshot and records it in the
+// clusterStagedUpdateRun of the CRP. It returns the target clusters which can be updated to the latest resource snapshot,
+// where nil means all of them, and the time to wait for the current stage to complete if it is waiting for its wait time.
+func (r *Reconciler) syncStagedUpdate(ctx context.Context, crp *fleetv1beta1.ClusterResourcePlacement, latestResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot,
+	allBindings []*fleetv1beta1.ClusterResourceBinding) (map[string]bool, time.Duration, error) {
+	crpKObj := klog.KObj(crp)
+	run := &fleetv1beta1.ClusterStagedUpdateRun{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: crp.Name}, run); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the clusterStagedUpdateRun", "clusterResourcePlacement", crpKObj)
+			return nil, 0, controller.NewAPIServerError(true, err)
+		}
+		run = nil
+	}
+	if crp.Spec.Strategy.Type != fleetv1beta1.StagedUpdateRolloutStrategyType {
+		// the progress of a previous staged update is no longer relevant
+		if run != nil {
+			if err := r.Client.Delete(ctx, run); err != nil && !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to delete the clusterStagedUpdateRun", "clusterResourcePlacement", crpKObj)
+				return nil, 0, controller.NewAPIServerError(false, err)
+			}
+			klog.V(2).InfoS("Deleted the clusterStagedUpdateRun", "clusterResourcePlacement", crpKObj)
+		}
+		return nil, 0, nil
+	}
+
+	stageClusters, err := r.groupClustersByStage(ctx, crp.Spec.Strategy.StagedUpdate.Stages, allBindings)
+	if err != nil {
+		return nil, 0, err
+	}
+	approvedStages, err := r.listApprovedStages(ctx, crp.Name, latestResourceSnapshot.Name)
+	if err != nil {
+		return nil, 0, err
+	}
+	if run == nil {
+		run = &fleetv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: crp.Name}}
+		if err := controllerutil.SetControllerReference(crp, run, r.Client.Scheme()); err != nil {
+			klog.ErrorS(err, "Failed to set owner reference", "clusterStagedUpdateRun", klog.KObj(run))
+			// should never happen
+			return nil, 0, controller.NewUnexpectedBehaviorError(err)
+		}
+		if err := r.Client.Create(ctx, run); err != nil {
+			klog.ErrorS(err, "Failed to create the clusterStagedUpdateRun", "clusterResourcePlacement", crpKObj)
+			return nil, 0, controller.NewCreateIgnoreAlreadyExistError(err)
+		}
+		klog.V(2).InfoS("Created the clusterStagedUpdateRun", "clusterResourcePlacement", crpKObj)
+	}
+	stagedUpdateStatus, updatableClusters, waitTime := buildStagedUpdateStatus(crp, &run.Status, latestResourceSnapshot, stageClusters, approvedStages, allBindings, time.Now())
+	if equality.Semantic.DeepEqual(run.Status, *stagedUpdateStatus) {
+		return updatableClusters, waitTime, nil
+	}
+	run.Status = *stagedUpdateStatus
+	if err := r.Client.Status().Update(ctx, run); err != nil {
+		klog.ErrorS(err, "Failed to update the staged update status", "clusterResourcePlacement", crpKObj)
+		return nil, 0, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the staged update status", "clusterResourcePlacement", crpKObj)
+	return updatableClusters, waitTime, nil
+}
+
+// groupClustersByStage returns the sorted names of the target clusters of the scheduled or bound bindings in each stage.
+// A cluster selected by multiple stages belongs to the first one of them.
+func (r *Reconciler) groupClustersByStage(ctx context.Context, stages []fleetv1beta1.StageConfig,
+	allBindings []*fleetv1beta1.ClusterResourceBinding) ([][]string, error) {
+	selectors := make([]labels.Selector, len(stages))
+	for i := range stages {
+		selector, err := metav1.LabelSelectorAsSelector(stages[i].LabelSelector)
+		if err != nil {
+			// should never happen as the stages are validated
+			return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("the labelSelector of stage `%s` is invalid: %w", stages[i].Name, err))
+		}
+		selectors[i] = selector
+	}
+	stageClusters := make([][]string, len(stages))
+	for _, binding := range allBindings {
+		if binding.Spec.State != fleetv1beta1.BindingStateScheduled && binding.Spec.State != fleetv1beta1.BindingStateBound {
+			continue
+		}
+		clusterLabels, err := overrider.FetchClusterLabels(ctx, r.Client, binding.Spec.TargetCluster)
+		if err != nil {
+			return nil, err
+		}
+		for i := range selectors {
+			if selectors[i].Matches(labels.Set(clusterLabels)) {
+				stageClusters[i] = append(stageClusters[i], binding.Spec.TargetCluster)
+				break
+			}
+		}
+	}
+	for i := range stageClusters {
+		sort.Strings(stageClusters[i])
+	}
+	return stageClusters, nil
+}
+
+// listApprovedStages returns the names of the stages of the CRP approved by the clusterStageApprovals for the resource
+// snapshot.
+func (r *Reconciler) listApprovedStages(ctx context.Context, crpName, resourceSnapshotName string) (map[string]bool, error) {
+	var approvals fleetv1beta1.ClusterStageApprovalList
+	if err := r.Client.List(ctx, &approvals); err != nil {
+		klog.ErrorS(err, "Failed to list the clusterStageApprovals", "clusterResourcePlacement", crpName)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	approvedStages := make(map[string]bool)
+	for i := range approvals.Items {
+		approval := &approvals.Items[i].Spec
+		if approval.PlacementName == crpName && approval.ResourceSnapshotName == resourceSnapshotName {
+			approvedStages[approval.StageName] = true
+		}
+	}
+	return approvedStages, nil
+}
+
+// buildStagedUpdateStatus builds the staged update status of the latest resource snapshot from the previous one.
+// The stages are processed in order, and a stage completes once all of its clusters are bound to the latest resource
+// snapshot and ready, its wait time has passed and it is approved if required. A completed stage stays completed even
+// if some of its clusters become unavailable later, so that the rollout does not go back and forth.
+// It returns the status, the target clusters which can be updated to the latest resource snapshot, where nil means
+// all of them, and the time to wait for the current stage to complete if it is waiting for its wait time.
+func buildStagedUpdateStatus(crp *fleetv1beta1.ClusterResourcePlacement, previous *fleetv1beta1.StagedUpdateStatus, latestResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot,
+	stageClusters [][]string, approvedStages map[string]bool, allBindings []*fleetv1beta1.ClusterResourceBinding, now time.Time) (*fleetv1beta1.StagedUpdateStatus, map[string]bool, time.Duration) {
+	stages := crp.Spec.Strategy.StagedUpdate.Stages
+	readyTimeCutOff := now.Add(-time.Duration(*crp.Spec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
+	bindingMap := make(map[string]*fleetv1beta1.ClusterResourceBinding, len(allBindings))
+	for _, binding := range allBindings {
+		if binding.Spec.State == fleetv1beta1.BindingStateScheduled || binding.Spec.State == fleetv1beta1.BindingStateBound {
+			bindingMap[binding.Spec.TargetCluster] = binding
+		}
+	}
+	// the progress of the stages restarts with a new resource snapshot
+	previousStages := make(map[string]*fleetv1beta1.StageUpdatingStatus)
+	if previous.ResourceSnapshotName == latestResourceSnapshot.Name {
+		for i := range previous.StagesStatus {
+			previousStages[previous.StagesStatus[i].StageName] = &previous.StagesStatus[i]
+		}
+	}
+	status := &fleetv1beta1.StagedUpdateStatus{
+		ResourceSnapshotName: latestResourceSnapshot.Name,
+		StagesStatus:         make([]fleetv1beta1.StageUpdatingStatus, len(stages)),
+	}
+	updatableClusters := make(map[string]bool)
+	var waitTime time.Duration
+	blocked := false
+	for i := range stages {
+		stage := &stages[i]
+		stageStatus := &status.StagesStatus[i]
+		stageStatus.StageName = stage.Name
+		stageStatus.Clusters = stageClusters[i]
+		if blocked {
+			// the stage does not start until all the previous stages complete
+			continue
+		}
+		if previous, ok := previousStages[stage.Name]; ok {
+			stageStatus.StartTime = previous.StartTime
+			stageStatus.EndTime = previous.EndTime
+			// copy the conditions so that the previous status is not changed
+			stageStatus.Conditions = append([]metav1.Condition(nil), previous.Conditions...)
+		}
+		for _, cluster := range stageStatus.Clusters {
+			updatableClusters[cluster] = true
+		}
+		if meta.IsStatusConditionTrue(stageStatus.Conditions, string(fleetv1beta1.StageUpdatingConditionSucceeded)) {
+			continue
+		}
+		status.CurrentStage = stage.Name
+		blocked = true
+		if stageStatus.StartTime == nil {
+			stageStatus.StartTime = &metav1.Time{Time: now}
+		}
+		if !isStageUpdated(stageStatus.Clusters, bindingMap, latestResourceSnapshot.Name, readyTimeCutOff) {
+			stageStatus.EndTime = nil
+			meta.SetStatusCondition(&stageStatus.Conditions, metav1.Condition{
+				Type:               string(fleetv1beta1.StageUpdatingConditionProgressing),
+				Status:             metav1.ConditionTrue,
+				Reason:             stageUpdatingReason,
+				Message:            "The clusters in the stage are being updated to the latest resources",
+				ObservedGeneration: crp.Generation,
+			})
+			continue
+		}
+		if stageStatus.EndTime == nil {
+			stageStatus.EndTime = &metav1.Time{Time: now}
+		}
+		if stage.WaitTime != nil {
+			waitUntil := stageStatus.EndTime.Add(stage.WaitTime.Duration)
+			if now.Before(waitUntil) {
+				meta.SetStatusCondition(&stageStatus.Conditions, metav1.Condition{
+					Type:               string(fleetv1beta1.StageUpdatingConditionProgressing),
+					Status:             metav1.ConditionFalse,
+					Reason:             stageWaitingReason,
+					Message:            fmt.Sprintf("All the clusters in the stage are updated, waiting until %s before moving to the next stage", waitUntil.UTC().Format(time.RFC3339)),
+					ObservedGeneration: crp.Generation,
+				})
+				waitTime = waitUntil.Sub(now)
+				continue
+			}
+		}
+		if stage.RequireApproval && !approvedStages[stage.Name] {
+			meta.SetStatusCondition(&stageStatus.Conditions, metav1.Condition{
+				Type:   string(fleetv1beta1.StageUpdatingConditionProgressing),
+				Status: metav1.ConditionFalse,
+				Reason: stageWaitingForApprovalReason,
+				Message: fmt.Sprintf("All the clusters in the stage are updated, create a clusterStageApproval of the stage for the resourceSnapshot %s to approve it",
+					latestResourceSnapshot.Name),
+				ObservedGeneration: crp.Generation,
+			})
+			continue
+		}
+		meta.SetStatusCondition(&stageStatus.Conditions, metav1.Condition{
+			Type:               string(fleetv1beta1.StageUpdatingConditionProgressing),
+			Status:             metav1.ConditionFalse,
+			Reason:             stageSucceededReason,
+			Message:            "The stage completes",
+			ObservedGeneration: crp.Generation,
+		})
+		meta.SetStatusCondition(&stageStatus.Conditions, metav1.Condition{
+			Type:               string(fleetv1beta1.StageUpdatingConditionSucceeded),
+			Status:             metav1.ConditionTrue,
+			Reason:             stageSucceededReason,
+			Message:            "All the clusters in the stage are updated to the latest resources",
+			ObservedGeneration: crp.Generation,
+		})
+		status.CurrentStage = ""
+		blocked = false
+	}
+	if !blocked {
+		// the clusters not selected by any stage are updated after all the stages complete
+		klog.V(2).InfoS("All the stages of the staged update complete", "clusterResourcePlacement", klog.KObj(crp), "resourceSnapshot", latestResourceSnapshot.Name)
+		return status, nil, 0
+	}
+	klog.V(2).InfoS("Found the current stage of the staged update", "clusterResourcePlacement", klog.KObj(crp),
+		"resourceSnapshot", latestResourceSnapshot.Name, "stage", status.CurrentStage)
+	return status, updatableClusters, waitTime
+}
+
+// isStageUpdated checks if the bindings of all the clusters in a stage are bound to the latest resource snapshot and ready.
+func isStageUpdated(clusters []string, bindingMap map[string]*fleetv1beta1.ClusterResourceBinding, latestResourceSnapshotName string, readyTimeCutOff time.Time) bool {
+	for _, cluster := range clusters {
+		binding := bindingMap[cluster]
+		if binding == nil || binding.Spec.State != fleetv1beta1.BindingStateBound || binding.Spec.ResourceSnapshotName != latestResourceSnapshotName {
+			return false
+		}
+		if _, ready := isBindingReady(binding, readyTimeCutOff); !ready {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,257 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestBuildStagedUpdateStatus(t *testing.T) {
+	stagedUpdateCRP := func(canary fleetv1beta1.StageConfig) *fleetv1beta1.ClusterResourcePlacement {
+		crp := clusterResourcePlacementForTest("test", createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+		crp.Spec.Strategy.Type = fleetv1beta1.StagedUpdateRolloutStrategyType
+		canary.Name = "canary"
+		crp.Spec.Strategy.StagedUpdate = &fleetv1beta1.StagedUpdateStrategy{
+			Stages: []fleetv1beta1.StageConfig{canary, {Name: "prod"}},
+		}
+		return crp
+	}
+	succeededCanary := func(snapshotName string) fleetv1beta1.StagedUpdateStatus {
+		return fleetv1beta1.StagedUpdateStatus{
+			ResourceSnapshotName: snapshotName,
+			StagesStatus: []fleetv1beta1.StageUpdatingStatus{
+				{
+					StageName: "canary",
+					Conditions: []metav1.Condition{
+						{Type: string(fleetv1beta1.StageUpdatingConditionSucceeded), Status: metav1.ConditionTrue, Reason: stageSucceededReason},
+					},
+				},
+			},
+		}
+	}
+	latestSnapshot := &fleetv1beta1.ClusterResourceSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot-2"}}
+	updatedCanary := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-2", cluster1))
+	outdatedCanary := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster1))
+	updatedProd := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-2", cluster2))
+	outdatedProd := generateReadyClusterResourceBinding(generateClusterResourceBinding(fleetv1beta1.BindingStateBound, "snapshot-1", cluster2))
+
+	tests := map[string]struct {
+		crp                   *fleetv1beta1.ClusterResourcePlacement
+		previous              fleetv1beta1.StagedUpdateStatus
+		latestSnapshot        *fleetv1beta1.ClusterResourceSnapshot
+		approvedStages        map[string]bool
+		allBindings           []*fleetv1beta1.ClusterResourceBinding
+		wantCurrentStage      string
+		wantStageReasons      []string
+		wantUpdatableClusters map[string]bool
+		wantWaitTime          time.Duration
+	}{
+		"the first stage is being updated": {
+			crp:                   stagedUpdateCRP(fleetv1beta1.StageConfig{}),
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{outdatedCanary, outdatedProd},
+			wantCurrentStage:      "canary",
+			wantStageReasons:      []string{stageUpdatingReason, ""},
+			wantUpdatableClusters: map[string]bool{cluster1: true},
+		},
+		"the next stage starts after the first stage completes": {
+			crp:                   stagedUpdateCRP(fleetv1beta1.StageConfig{}),
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{updatedCanary, outdatedProd},
+			wantCurrentStage:      "prod",
+			wantStageReasons:      []string{stageSucceededReason, stageUpdatingReason},
+			wantUpdatableClusters: map[string]bool{cluster1: true, cluster2: true},
+		},
+		"the first stage waits for its wait time": {
+			crp: stagedUpdateCRP(fleetv1beta1.StageConfig{WaitTime: &metav1.Duration{Duration: time.Hour}}),
+			previous: fleetv1beta1.StagedUpdateStatus{
+				ResourceSnapshotName: "snapshot-2",
+				StagesStatus: []fleetv1beta1.StageUpdatingStatus{
+					{StageName: "canary", EndTime: &metav1.Time{Time: now.Add(-time.Minute * 30)}},
+				},
+			},
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{updatedCanary, outdatedProd},
+			wantCurrentStage:      "canary",
+			wantStageReasons:      []string{stageWaitingReason, ""},
+			wantUpdatableClusters: map[string]bool{cluster1: true},
+			wantWaitTime:          time.Minute * 30,
+		},
+		"the first stage waits for the approval": {
+			crp:                   stagedUpdateCRP(fleetv1beta1.StageConfig{RequireApproval: true}),
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{updatedCanary, outdatedProd},
+			wantCurrentStage:      "canary",
+			wantStageReasons:      []string{stageWaitingForApprovalReason, ""},
+			wantUpdatableClusters: map[string]bool{cluster1: true},
+		},
+		"all the stages complete after the approval": {
+			crp:              stagedUpdateCRP(fleetv1beta1.StageConfig{RequireApproval: true}),
+			latestSnapshot:   latestSnapshot,
+			approvedStages:   map[string]bool{"staging": true, "canary": true},
+			allBindings:      []*fleetv1beta1.ClusterResourceBinding{updatedCanary, updatedProd},
+			wantStageReasons: []string{stageSucceededReason, stageSucceededReason},
+		},
+		"a completed stage stays completed": {
+			crp:                   stagedUpdateCRP(fleetv1beta1.StageConfig{}),
+			previous:              succeededCanary("snapshot-2"),
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{outdatedCanary, outdatedProd},
+			wantCurrentStage:      "prod",
+			wantStageReasons:      []string{stageSucceededReason, stageUpdatingReason},
+			wantUpdatableClusters: map[string]bool{cluster1: true, cluster2: true},
+		},
+		"the stages restart with a new resource snapshot": {
+			crp:                   stagedUpdateCRP(fleetv1beta1.StageConfig{}),
+			previous:              succeededCanary("snapshot-1"),
+			latestSnapshot:        latestSnapshot,
+			allBindings:           []*fleetv1beta1.ClusterResourceBinding{outdatedCanary, outdatedProd},
+			wantCurrentStage:      "canary",
+			wantStageReasons:      []string{stageUpdatingReason, ""},
+			wantUpdatableClusters: map[string]bool{cluster1: true},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stageClusters := [][]string{{cluster1}, {cluster2}}
+			status, updatableClusters, waitTime := buildStagedUpdateStatus(tt.crp, &tt.previous, tt.latestSnapshot, stageClusters, tt.approvedStages, tt.allBindings, now)
+			if status.CurrentStage != tt.wantCurrentStage {
+				t.Errorf("buildStagedUpdateStatus() currentStage = %s, want %s", status.CurrentStage, tt.wantCurrentStage)
+			}
+			gotStageReasons := make([]string, len(status.StagesStatus))
+			for i, stageStatus := range status.StagesStatus {
+				if cond := meta.FindStatusCondition(stageStatus.Conditions, string(fleetv1beta1.StageUpdatingConditionProgressing)); cond != nil {
+					gotStageReasons[i] = cond.Reason
+				} else if cond := meta.FindStatusCondition(stageStatus.Conditions, string(fleetv1beta1.StageUpdatingConditionSucceeded)); cond != nil {
+					gotStageReasons[i] = cond.Reason
+				}
+			}
+			if diff := cmp.Diff(tt.wantStageReasons, gotStageReasons); diff != "" {
+				t.Errorf("buildStagedUpdateStatus() stage reasons mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantUpdatableClusters, updatableClusters); diff != "" {
+				t.Errorf("buildStagedUpdateStatus() updatable clusters mismatch (-want, +got):\n%s", diff)
+			}
+			if waitTime != tt.wantWaitTime {
+				t.Errorf("buildStagedUpdateStatus() waitTime = %s, want %s", waitTime, tt.wantWaitTime)
+			}
+		})
+	}
+}
+
+func TestListApprovedStages(t *testing.T) {
+	approval := func(placementName, stageName, resourceSnapshotName string) fleetv1beta1.ClusterStageApproval {
+		return fleetv1beta1.ClusterStageApproval{
+			Spec: fleetv1beta1.ClusterStageApprovalSpec{
+				PlacementName:        placementName,
+				StageName:            stageName,
+				ResourceSnapshotName: resourceSnapshotName,
+			},
+		}
+	}
+	r := &Reconciler{
+		Client: &test.MockClient{
+			MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				list.(*fleetv1beta1.ClusterStageApprovalList).Items = []fleetv1beta1.ClusterStageApproval{
+					approval("test", "canary", "snapshot-2"),
+					approval("test", "staging", "snapshot-1"),
+					approval("other", "prod", "snapshot-2"),
+				}
+				return nil
+			},
+		},
+	}
+	got, err := r.listApprovedStages(context.Background(), "test", "snapshot-2")
+	if err != nil {
+		t.Fatalf("listApprovedStages() got error %v, want no error", err)
+	}
+	if diff := cmp.Diff(map[string]bool{"canary": true}, got); diff != "" {
+		t.Errorf("listApprovedStages() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSyncStagedUpdate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add the placement APIs to the scheme: %v", err)
+	}
+	latestSnapshot := &fleetv1beta1.ClusterResourceSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot-2"}}
+	tests := map[string]struct {
+		strategyType fleetv1beta1.RolloutStrategyType
+		existingRun  bool
+		wantRun      bool
+	}{
+		"create the clusterStagedUpdateRun of the staged update": {
+			strategyType: fleetv1beta1.StagedUpdateRolloutStrategyType,
+			wantRun:      true,
+		},
+		"update the existing clusterStagedUpdateRun of the staged update": {
+			strategyType: fleetv1beta1.StagedUpdateRolloutStrategyType,
+			existingRun:  true,
+			wantRun:      true,
+		},
+		"delete the clusterStagedUpdateRun once the strategy is not staged update": {
+			strategyType: fleetv1beta1.RollingUpdateRolloutStrategyType,
+			existingRun:  true,
+		},
+		"no clusterStagedUpdateRun for the rolling update": {
+			strategyType: fleetv1beta1.RollingUpdateRolloutStrategyType,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest("test", createPlacementPolicyForTest(fleetv1beta1.PickAllPlacementType, 0))
+			crp.Spec.Strategy.Type = tt.strategyType
+			crp.Spec.Strategy.StagedUpdate = &fleetv1beta1.StagedUpdateStrategy{
+				Stages: []fleetv1beta1.StageConfig{{Name: "canary"}},
+			}
+			objects := []client.Object{crp}
+			if tt.existingRun {
+				objects = append(objects, &fleetv1beta1.ClusterStagedUpdateRun{
+					ObjectMeta: metav1.ObjectMeta{Name: crp.Name},
+					Status:     fleetv1beta1.StagedUpdateStatus{ResourceSnapshotName: "snapshot-1"},
+				})
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			}
+			if _, _, err := r.syncStagedUpdate(context.Background(), crp, latestSnapshot, nil); err != nil {
+				t.Fatalf("syncStagedUpdate() got error %v, want no error", err)
+			}
+			run := &fleetv1beta1.ClusterStagedUpdateRun{}
+			err := r.Client.Get(context.Background(), types.NamespacedName{Name: crp.Name}, run)
+			if !tt.wantRun {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("syncStagedUpdate() got clusterStagedUpdateRun error %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get the clusterStagedUpdateRun: %v", err)
+			}
+			if run.Status.ResourceSnapshotName != latestSnapshot.Name {
+				t.Errorf("syncStagedUpdate() got resourceSnapshotName %s, want %s", run.Status.ResourceSnapshotName, latestSnapshot.Name)
+			}
+			if !tt.existingRun && !metav1.IsControlledBy(run, crp) {
+				t.Errorf("syncStagedUpdate() got owner references %+v, want controlled by the clusterResourcePlacement", run.OwnerReferences)
+			}
+		})
+	}
+}
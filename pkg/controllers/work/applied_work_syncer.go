@@ -24,13 +24,16 @@ package work
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 )
@@ -107,10 +110,31 @@ func (r *ApplyWorkReconciler) generateDiff(ctx context.Context, work *fleetv1bet
 	return newRes, staleRes, nil
 }
 
-func (r *ApplyWorkReconciler) deleteStaleManifest(ctx context.Context, staleManifests []fleetv1beta1.AppliedResourceMeta, owner metav1.OwnerReference) error {
+// deleteStaleManifest deletes the stale manifests from the member cluster according to the deletion policy of the work.
+// It returns the stale manifests that are still retained on the cluster and the time after which the next one of them
+// is due for deletion.
+func (r *ApplyWorkReconciler) deleteStaleManifest(ctx context.Context, staleManifests []fleetv1beta1.AppliedResourceMeta,
+	owner metav1.OwnerReference, deletionPolicy *fleetv1beta1.DeletionPolicy) ([]fleetv1beta1.AppliedResourceMeta, time.Duration, error) {
 	var errs []error
+	var retained []fleetv1beta1.AppliedResourceMeta
+	var requeueAfter time.Duration
+	orphan := deletionPolicy != nil && deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeOrphan
 
 	for _, staleManifest := range staleManifests {
+		if deletionPolicy != nil && deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeRetain {
+			if staleManifest.RetainedSince == nil {
+				staleManifest.RetainedSince = &metav1.Time{Time: time.Now()}
+			}
+			gracePeriod := time.Duration(pointer.Int32Deref(deletionPolicy.RetainGracePeriodSeconds, 0)) * time.Second
+			if remaining := time.Until(staleManifest.RetainedSince.Add(gracePeriod)); remaining > 0 {
+				klog.V(2).InfoS("retain the staled manifest until the grace period ends", "manifest", staleManifest, "owner", owner, "remaining", remaining)
+				retained = append(retained, staleManifest)
+				if requeueAfter == 0 || remaining < requeueAfter {
+					requeueAfter = remaining
+				}
+				continue
+			}
+		}
 		gvr := schema.GroupVersionResource{
 			Group:    staleManifest.Group,
 			Version:  staleManifest.Version,
@@ -142,7 +166,7 @@ func (r *ApplyWorkReconciler) deleteStaleManifest(ctx context.Context, staleMani
 			klog.V(2).InfoS("the stale manifest is not owned by this work, skip", "manifest", staleManifest, "owner", owner)
 			continue
 		}
-		if len(newOwners) == 0 {
+		if len(newOwners) == 0 && !orphan {
 			klog.V(2).InfoS("delete the staled manifest", "manifest", staleManifest, "owner", owner)
 			err = r.spokeDynamicClient.Resource(gvr).Namespace(staleManifest.Namespace).
 				Delete(ctx, staleManifest.Name, metav1.DeleteOptions{})
@@ -160,6 +184,60 @@ func (r *ApplyWorkReconciler) deleteStaleManifest(ctx context.Context, staleMani
 			}
 		}
 	}
+	return retained, requeueAfter, utilerrors.NewAggregate(errs)
+}
+
+// orphanAppliedResources removes the owner reference of the appliedWork from all the resources it applied so that
+// the resources are left on the cluster when the appliedWork is deleted.
+func (r *ApplyWorkReconciler) orphanAppliedResources(ctx context.Context, appliedWorkName string) error {
+	appliedWork := &fleetv1beta1.AppliedWork{}
+	if err := r.spokeClient.Get(ctx, types.NamespacedName{Name: appliedWorkName}, appliedWork); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("the appliedWork is already deleted", "appliedWork", appliedWorkName)
+			return nil
+		}
+		klog.ErrorS(err, "failed to get the appliedWork", "appliedWork", appliedWorkName)
+		return err
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: fleetv1beta1.GroupVersion.String(),
+		Kind:       fleetv1beta1.AppliedWorkKind,
+		Name:       appliedWork.GetName(),
+		UID:        appliedWork.GetUID(),
+	}
+	var errs []error
+	for _, res := range appliedWork.Status.AppliedResources {
+		gvr := schema.GroupVersionResource{
+			Group:    res.Group,
+			Version:  res.Version,
+			Resource: res.Resource,
+		}
+		uObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(res.Namespace).Get(ctx, res.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				klog.V(2).InfoS("the applied manifest is already deleted", "manifest", res, "owner", owner)
+				continue
+			}
+			klog.ErrorS(err, "failed to get the applied manifest", "manifest", res, "owner", owner)
+			errs = append(errs, err)
+			continue
+		}
+		existingOwners := uObj.GetOwnerReferences()
+		index := indexOwnerRef(existingOwners, owner)
+		if index == -1 {
+			klog.V(2).InfoS("the applied manifest is not owned by this work, skip", "manifest", res, "owner", owner)
+			continue
+		}
+		newOwners := make([]metav1.OwnerReference, 0, len(existingOwners)-1)
+		newOwners = append(newOwners, existingOwners[:index]...)
+		newOwners = append(newOwners, existingOwners[index+1:]...)
+		uObj.SetOwnerReferences(newOwners)
+		klog.V(2).InfoS("orphan the applied manifest", "manifest", res, "owner", owner)
+		if _, err = r.spokeDynamicClient.Resource(gvr).Namespace(res.Namespace).Update(ctx, uObj, metav1.UpdateOptions{FieldManager: workFieldManagerName}); err != nil {
+			klog.ErrorS(err, "failed to remove the owner reference from the applied manifest", "manifest", res, "owner", owner)
+			errs = append(errs, err)
+		}
+	}
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -25,19 +25,24 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
 	testingclient "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 )
@@ -214,6 +219,8 @@ func TestDeleteStaleManifest(t *testing.T) {
 		spokeDynamicClient dynamic.Interface
 		staleManifests     []fleetv1beta1.AppliedResourceMeta
 		owner              metav1.OwnerReference
+		deletionPolicy     *fleetv1beta1.DeletionPolicy
+		wantRetained       []string
 		wantErr            error
 	}{
 		"test staled manifests  already deleted": {
@@ -294,13 +301,128 @@ func TestDeleteStaleManifest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"test orphan a staled manifest instead of deleting it": {
+			spokeDynamicClient: func() *fake.FakeDynamicClient {
+				uObj := unstructured.Unstructured{}
+				uObj.SetOwnerReferences([]metav1.OwnerReference{
+					{
+						APIVersion: "owned by work",
+					},
+				})
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, uObj.DeepCopy(), nil
+				})
+				dynamicClient.PrependReactor("update", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					obj := action.(testingclient.UpdateAction).GetObject().(*unstructured.Unstructured)
+					if len(obj.GetOwnerReferences()) != 0 {
+						return true, nil, fmt.Errorf("owner reference is not removed")
+					}
+					return true, obj, nil
+				})
+				dynamicClient.PrependReactor("delete", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("should not call")
+				})
+				return dynamicClient
+			}(),
+			staleManifests: []fleetv1beta1.AppliedResourceMeta{
+				{
+					WorkResourceIdentifier: fleetv1beta1.WorkResourceIdentifier{
+						Name: "does not matter",
+					},
+				},
+			},
+			owner: metav1.OwnerReference{
+				APIVersion: "owned by work",
+			},
+			deletionPolicy: &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan},
+			wantErr:        nil,
+		},
+		"test retain the staled manifests within the grace period": {
+			spokeDynamicClient: func() *fake.FakeDynamicClient {
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("*", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("should not call")
+				})
+				return dynamicClient
+			}(),
+			staleManifests: []fleetv1beta1.AppliedResourceMeta{
+				{
+					WorkResourceIdentifier: fleetv1beta1.WorkResourceIdentifier{
+						Name: "newly staled",
+					},
+				},
+				{
+					WorkResourceIdentifier: fleetv1beta1.WorkResourceIdentifier{
+						Name: "staled a while ago",
+					},
+					RetainedSince: &metav1.Time{Time: time.Now().Add(-time.Second)},
+				},
+			},
+			owner: metav1.OwnerReference{
+				APIVersion: "owned by work",
+			},
+			deletionPolicy: &fleetv1beta1.DeletionPolicy{
+				Type:                     fleetv1beta1.DeletionPolicyTypeRetain,
+				RetainGracePeriodSeconds: pointer.Int32(60),
+			},
+			wantRetained: []string{"newly staled", "staled a while ago"},
+			wantErr:      nil,
+		},
+		"test delete the staled manifest after the grace period": {
+			spokeDynamicClient: func() *fake.FakeDynamicClient {
+				uObj := unstructured.Unstructured{}
+				uObj.SetOwnerReferences([]metav1.OwnerReference{
+					{
+						APIVersion: "owned by work",
+					},
+				})
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, uObj.DeepCopy(), nil
+				})
+				dynamicClient.PrependReactor("delete", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("delete called")
+				})
+				return dynamicClient
+			}(),
+			staleManifests: []fleetv1beta1.AppliedResourceMeta{
+				{
+					WorkResourceIdentifier: fleetv1beta1.WorkResourceIdentifier{
+						Name: "does not matter",
+					},
+					RetainedSince: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+				},
+			},
+			owner: metav1.OwnerReference{
+				APIVersion: "owned by work",
+			},
+			deletionPolicy: &fleetv1beta1.DeletionPolicy{
+				Type:                     fleetv1beta1.DeletionPolicyTypeRetain,
+				RetainGracePeriodSeconds: pointer.Int32(60),
+			},
+			wantErr: utilerrors.NewAggregate([]error{fmt.Errorf("delete called")}),
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			r := &ApplyWorkReconciler{
 				spokeDynamicClient: tt.spokeDynamicClient,
 			}
-			gotErr := r.deleteStaleManifest(context.Background(), tt.staleManifests, tt.owner)
+			gotRetained, gotRequeueAfter, gotErr := r.deleteStaleManifest(context.Background(), tt.staleManifests, tt.owner, tt.deletionPolicy)
+			var gotRetainedNames []string
+			for _, res := range gotRetained {
+				if res.RetainedSince == nil {
+					t.Errorf("test case `%s` retained manifest %s without the retained since time", name, res.Name)
+				}
+				gotRetainedNames = append(gotRetainedNames, res.Name)
+			}
+			if diff := cmp.Diff(tt.wantRetained, gotRetainedNames); diff != "" {
+				t.Errorf("test case `%s` retained manifests mismatch (-want, +got):\n%s", name, diff)
+			}
+			if gotRequeue := gotRequeueAfter > 0; gotRequeue != (len(tt.wantRetained) > 0) {
+				t.Errorf("test case `%s` got requeue after %v, want requeue %t", name, gotRequeueAfter, len(tt.wantRetained) > 0)
+			}
 			if tt.wantErr == nil {
 				if gotErr != nil {
 					t.Errorf("test case `%s` didn't return the exepected error,  want no error, got error = %+v ", name, gotErr)
@@ -312,6 +434,159 @@ func TestDeleteStaleManifest(t *testing.T) {
 	}
 }
 
+func TestOrphanAppliedResources(t *testing.T) {
+	appliedWork := fleetv1beta1.AppliedWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "work",
+			UID:  types.UID("uid"),
+		},
+		Status: fleetv1beta1.AppliedWorkStatus{
+			AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+				{
+					WorkResourceIdentifier: fleetv1beta1.WorkResourceIdentifier{
+						Name: "does not matter",
+					},
+				},
+			},
+		},
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: fleetv1beta1.GroupVersion.String(),
+		Kind:       fleetv1beta1.AppliedWorkKind,
+		Name:       appliedWork.Name,
+		UID:        appliedWork.UID,
+	}
+	otherOwner := metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       "other",
+		UID:        types.UID("other-uid"),
+	}
+	getAppliedWork := func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		appliedWork.DeepCopyInto(obj.(*fleetv1beta1.AppliedWork))
+		return nil
+	}
+
+	tests := map[string]struct {
+		getAppliedWork     test.MockGetFn
+		spokeDynamicClient func(updated *[]metav1.OwnerReference) *fake.FakeDynamicClient
+		wantOwners         []metav1.OwnerReference
+		wantErr            error
+	}{
+		"appliedWork is already deleted": {
+			getAppliedWork: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+			},
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("should not call")
+				})
+				return dynamicClient
+			},
+		},
+		"failed to get the appliedWork": {
+			getAppliedWork: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				return fmt.Errorf("get failed")
+			},
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				return fake.NewSimpleDynamicClient(runtime.NewScheme())
+			},
+			wantErr: fmt.Errorf("get failed"),
+		},
+		"applied manifest is already deleted": {
+			getAppliedWork: getAppliedWork,
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, apierrors.NewNotFound(schema.GroupResource{}, "does not matter")
+				})
+				return dynamicClient
+			},
+		},
+		"failed to get the applied manifest": {
+			getAppliedWork: getAppliedWork,
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("get failed")
+				})
+				return dynamicClient
+			},
+			wantErr: utilerrors.NewAggregate([]error{fmt.Errorf("get failed")}),
+		},
+		"applied manifest not owned by the appliedWork is left untouched": {
+			getAppliedWork: getAppliedWork,
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				uObj := unstructured.Unstructured{}
+				uObj.SetOwnerReferences([]metav1.OwnerReference{otherOwner})
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, uObj.DeepCopy(), nil
+				})
+				dynamicClient.PrependReactor("update", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("should not call")
+				})
+				return dynamicClient
+			},
+		},
+		"owner reference of the appliedWork is removed": {
+			getAppliedWork: getAppliedWork,
+			spokeDynamicClient: func(updated *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				uObj := unstructured.Unstructured{}
+				uObj.SetOwnerReferences([]metav1.OwnerReference{otherOwner, owner})
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, uObj.DeepCopy(), nil
+				})
+				dynamicClient.PrependReactor("update", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					obj := action.(testingclient.UpdateAction).GetObject().(*unstructured.Unstructured)
+					*updated = obj.GetOwnerReferences()
+					return true, obj, nil
+				})
+				return dynamicClient
+			},
+			wantOwners: []metav1.OwnerReference{otherOwner},
+		},
+		"failed to remove the owner reference": {
+			getAppliedWork: getAppliedWork,
+			spokeDynamicClient: func(_ *[]metav1.OwnerReference) *fake.FakeDynamicClient {
+				uObj := unstructured.Unstructured{}
+				uObj.SetOwnerReferences([]metav1.OwnerReference{owner})
+				dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+				dynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, uObj.DeepCopy(), nil
+				})
+				dynamicClient.PrependReactor("update", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, fmt.Errorf("update failed")
+				})
+				return dynamicClient
+			},
+			wantErr: utilerrors.NewAggregate([]error{fmt.Errorf("update failed")}),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotOwners []metav1.OwnerReference
+			r := &ApplyWorkReconciler{
+				spokeClient:        &test.MockClient{MockGet: tt.getAppliedWork},
+				spokeDynamicClient: tt.spokeDynamicClient(&gotOwners),
+			}
+			gotErr := r.orphanAppliedResources(context.Background(), appliedWork.Name)
+			if tt.wantErr == nil {
+				if gotErr != nil {
+					t.Errorf("orphanAppliedResources() got error %v, want no error", gotErr)
+				}
+			} else if gotErr == nil || gotErr.Error() != tt.wantErr.Error() {
+				t.Errorf("orphanAppliedResources() got error %v, want error %v", gotErr, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantOwners, gotOwners); diff != "" {
+				t.Errorf("orphanAppliedResources() owner references mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func generateWorkObj(identifier *fleetv1beta1.WorkResourceIdentifier) fleetv1beta1.Work {
 	if identifier != nil {
 		return fleetv1beta1.Work{
@@ -26,6 +26,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"go.uber.org/atomic"
@@ -34,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -47,14 +49,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	"go.goms.io/fleet/pkg/metrics"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
 	"go.goms.io/fleet/pkg/utils"
 )
 
 const (
 	workFieldManagerName = "work-api-agent"
+
+	// availabilityRecheckInterval is the interval at which the work is reconciled again when
+	// some of its manifests are not available yet or are waiting for the previous apply waves.
+	availabilityRecheckInterval = time.Second * 5
+
+	// maxBackReportedStatusSize is the max size of the status of an applied resource reported back to the hub cluster,
+	// so that the work does not grow too large.
+	maxBackReportedStatusSize = 4 * 1024
 )
 
 // WorkCondition condition reasons
@@ -65,6 +77,8 @@ const (
 	AppliedWorkCompleteReason = "AppliedWorkComplete"
 	// AppliedManifestFailedReason is the reason string of condition when it failed to apply manifest.
 	AppliedManifestFailedReason = "AppliedManifestFailedReason"
+	// AppliedWorkPendingReason is the reason string of work condition when some of the manifests are waiting to be applied.
+	AppliedWorkPendingReason = "AppliedWorkPending"
 )
 
 // ApplyWorkReconciler reconciles a Work object
@@ -75,12 +89,13 @@ type ApplyWorkReconciler struct {
 	restMapper         meta.RESTMapper
 	recorder           record.EventRecorder
 	concurrency        int
+	applyConcurrency   int
 	workNameSpace      string
 	joined             *atomic.Bool
 }
 
 func NewApplyWorkReconciler(hubClient client.Client, spokeDynamicClient dynamic.Interface, spokeClient client.Client,
-	restMapper meta.RESTMapper, recorder record.EventRecorder, concurrency int, workNameSpace string) *ApplyWorkReconciler {
+	restMapper meta.RESTMapper, recorder record.EventRecorder, concurrency, applyConcurrency int, workNameSpace string) *ApplyWorkReconciler {
 	return &ApplyWorkReconciler{
 		client:             hubClient,
 		spokeDynamicClient: spokeDynamicClient,
@@ -88,6 +103,7 @@ func NewApplyWorkReconciler(hubClient client.Client, spokeDynamicClient dynamic.
 		restMapper:         restMapper,
 		recorder:           recorder,
 		concurrency:        concurrency,
+		applyConcurrency:   applyConcurrency,
 		workNameSpace:      workNameSpace,
 		joined:             atomic.NewBool(false),
 	}
@@ -109,6 +125,22 @@ const (
 
 	// ManifestNoChangeAction indicates that we don't need to change the manifest.
 	ManifestNoChangeAction applyAction = "ManifestNoChange"
+
+	// ManifestDriftedAction indicates that we found drifts on the manifest and left them as they are
+	// according to the apply strategy.
+	ManifestDriftedAction applyAction = "ManifestDrifted"
+
+	// ManifestDiffReportedAction indicates that we found differences between the manifest and the resource on the
+	// cluster and only reported them according to the apply strategy.
+	ManifestDiffReportedAction applyAction = "ManifestDiffReported"
+
+	// ManifestNotTakenOverAction indicates that we found the resource not managed by the work controller on the cluster
+	// and did not take it over because it differs from the manifest.
+	ManifestNotTakenOverAction applyAction = "ManifestNotTakenOver"
+
+	// ManifestApplyWaitingAction indicates that we did not apply the manifest because the manifests in the previous
+	// apply waves are not applied or not available yet.
+	ManifestApplyWaitingAction applyAction = "ManifestApplyWaiting"
 )
 
 // applyResult contains the result of a manifest being applied.
@@ -117,6 +149,19 @@ type applyResult struct {
 	generation int64
 	action     applyAction
 	err        error
+	// availability and availabilityErr are only set when the manifest is applied successfully.
+	availability    manifestAvailabilityType
+	availabilityErr error
+	// drifts are only set when the manifest has drifted and the drifts are not overwritten.
+	drifts []fleetv1beta1.PatchDetail
+	// diffs and diffObservedGeneration are only set when differences are found and reported, either because the apply
+	// strategy is ReportDiff or because the resource is not taken over; diffObservedGeneration is nil if the resource
+	// does not exist on the cluster.
+	diffs                  []fleetv1beta1.PatchDetail
+	diffObservedGeneration *int64
+	// observedStatus is only set when the manifest is applied successfully and the apply strategy asks to
+	// report back its status.
+	observedStatus *runtime.RawExtension
 }
 
 // Reconcile implement the control loop logic for Work object.
@@ -146,6 +191,16 @@ func (r *ApplyWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return r.garbageCollectAppliedWork(ctx, work)
 	}
 
+	// a dry-run work is only validated against the cluster, nothing is applied
+	if work.Spec.DryRun {
+		return r.dryRunWork(ctx, work)
+	}
+
+	// a rollout gate work only carries the checks run against the cluster, nothing is applied
+	if len(work.Spec.RolloutGates) != 0 {
+		return r.checkRolloutGatesOfWork(ctx, work)
+	}
+
 	// ensure that the appliedWork and the finalizer exist
 	appliedWork, err := r.ensureAppliedWork(ctx, work)
 	if err != nil {
@@ -160,7 +215,7 @@ func (r *ApplyWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	// apply the manifests to the member cluster
-	results := r.applyManifests(ctx, work.Spec.Workload.Manifests, owner)
+	results := r.applyManifests(ctx, work.Spec.Workload.Manifests, owner, work.Spec.ApplyStrategy)
 
 	// collect the latency from the work update time to now.
 	lastUpdateTime, ok := work.GetAnnotations()[utils.LastWorkUpdateTimeAnnotationKey]
@@ -196,8 +251,9 @@ func (r *ApplyWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		klog.ErrorS(err, "failed to generate the diff between work status and appliedWork status", work.Kind, logObjRef)
 		return ctrl.Result{}, err
 	}
-	// delete all the manifests that should not be in the cluster.
-	if err = r.deleteStaleManifest(ctx, staleRes, owner); err != nil {
+	// delete all the manifests that should not be in the cluster unless the deletion policy asks to keep them.
+	retainedRes, retainRequeueAfter, err := r.deleteStaleManifest(ctx, staleRes, owner, work.Spec.DeletionPolicy)
+	if err != nil {
 		klog.ErrorS(err, "resource garbage-collection incomplete; some Work owned resources could not be deleted", work.Kind, logObjRef)
 		// we can't proceed to update the applied
 		return ctrl.Result{}, err
@@ -208,8 +264,9 @@ func (r *ApplyWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	// update the appliedWork with the new work after the stales are deleted
-	appliedWork.Status.AppliedResources = newRes
+	// update the appliedWork with the new work after the stales are deleted, and keep tracking the retained ones
+	// so that they are deleted once their grace period ends
+	appliedWork.Status.AppliedResources = append(newRes, retainedRes...)
 	if err = r.spokeClient.Status().Update(ctx, appliedWork, &client.SubResourceUpdateOptions{}); err != nil {
 		klog.ErrorS(err, "failed to update appliedWork status", appliedWork.Kind, appliedWork.GetName())
 		return ctrl.Result{}, err
@@ -220,34 +277,81 @@ func (r *ApplyWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			"work", logObjRef)
 	}
 
+	if err == nil && meta.IsStatusConditionFalse(work.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable) {
+		klog.V(2).InfoS("work is applied but not available yet; the message is queued again for availability check",
+			"work", logObjRef)
+		return ctrl.Result{RequeueAfter: availabilityRecheckInterval}, nil
+	}
+
+	if err == nil && hasWaitingManifests(results) {
+		klog.V(2).InfoS("some manifests are waiting for the previous apply waves; the message is queued again for availability check",
+			"work", logObjRef)
+		return ctrl.Result{RequeueAfter: availabilityRecheckInterval}, nil
+	}
+
+	if err == nil && len(retainedRes) > 0 && retainRequeueAfter < time.Minute*5 {
+		klog.V(2).InfoS("some stale manifests are retained; the message is queued again for their deletion",
+			"work", logObjRef, "requeueAfter", retainRequeueAfter)
+		return ctrl.Result{RequeueAfter: retainRequeueAfter}, nil
+	}
+
 	// we periodically reconcile the work to make sure the member cluster state is in sync with the work
 	// even if the reconciling succeeds in case the resources on the member cluster is removed/changed.
+	// This is also when we detect the drifts of the resources on the member cluster.
 	return ctrl.Result{RequeueAfter: time.Minute * 5}, err
 }
 
-// garbageCollectAppliedWork deletes the appliedWork and all the manifests associated with it from the cluster.
+// garbageCollectAppliedWork deletes the appliedWork and all the manifests associated with it from the cluster
+// according to the deletion policy of the work.
 func (r *ApplyWorkReconciler) garbageCollectAppliedWork(ctx context.Context, work *fleetv1beta1.Work) (ctrl.Result, error) {
-	deletePolicy := metav1.DeletePropagationBackground
 	if !controllerutil.ContainsFinalizer(work, fleetv1beta1.WorkFinalizer) {
 		return ctrl.Result{}, nil
 	}
-	// delete the appliedWork which will remove all the manifests associated with it
-	// TODO: allow orphaned manifest
+	deletionPolicy := work.Spec.DeletionPolicy
+	retainUntil := work.DeletionTimestamp.Time
+	if deletionPolicy != nil && deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeRetain {
+		retainUntil = retainUntil.Add(time.Duration(pointer.Int32Deref(deletionPolicy.RetainGracePeriodSeconds, 0)) * time.Second)
+	}
+	var err error
+	if time.Now().Before(retainUntil) {
+		// the retained appliedWork is deleted by the member cluster on its own once the grace period ends,
+		// so that the work does not block the deletion on the hub cluster in the meantime
+		klog.V(2).InfoS("retain the manifests until the grace period ends", "work", klog.KObj(work), "retainUntil", retainUntil)
+		err = r.markAppliedWorkRetained(ctx, work.Name, retainUntil)
+	} else {
+		orphan := deletionPolicy != nil && deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeOrphan
+		err = r.deleteAppliedWork(ctx, work.Name, orphan)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	controllerutil.RemoveFinalizer(work, fleetv1beta1.WorkFinalizer)
+	return ctrl.Result{}, r.client.Update(ctx, work, &client.UpdateOptions{})
+}
+
+// deleteAppliedWork deletes the appliedWork which will remove all the manifests associated with it.
+// The manifests are left on the cluster if orphan is true.
+func (r *ApplyWorkReconciler) deleteAppliedWork(ctx context.Context, appliedWorkName string, orphan bool) error {
+	if orphan {
+		if err := r.orphanAppliedResources(ctx, appliedWorkName); err != nil {
+			return err
+		}
+	}
+	deletePolicy := metav1.DeletePropagationBackground
 	appliedWork := fleetv1beta1.AppliedWork{
-		ObjectMeta: metav1.ObjectMeta{Name: work.Name},
+		ObjectMeta: metav1.ObjectMeta{Name: appliedWorkName},
 	}
 	err := r.spokeClient.Delete(ctx, &appliedWork, &client.DeleteOptions{PropagationPolicy: &deletePolicy})
 	switch {
 	case apierrors.IsNotFound(err):
-		klog.V(2).InfoS("the appliedWork is already deleted", "appliedWork", work.Name)
+		klog.V(2).InfoS("the appliedWork is already deleted", "appliedWork", appliedWorkName)
 	case err != nil:
-		klog.ErrorS(err, "failed to delete the appliedWork", "appliedWork", work.Name)
-		return ctrl.Result{}, err
+		klog.ErrorS(err, "failed to delete the appliedWork", "appliedWork", appliedWorkName)
+		return err
 	default:
-		klog.InfoS("successfully deleted the appliedWork", "appliedWork", work.Name)
+		klog.InfoS("successfully deleted the appliedWork", "appliedWork", appliedWorkName, "orphan", orphan)
 	}
-	controllerutil.RemoveFinalizer(work, fleetv1beta1.WorkFinalizer)
-	return ctrl.Result{}, r.client.Update(ctx, work, &client.UpdateOptions{})
+	return nil
 }
 
 // ensureAppliedWork makes sure that an associated appliedWork and a finalizer on the work resource exsits on the cluster.
@@ -265,7 +369,7 @@ func (r *ApplyWorkReconciler) ensureAppliedWork(ctx context.Context, work *fleet
 			klog.ErrorS(err, "failed to retrieve the appliedWork ", "appliedWork", workRef.Name)
 			return nil, err
 		default:
-			return appliedWork, nil
+			return appliedWork, r.stopRetainingAppliedWork(ctx, appliedWork)
 		}
 	}
 
@@ -293,18 +397,30 @@ func (r *ApplyWorkReconciler) ensureAppliedWork(ctx context.Context, work *fleet
 }
 
 // applyManifests processes a given set of Manifests by: setting ownership, validating the manifest, and passing it on for application to the cluster.
-func (r *ApplyWorkReconciler) applyManifests(ctx context.Context, manifests []fleetv1beta1.Manifest, owner metav1.OwnerReference) []applyResult {
-	var appliedObj *unstructured.Unstructured
+// The manifests are applied in waves ordered by their apply wave numbers; the manifests in a wave are not applied until
+// all the manifests in the previous waves are applied and those whose availability is known are available.
+// The manifests in the same wave are applied in parallel by at most applyConcurrency workers.
+// The waves are ignored if the apply strategy only reports the differences as nothing is applied.
+func (r *ApplyWorkReconciler) applyManifests(ctx context.Context, manifests []fleetv1beta1.Manifest, owner metav1.OwnerReference,
+	applyStrategy *fleetv1beta1.ApplyStrategy) []applyResult {
+	type decodedManifest struct {
+		index  int
+		wave   int
+		gvr    schema.GroupVersionResource
+		rawObj *unstructured.Unstructured
+	}
+	reportDiff := applyStrategy != nil && applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff
 
 	results := make([]applyResult, len(manifests))
+	decodedManifests := make([]decodedManifest, 0, len(manifests))
 	for index, manifest := range manifests {
-		var result applyResult
 		gvr, rawObj, err := r.decodeManifest(manifest)
-		switch {
-		case err != nil:
-			result.err = err
-			result.identifier = fleetv1beta1.WorkResourceIdentifier{
-				Ordinal: index,
+		if err != nil {
+			result := applyResult{
+				err: err,
+				identifier: fleetv1beta1.WorkResourceIdentifier{
+					Ordinal: index,
+				},
 			}
 			if rawObj != nil {
 				result.identifier.Group = rawObj.GroupVersionKind().Group
@@ -313,28 +429,147 @@ func (r *ApplyWorkReconciler) applyManifests(ctx context.Context, manifests []fl
 				result.identifier.Namespace = rawObj.GetNamespace()
 				result.identifier.Name = rawObj.GetName()
 			}
+			results[index] = result
+			continue
+		}
+		wave, err := applyWaveOf(rawObj)
+		if err != nil {
+			results[index] = applyResult{
+				err:        err,
+				identifier: buildResourceIdentifier(index, rawObj, gvr),
+			}
+			continue
+		}
+		decodedManifests = append(decodedManifests, decodedManifest{index: index, wave: wave, gvr: gvr, rawObj: rawObj})
+	}
+	// keep the order of the manifests in the same wave
+	sort.SliceStable(decodedManifests, func(i, j int) bool {
+		return decodedManifests[i].wave < decodedManifests[j].wave
+	})
+
+	workers := r.applyConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+	p := parallelizer.NewParallelizer(workers)
+	if reportDiff {
+		p.ParallelizeUntil(ctx, len(decodedManifests), func(piece int) {
+			m := decodedManifests[piece]
+			results[m.index] = r.diffManifest(ctx, m.index, m.gvr, m.rawObj)
+		}, "diffManifests")
+		return results
+	}
 
-		default:
-			addOwnerRef(owner, rawObj)
-			appliedObj, result.action, result.err = r.applyUnstructured(ctx, gvr, rawObj)
-			result.identifier = buildResourceIdentifier(index, rawObj, gvr)
-			logObjRef := klog.ObjectRef{
-				Name:      result.identifier.Name,
-				Namespace: result.identifier.Namespace,
+	previousWavesReady := true
+	for start := 0; start < len(decodedManifests); {
+		end := start + 1
+		for end < len(decodedManifests) && decodedManifests[end].wave == decodedManifests[start].wave {
+			end++
+		}
+		wave := decodedManifests[start:end]
+		start = end
+		if !previousWavesReady {
+			for _, m := range wave {
+				result := applyResult{
+					identifier: buildResourceIdentifier(m.index, m.rawObj, m.gvr),
+					action:     ManifestApplyWaitingAction,
+				}
+				klog.V(2).InfoS("manifest is waiting for the previous apply waves", "gvr", m.gvr, "wave", m.wave,
+					"manifest", klog.ObjectRef{Name: result.identifier.Name, Namespace: result.identifier.Namespace})
+				results[m.index] = result
 			}
-			if result.err == nil {
-				result.generation = appliedObj.GetGeneration()
-				klog.V(2).InfoS("apply manifest succeeded", "gvr", gvr, "manifest", logObjRef,
-					"apply action", result.action, "new ObservedGeneration", result.generation)
-			} else {
-				klog.ErrorS(result.err, "manifest upsert failed", "gvr", gvr, "manifest", logObjRef)
+			continue
+		}
+		// the manifests in the same wave do not depend on each other, so they are applied in parallel;
+		// each result lands in the slot of its manifest
+		p.ParallelizeUntil(ctx, len(wave), func(piece int) {
+			m := wave[piece]
+			results[m.index] = r.applyManifest(ctx, m.index, m.gvr, m.rawObj, owner, applyStrategy)
+		}, "applyManifests")
+		for _, m := range wave {
+			if blocksNextApplyWave(results[m.index]) {
+				previousWavesReady = false
 			}
 		}
-		results[index] = result
 	}
 	return results
 }
 
+// diffManifest reports the differences between a decoded manifest and the resource on the cluster without applying it.
+func (r *ApplyWorkReconciler) diffManifest(ctx context.Context, index int, gvr schema.GroupVersionResource, rawObj *unstructured.Unstructured) applyResult {
+	var result applyResult
+	result.identifier = buildResourceIdentifier(index, rawObj, gvr)
+	logObjRef := klog.ObjectRef{
+		Name:      result.identifier.Name,
+		Namespace: result.identifier.Namespace,
+	}
+	var appliedObj *unstructured.Unstructured
+	var diffs []fleetv1beta1.PatchDetail
+	appliedObj, diffs, result.err = r.diffUnstructured(ctx, gvr, rawObj)
+	switch {
+	case result.err != nil:
+		klog.ErrorS(result.err, "manifest diff failed", "gvr", gvr, "manifest", logObjRef)
+	case len(diffs) > 0:
+		result.action = ManifestDiffReportedAction
+		result.diffs = diffs
+		if appliedObj != nil {
+			result.generation = appliedObj.GetGeneration()
+			result.diffObservedGeneration = pointer.Int64(result.generation)
+		}
+		klog.V(2).InfoS("manifest differs from the resource on the cluster", "gvr", gvr, "manifest", logObjRef, "diffs", len(diffs))
+	default:
+		// the resource on the cluster is the same as the manifest
+		result.action = ManifestNoChangeAction
+		result.generation = appliedObj.GetGeneration()
+		result.availability, result.availabilityErr = trackResourceAvailability(appliedObj)
+		if result.availabilityErr != nil {
+			klog.ErrorS(result.availabilityErr, "failed to track the manifest availability", "gvr", gvr, "manifest", logObjRef)
+		}
+	}
+	return result
+}
+
+// applyManifest applies a decoded manifest to the cluster according to the apply strategy.
+func (r *ApplyWorkReconciler) applyManifest(ctx context.Context, index int, gvr schema.GroupVersionResource, rawObj *unstructured.Unstructured,
+	owner metav1.OwnerReference, applyStrategy *fleetv1beta1.ApplyStrategy) applyResult {
+	var result applyResult
+	addOwnerRef(owner, rawObj)
+	var appliedObj *unstructured.Unstructured
+	appliedObj, result.action, result.err = r.applyUnstructured(ctx, gvr, rawObj, applyStrategy)
+	result.identifier = buildResourceIdentifier(index, rawObj, gvr)
+	logObjRef := klog.ObjectRef{
+		Name:      result.identifier.Name,
+		Namespace: result.identifier.Namespace,
+	}
+	switch {
+	case result.err == nil && result.action == ManifestDriftedAction:
+		result.generation = appliedObj.GetGeneration()
+		// report the drifts which are left as they are
+		result.drifts, result.err = r.detectDriftsOnCluster(ctx, gvr, rawObj, appliedObj)
+		klog.V(2).InfoS("manifest has drifted", "gvr", gvr, "manifest", logObjRef, "drifts", len(result.drifts))
+	case result.err == nil && result.action == ManifestNotTakenOverAction:
+		result.generation = appliedObj.GetGeneration()
+		result.diffObservedGeneration = pointer.Int64(result.generation)
+		// report the diffs which prevent the resource from being taken over
+		result.diffs, result.err = r.detectDriftsOnCluster(ctx, gvr, rawObj, appliedObj)
+		klog.V(2).InfoS("manifest is not taken over", "gvr", gvr, "manifest", logObjRef, "diffs", len(result.diffs))
+	case result.err == nil:
+		result.generation = appliedObj.GetGeneration()
+		klog.V(2).InfoS("apply manifest succeeded", "gvr", gvr, "manifest", logObjRef,
+			"apply action", result.action, "new ObservedGeneration", result.generation)
+		result.availability, result.availabilityErr = trackResourceAvailability(appliedObj)
+		if result.availabilityErr != nil {
+			klog.ErrorS(result.availabilityErr, "failed to track the manifest availability", "gvr", gvr, "manifest", logObjRef)
+		}
+		if applyStrategy != nil && applyStrategy.ReportBackStatus {
+			result.observedStatus = buildObservedStatus(appliedObj)
+		}
+	default:
+		klog.ErrorS(result.err, "manifest upsert failed", "gvr", gvr, "manifest", logObjRef)
+	}
+	return result
+}
+
 // Decodes the manifest into usable structs.
 func (r *ApplyWorkReconciler) decodeManifest(manifest fleetv1beta1.Manifest) (schema.GroupVersionResource, *unstructured.Unstructured, error) {
 	unstructuredObj := &unstructured.Unstructured{}
@@ -354,8 +589,11 @@ func (r *ApplyWorkReconciler) decodeManifest(manifest fleetv1beta1.Manifest) (sc
 // applyUnstructured determines if an unstructured manifest object can & should be applied. It first validates
 // the size of the last modified annotation of the manifest, it removes the annotation if the size crosses the annotation size threshold
 // and then creates/updates the resource on the cluster using server side apply instead of three-way merge patch.
+// The resource is always updated using server side apply if the apply strategy asks for it.
+// If the manifest has not changed but the resource on the cluster has drifted from it, the drifts are either overwritten
+// or left as they are according to the apply strategy.
 func (r *ApplyWorkReconciler) applyUnstructured(ctx context.Context, gvr schema.GroupVersionResource,
-	manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, applyAction, error) {
+	manifestObj *unstructured.Unstructured, applyStrategy *fleetv1beta1.ApplyStrategy) (*unstructured.Unstructured, applyAction, error) {
 	manifestRef := klog.ObjectRef{
 		Name:      manifestObj.GetName(),
 		Namespace: manifestObj.GetNamespace(),
@@ -396,44 +634,142 @@ func (r *ApplyWorkReconciler) applyUnstructured(ctx context.Context, gvr schema.
 		return nil, ManifestNoChangeAction, err
 	}
 
-	// check if the existing manifest is managed by the work
+	// check if the existing manifest is managed by the work and take it over if allowed by the apply strategy
+	takeOver := false
 	if !isManifestManagedByWork(curObj.GetOwnerReferences()) {
-		err = fmt.Errorf("resource is not managed by the work controller")
-		klog.ErrorS(err, "skip applying a not managed manifest", "gvr", gvr, "obj", manifestRef)
-		return nil, ManifestNoChangeAction, err
+		whenToTakeOver := fleetv1beta1.WhenToTakeOverTypeNever
+		if applyStrategy != nil && applyStrategy.WhenToTakeOver != "" {
+			whenToTakeOver = applyStrategy.WhenToTakeOver
+		}
+		switch whenToTakeOver {
+		case fleetv1beta1.WhenToTakeOverTypeAlways:
+			klog.V(2).InfoS("take over a not managed manifest", "gvr", gvr, "manifest", manifestRef)
+		case fleetv1beta1.WhenToTakeOverTypeIfNoDiff:
+			diffs, err := r.detectDriftsOnCluster(ctx, gvr, manifestObj, curObj)
+			if err != nil {
+				klog.ErrorS(err, "failed to compare the manifest with the not managed resource", "gvr", gvr, "manifest", manifestRef)
+				return nil, ManifestNoChangeAction, err
+			}
+			if len(diffs) > 0 {
+				klog.V(2).InfoS("skip taking over a not managed manifest with diffs", "gvr", gvr, "manifest", manifestRef, "diffs", len(diffs))
+				return curObj, ManifestNotTakenOverAction, nil
+			}
+			klog.V(2).InfoS("take over a not managed manifest without diffs", "gvr", gvr, "manifest", manifestRef)
+		default:
+			err = fmt.Errorf("resource is not managed by the work controller")
+			klog.ErrorS(err, "skip applying a not managed manifest", "gvr", gvr, "obj", manifestRef)
+			return nil, ManifestNoChangeAction, err
+		}
+		takeOver = true
 	}
 
-	// We only try to update the object if its spec hash value has changed.
-	if manifestObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation] != curObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation] {
-		// we need to merge the owner reference between the current and the manifest since we support one manifest
-		// belong to multiple work, so it contains the union of all the appliedWork.
-		manifestObj.SetOwnerReferences(mergeOwnerReference(curObj.GetOwnerReferences(), manifestObj.GetOwnerReferences()))
-		// record the raw manifest with the hash annotation in the manifest.
-		isModifiedConfigAnnotationNotEmpty, err := setModifiedConfigurationAnnotation(manifestObj)
+	// We only try to update the object if it is being taken over, its spec hash value has changed or it has drifted
+	// from the manifest.
+	needUpdate := takeOver || manifestObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation] != curObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation]
+	if !needUpdate {
+		drifts, err := r.detectDriftsOnCluster(ctx, gvr, manifestObj, curObj)
 		if err != nil {
+			klog.ErrorS(err, "failed to detect the drifts of the manifest", "gvr", gvr, "manifest", manifestRef)
 			return nil, ManifestNoChangeAction, err
 		}
-		if !isModifiedConfigAnnotationNotEmpty {
-			klog.V(2).InfoS("using server side apply for manifest", "gvr", gvr, "manifest", manifestRef)
-			return r.applyObject(ctx, gvr, manifestObj)
+		if len(drifts) > 0 {
+			if applyStrategy != nil && applyStrategy.WhenToApply == fleetv1beta1.WhenToApplyTypeIfNotDrifted {
+				klog.V(2).InfoS("leave the drifts of the manifest as they are", "gvr", gvr, "manifest", manifestRef, "drifts", len(drifts))
+				return curObj, ManifestDriftedAction, nil
+			}
+			klog.V(2).InfoS("overwrite the drifts of the manifest", "gvr", gvr, "manifest", manifestRef, "drifts", len(drifts))
+			needUpdate = true
 		}
-		klog.V(2).InfoS("using three way merge for manifest", "gvr", gvr, "manifest", manifestRef)
-		return r.patchCurrentResource(ctx, gvr, manifestObj, curObj)
+	}
+	if needUpdate {
+		return r.updateObject(ctx, gvr, manifestObj, curObj, applyStrategy, false)
 	}
 
 	return curObj, ManifestNoChangeAction, nil
 }
 
+// updateObject updates the resource on the cluster with the manifest using server side apply or three-way merge patch
+// according to the apply strategy and the size of the manifest. Nothing is persisted on the cluster if dryRun is true.
+func (r *ApplyWorkReconciler) updateObject(ctx context.Context, gvr schema.GroupVersionResource, manifestObj, curObj *unstructured.Unstructured,
+	applyStrategy *fleetv1beta1.ApplyStrategy, dryRun bool) (*unstructured.Unstructured, applyAction, error) {
+	manifestRef := klog.ObjectRef{
+		Name:      manifestObj.GetName(),
+		Namespace: manifestObj.GetNamespace(),
+	}
+	// we need to merge the owner reference between the current and the manifest since we support one manifest
+	// belong to multiple work, so it contains the union of all the appliedWork.
+	manifestObj.SetOwnerReferences(mergeOwnerReference(curObj.GetOwnerReferences(), manifestObj.GetOwnerReferences()))
+	// record the raw manifest with the hash annotation in the manifest.
+	isModifiedConfigAnnotationNotEmpty, err := setModifiedConfigurationAnnotation(manifestObj)
+	if err != nil {
+		return nil, ManifestNoChangeAction, err
+	}
+	if applyStrategy != nil && applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeServerSideApply {
+		force := applyStrategy.ServerSideApplyConfig != nil && applyStrategy.ServerSideApplyConfig.ForceConflicts
+		klog.V(2).InfoS("using server side apply for manifest per the apply strategy", "gvr", gvr, "manifest", manifestRef, "force", force)
+		return r.applyObject(ctx, gvr, manifestObj, force, dryRun)
+	}
+	if !isModifiedConfigAnnotationNotEmpty {
+		// the manifest is too large for the last applied configuration annotation, so that the three way merge
+		// cannot be used; the conflicts are forced only if the apply strategy asks for it.
+		force := applyStrategy == nil ||
+			(applyStrategy.ServerSideApplyConfig != nil && applyStrategy.ServerSideApplyConfig.ForceConflicts)
+		klog.V(2).InfoS("using server side apply for manifest", "gvr", gvr, "manifest", manifestRef, "force", force)
+		appliedObj, action, err := r.applyObject(ctx, gvr, manifestObj, force, dryRun)
+		if err != nil {
+			return nil, action, fmt.Errorf("the manifest is too large for the last applied configuration annotation and failed to be applied with server side apply instead: %w", err)
+		}
+		return appliedObj, action, nil
+	}
+	klog.V(2).InfoS("using three way merge for manifest", "gvr", gvr, "manifest", manifestRef)
+	return r.patchCurrentResource(ctx, gvr, manifestObj, curObj, dryRun)
+}
+
+// diffUnstructured compares the manifest with the resource on the cluster without changing the resource.
+// It returns the resource on the cluster, which is nil if it does not exist, and the differences found.
+// The resource is compared even if it is not managed by the work controller as nothing is changed on the cluster.
+func (r *ApplyWorkReconciler) diffUnstructured(ctx context.Context, gvr schema.GroupVersionResource,
+	manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, []fleetv1beta1.PatchDetail, error) {
+	manifestRef := klog.ObjectRef{
+		Name:      manifestObj.GetName(),
+		Namespace: manifestObj.GetNamespace(),
+	}
+	// the whole resource is reported as a difference if it does not exist on the cluster
+	notFoundDiffs := []fleetv1beta1.PatchDetail{{Path: "/", ValueInHub: "(the whole object)"}}
+	// a resource with generated name can never be matched with the resource on the cluster
+	if manifestObj.GetName() == "" && manifestObj.GetGenerateName() != "" {
+		klog.V(2).InfoS("cannot find the resource with generated name on the cluster", "gvr", gvr, "manifest", manifestRef)
+		return nil, notFoundDiffs, nil
+	}
+	curObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestObj.GetNamespace()).Get(ctx, manifestObj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		klog.V(2).InfoS("the manifest does not exist on the cluster", "gvr", gvr, "manifest", manifestRef)
+		return nil, notFoundDiffs, nil
+	case err != nil:
+		return nil, nil, err
+	}
+	diffs, err := r.detectDriftsOnCluster(ctx, gvr, manifestObj, curObj)
+	if err != nil {
+		klog.ErrorS(err, "failed to compare the manifest with the resource on the cluster", "gvr", gvr, "manifest", manifestRef)
+		return nil, nil, err
+	}
+	return curObj, diffs, nil
+}
+
 // applyObject uses server side apply to apply the manifest.
+// It forces the work controller to take the ownership of the conflicting fields if force is true, and persists nothing
+// on the cluster if dryRun is true.
 func (r *ApplyWorkReconciler) applyObject(ctx context.Context, gvr schema.GroupVersionResource,
-	manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, applyAction, error) {
+	manifestObj *unstructured.Unstructured, force, dryRun bool) (*unstructured.Unstructured, applyAction, error) {
 	manifestRef := klog.ObjectRef{
 		Name:      manifestObj.GetName(),
 		Namespace: manifestObj.GetNamespace(),
 	}
 	options := metav1.ApplyOptions{
 		FieldManager: workFieldManagerName,
-		Force:        true,
+		Force:        force,
+		DryRun:       dryRunOption(dryRun),
 	}
 	manifestObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestObj.GetNamespace()).Apply(ctx, manifestObj.GetName(), manifestObj, options)
 	if err != nil {
@@ -445,8 +781,9 @@ func (r *ApplyWorkReconciler) applyObject(ctx context.Context, gvr schema.GroupV
 }
 
 // patchCurrentResource uses three-way merge to patch the current resource with the new manifest we get from the work.
+// It persists nothing on the cluster if dryRun is true.
 func (r *ApplyWorkReconciler) patchCurrentResource(ctx context.Context, gvr schema.GroupVersionResource,
-	manifestObj, curObj *unstructured.Unstructured) (*unstructured.Unstructured, applyAction, error) {
+	manifestObj, curObj *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, applyAction, error) {
 	manifestRef := klog.ObjectRef{
 		Name:      manifestObj.GetName(),
 		Namespace: manifestObj.GetNamespace(),
@@ -467,7 +804,7 @@ func (r *ApplyWorkReconciler) patchCurrentResource(ctx context.Context, gvr sche
 	}
 	// Use client side apply the patch to the member cluster
 	manifestObj, patchErr := r.spokeDynamicClient.Resource(gvr).Namespace(manifestObj.GetNamespace()).
-		Patch(ctx, manifestObj.GetName(), patch.Type(), data, metav1.PatchOptions{FieldManager: workFieldManagerName})
+		Patch(ctx, manifestObj.GetName(), patch.Type(), data, metav1.PatchOptions{FieldManager: workFieldManagerName, DryRun: dryRunOption(dryRun)})
 	if patchErr != nil {
 		klog.ErrorS(patchErr, "failed to patch the manifest", "gvr", gvr, "manifest", manifestRef)
 		return nil, ManifestNoChangeAction, patchErr
@@ -486,21 +823,50 @@ func (r *ApplyWorkReconciler) generateWorkCondition(results []applyResult, work
 			errs = append(errs, result.err)
 		}
 		appliedCondition := buildManifestAppliedCondition(result.err, result.action, result.generation)
+		availableCondition := buildManifestAvailableCondition(result, result.generation)
 		manifestCondition := fleetv1beta1.ManifestCondition{
 			Identifier: result.identifier,
-			Conditions: []metav1.Condition{appliedCondition},
+			Conditions: []metav1.Condition{appliedCondition, availableCondition},
 		}
 		foundmanifestCondition := findManifestConditionByIdentifier(result.identifier, work.Status.ManifestConditions)
 		if foundmanifestCondition != nil {
 			manifestCondition.Conditions = foundmanifestCondition.Conditions
 			meta.SetStatusCondition(&manifestCondition.Conditions, appliedCondition)
+			meta.SetStatusCondition(&manifestCondition.Conditions, availableCondition)
+		}
+		if len(result.drifts) > 0 {
+			now := metav1.Now()
+			manifestCondition.DriftDetails = &fleetv1beta1.DriftDetails{
+				ObservationTime:                   now,
+				FirstDriftedObservedTime:          now,
+				ObservedInMemberClusterGeneration: result.generation,
+				ObservedDrifts:                    result.drifts,
+			}
+			// keep the time when the drifts were first observed
+			if foundmanifestCondition != nil && foundmanifestCondition.DriftDetails != nil {
+				manifestCondition.DriftDetails.FirstDriftedObservedTime = foundmanifestCondition.DriftDetails.FirstDriftedObservedTime
+			}
+		}
+		if len(result.diffs) > 0 {
+			manifestCondition.DiffDetails = &fleetv1beta1.DiffDetails{
+				ObservationTime:                   metav1.Now(),
+				ObservedInMemberClusterGeneration: result.diffObservedGeneration,
+				ObservedDiffs:                     result.diffs,
+			}
+		}
+		if result.observedStatus != nil {
+			manifestCondition.BackReportedStatus = &fleetv1beta1.BackReportedStatus{
+				ObservedStatus:  *result.observedStatus,
+				ObservationTime: metav1.Now(),
+			}
 		}
 		manifestConditions[index] = manifestCondition
 	}
 
 	work.Status.ManifestConditions = manifestConditions
 	workCond := generateWorkAppliedCondition(manifestConditions, work.Generation)
-	work.Status.Conditions = []metav1.Condition{workCond}
+	availableCond := generateWorkAvailableCondition(manifestConditions, work.Generation)
+	work.Status.Conditions = []metav1.Condition{workCond, availableCond}
 	return errs
 }
 
@@ -528,10 +894,26 @@ func (r *ApplyWorkReconciler) Leave(ctx context.Context) error {
 		klog.ErrorS(err, "failed to list all the work object", "clusterNS", r.workNameSpace)
 		return client.IgnoreNotFound(err)
 	}
-	// we leave the resources on the member cluster for now
+	// we leave the resources on the member cluster unless the deletion policy asks to delete, orphan or retain them
 	for _, work := range works.Items {
 		staleWork := work.DeepCopy()
 		if controllerutil.ContainsFinalizer(staleWork, fleetv1beta1.WorkFinalizer) {
+			deletionPolicy := staleWork.Spec.DeletionPolicy
+			var err error
+			switch {
+			case deletionPolicy == nil:
+				// keep the resources on the cluster as they are
+			case deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeRetain:
+				gracePeriod := time.Duration(pointer.Int32Deref(deletionPolicy.RetainGracePeriodSeconds, 0)) * time.Second
+				err = r.markAppliedWorkRetained(ctx, staleWork.Name, time.Now().Add(gracePeriod))
+			default:
+				err = r.deleteAppliedWork(ctx, staleWork.Name, deletionPolicy.Type == fleetv1beta1.DeletionPolicyTypeOrphan)
+			}
+			if err != nil {
+				klog.ErrorS(err, "failed to handle the appliedWork of the work according to its deletion policy",
+					"clusterNS", r.workNameSpace, "work", klog.KObj(staleWork), "deletionPolicy", deletionPolicy.Type)
+				return err
+			}
 			controllerutil.RemoveFinalizer(staleWork, fleetv1beta1.WorkFinalizer)
 			if updateErr := r.client.Update(ctx, staleWork, &client.UpdateOptions{}); updateErr != nil {
 				klog.ErrorS(updateErr, "failed to remove the work finalizer from the work",
@@ -545,6 +927,100 @@ func (r *ApplyWorkReconciler) Leave(ctx context.Context) error {
 	return nil
 }
 
+// markAppliedWorkRetained records on the appliedWork the time until which the resources it applied are retained on
+// the cluster, after which the retained appliedWork controller deletes it. An existing record is kept so that the
+// grace period is not extended.
+func (r *ApplyWorkReconciler) markAppliedWorkRetained(ctx context.Context, appliedWorkName string, retainUntil time.Time) error {
+	appliedWork := &fleetv1beta1.AppliedWork{}
+	if err := r.spokeClient.Get(ctx, types.NamespacedName{Name: appliedWorkName}, appliedWork); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("the appliedWork is already deleted", "appliedWork", appliedWorkName)
+			return nil
+		}
+		klog.ErrorS(err, "failed to get the appliedWork", "appliedWork", appliedWorkName)
+		return err
+	}
+	if _, ok := appliedWork.GetAnnotations()[fleetv1beta1.RetainUntilAnnotation]; ok {
+		return nil
+	}
+	annotations := appliedWork.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[fleetv1beta1.RetainUntilAnnotation] = retainUntil.UTC().Format(time.RFC3339)
+	appliedWork.SetAnnotations(annotations)
+	if err := r.spokeClient.Update(ctx, appliedWork); err != nil {
+		klog.ErrorS(err, "failed to mark the appliedWork retained", "appliedWork", appliedWorkName)
+		return err
+	}
+	klog.InfoS("retain the resources of the appliedWork until the grace period ends", "appliedWork", appliedWorkName, "retainUntil", retainUntil)
+	return nil
+}
+
+// stopRetainingAppliedWork removes the retain until record from the appliedWork of a work placed on the cluster again,
+// e.g., after the cluster joins the fleet again, so that the resources it applied are managed by the work again.
+func (r *ApplyWorkReconciler) stopRetainingAppliedWork(ctx context.Context, appliedWork *fleetv1beta1.AppliedWork) error {
+	if _, ok := appliedWork.GetAnnotations()[fleetv1beta1.RetainUntilAnnotation]; !ok {
+		return nil
+	}
+	delete(appliedWork.Annotations, fleetv1beta1.RetainUntilAnnotation)
+	if err := r.spokeClient.Update(ctx, appliedWork); err != nil {
+		klog.ErrorS(err, "failed to remove the retain until annotation from the appliedWork", "appliedWork", klog.KObj(appliedWork))
+		return err
+	}
+	klog.InfoS("stop retaining the resources of the appliedWork", "appliedWork", klog.KObj(appliedWork))
+	return nil
+}
+
+// ReconcileRetainedAppliedWork deletes a retained appliedWork, together with the resources it applied, once its retain
+// grace period ends, unless its work is placed on the cluster again in the meantime.
+func (r *ApplyWorkReconciler) ReconcileRetainedAppliedWork(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	appliedWork := &fleetv1beta1.AppliedWork{}
+	if err := r.spokeClient.Get(ctx, req.NamespacedName, appliedWork); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "failed to get the retained appliedWork", "appliedWork", req.Name)
+		return ctrl.Result{}, err
+	}
+	retainUntil, ok := retainUntilOf(appliedWork)
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	if remaining := time.Until(retainUntil); remaining > 0 {
+		klog.V(2).InfoS("retain the resources of the appliedWork until the grace period ends", "appliedWork", req.Name, "remaining", remaining)
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+	if r.joined.Load() {
+		work := &fleetv1beta1.Work{}
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: r.workNameSpace, Name: appliedWork.Spec.WorkName}, work)
+		switch {
+		case err == nil && work.DeletionTimestamp.IsZero():
+			// the work reconciler takes the appliedWork over again
+			klog.V(2).InfoS("the work of the retained appliedWork is placed on the cluster again", "appliedWork", req.Name)
+			return ctrl.Result{}, nil
+		case err != nil && !apierrors.IsNotFound(err):
+			klog.ErrorS(err, "failed to get the work of the retained appliedWork", "appliedWork", req.Name)
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, r.deleteAppliedWork(ctx, appliedWork.Name, false)
+}
+
+// retainUntilOf returns the time until which the resources applied by the appliedWork are retained, if any.
+func retainUntilOf(appliedWork *fleetv1beta1.AppliedWork) (time.Time, bool) {
+	value, ok := appliedWork.GetAnnotations()[fleetv1beta1.RetainUntilAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	retainUntil, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.ErrorS(err, "failed to parse the retain until annotation", "appliedWork", klog.KObj(appliedWork), "value", value)
+		return time.Time{}, false
+	}
+	return retainUntil, true
+}
+
 // SetupWithManager wires up the controller.
 func (r *ApplyWorkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -555,6 +1031,19 @@ func (r *ApplyWorkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
+// SetupRetainedAppliedWorkControllerWithManager wires up the controller which deletes the retained appliedWorks with
+// the manager of the member cluster.
+func (r *ApplyWorkReconciler) SetupRetainedAppliedWorkControllerWithManager(mgr ctrl.Manager) error {
+	isRetained := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[fleetv1beta1.RetainUntilAnnotation]
+		return ok
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("retained-applied-work").
+		For(&fleetv1beta1.AppliedWork{}, builder.WithPredicates(isRetained)).
+		Complete(reconcile.Func(r.ReconcileRetainedAppliedWork))
+}
+
 // Generates a hash of the spec annotation from an unstructured object after we remove all the fields
 // we have modified.
 func computeManifestHash(obj *unstructured.Unstructured) (string, error) {
@@ -653,6 +1142,26 @@ func buildResourceIdentifier(index int, object *unstructured.Unstructured, gvr s
 	}
 }
 
+// buildObservedStatus returns the status of the applied object to be reported back to the hub cluster.
+// It returns nil if the object has no status or its status is too large to be reported back.
+func buildObservedStatus(appliedObj *unstructured.Unstructured) *runtime.RawExtension {
+	status, found, err := unstructured.NestedFieldNoCopy(appliedObj.Object, "status")
+	if err != nil || !found || status == nil {
+		return nil
+	}
+	raw, err := json.Marshal(status)
+	if err != nil {
+		klog.ErrorS(err, "failed to marshal the status of the applied object", "object", klog.KObj(appliedObj))
+		return nil
+	}
+	if len(raw) > maxBackReportedStatusSize {
+		klog.V(2).InfoS("the status of the applied object is too large to be reported back", "object", klog.KObj(appliedObj),
+			"size", len(raw), "maxSize", maxBackReportedStatusSize)
+		return nil
+	}
+	return &runtime.RawExtension{Raw: raw}
+}
+
 func buildManifestAppliedCondition(err error, action applyAction, observedGeneration int64) metav1.Condition {
 	if err != nil {
 		return metav1.Condition{
@@ -665,6 +1174,46 @@ func buildManifestAppliedCondition(err error, action applyAction, observedGenera
 		}
 	}
 
+	switch action {
+	case ManifestDriftedAction:
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(action),
+			Message:            "Manifest has drifted on the cluster and the drifts are not overwritten per the apply strategy",
+		}
+	case ManifestDiffReportedAction:
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(action),
+			Message:            "Manifest differs from the resource on the cluster and the differences are only reported per the apply strategy",
+		}
+	case ManifestNotTakenOverAction:
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(action),
+			Message:            "Resource not managed by the work controller differs from the manifest and is not taken over per the apply strategy",
+		}
+	case ManifestApplyWaitingAction:
+		// the manifest is not failed but still in progress, which the hub cluster treats as pending
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(action),
+			Message:            "Manifest is waiting for the manifests in the previous apply waves to be applied and available",
+		}
+	}
+
 	return metav1.Condition{
 		Type:               fleetv1beta1.WorkConditionTypeApplied,
 		Status:             metav1.ConditionTrue,
@@ -676,8 +1225,10 @@ func buildManifestAppliedCondition(err error, action applyAction, observedGenera
 }
 
 // generateWorkAppliedCondition generate applied status condition for work.
-// If one of the manifests is applied failed on the spoke, the applied status condition of the work is false.
+// If one of the manifests is applied failed on the spoke, the applied status condition of the work is false;
+// otherwise if one of the manifests is still waiting to be applied, the applied status condition of the work is unknown.
 func generateWorkAppliedCondition(manifestConditions []fleetv1beta1.ManifestCondition, observedGeneration int64) metav1.Condition {
+	pending := false
 	for _, manifestCond := range manifestConditions {
 		if meta.IsStatusConditionFalse(manifestCond.Conditions, fleetv1beta1.WorkConditionTypeApplied) {
 			return metav1.Condition{
@@ -689,6 +1240,19 @@ func generateWorkAppliedCondition(manifestConditions []fleetv1beta1.ManifestCond
 				ObservedGeneration: observedGeneration,
 			}
 		}
+		if meta.IsStatusConditionPresentAndEqual(manifestCond.Conditions, fleetv1beta1.WorkConditionTypeApplied, metav1.ConditionUnknown) {
+			pending = true
+		}
+	}
+	if pending {
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionUnknown,
+			LastTransitionTime: metav1.Now(),
+			Reason:             AppliedWorkPendingReason,
+			Message:            "Some of the manifests are waiting for the previous apply waves",
+			ObservedGeneration: observedGeneration,
+		}
 	}
 
 	return metav1.Condition{
@@ -700,3 +1264,68 @@ func generateWorkAppliedCondition(manifestConditions []fleetv1beta1.ManifestCond
 		ObservedGeneration: observedGeneration,
 	}
 }
+
+// buildManifestAvailableCondition builds the available status condition of a manifest based on its apply result.
+func buildManifestAvailableCondition(result applyResult, observedGeneration int64) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeAvailable,
+		ObservedGeneration: observedGeneration,
+		LastTransitionTime: metav1.Now(),
+	}
+	switch {
+	case result.err != nil || result.action == ManifestDriftedAction || result.action == ManifestDiffReportedAction ||
+		result.action == ManifestNotTakenOverAction || result.action == ManifestApplyWaitingAction:
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = fleetv1beta1.ManifestNotAppliedReason
+		cond.Message = "Manifest is not applied yet"
+	case result.availabilityErr != nil:
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = fleetv1beta1.ManifestAvailabilityUnknownReason
+		cond.Message = fmt.Sprintf("Failed to track the manifest availability: %v", result.availabilityErr)
+	case result.availability == manifestAvailable:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = fleetv1beta1.ManifestAvailableReason
+		cond.Message = "Manifest is available"
+	case result.availability == manifestNotTrackable:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = fleetv1beta1.ManifestNotTrackableReason
+		cond.Message = "Manifest is not trackable and is considered available once applied"
+	default:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = fleetv1beta1.ManifestNotAvailableYetReason
+		cond.Message = "Manifest is not available yet"
+	}
+	return cond
+}
+
+// generateWorkAvailableCondition generate available status condition for work.
+// If the availability of one of the manifests is unknown, the available status condition of the work is unknown;
+// otherwise if one of the manifests is not available yet, the available status condition of the work is false.
+func generateWorkAvailableCondition(manifestConditions []fleetv1beta1.ManifestCondition, observedGeneration int64) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeAvailable,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             fleetv1beta1.WorkAvailableReason,
+		Message:            "All the manifests are available",
+		ObservedGeneration: observedGeneration,
+	}
+	for _, manifestCond := range manifestConditions {
+		availableCond := meta.FindStatusCondition(manifestCond.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+		switch {
+		case availableCond == nil || availableCond.Status == metav1.ConditionUnknown:
+			cond.Status = metav1.ConditionUnknown
+			cond.Reason = fleetv1beta1.WorkAvailabilityUnknownReason
+			cond.Message = "The availability of some of the manifests is unknown"
+			return cond
+		case availableCond.Status == metav1.ConditionFalse:
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = fleetv1beta1.WorkNotAvailableYetReason
+			cond.Message = "Some of the manifests are not available yet"
+		case availableCond.Reason == fleetv1beta1.ManifestNotTrackableReason && cond.Status == metav1.ConditionTrue:
+			cond.Reason = fleetv1beta1.WorkNotTrackableReason
+			cond.Message = "All the manifests are applied but the availability of some of them cannot be tracked"
+		}
+	}
+	return cond
+}
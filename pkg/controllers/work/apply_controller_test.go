@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -355,10 +356,71 @@ func TestApplyUnstructured(t *testing.T) {
 		},
 	}
 	rawTestDeploymentWithDifferentOwner, _ := json.Marshal(testDeploymentWithDifferentOwner)
-	_, diffOwnerDynamicClient, _, err := createObjAndDynamicClient(rawTestDeploymentWithDifferentOwner)
+	diffOwnerObj, diffOwnerDynamicClient, diffOwnerSpecHash, err := createObjAndDynamicClient(rawTestDeploymentWithDifferentOwner)
 	if err != nil {
 		t.Errorf("failed to create obj and dynamic client: %s", err)
 	}
+	// the object on the cluster is not managed by the work but has the same spec as the manifest
+	sameSpecNotManagedObj := correctObj.DeepCopy()
+	sameSpecNotManagedObj.SetOwnerReferences(testDeploymentWithDifferentOwner.OwnerReferences)
+	sameSpecNotManagedDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	sameSpecNotManagedDynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, sameSpecNotManagedObj.DeepCopy(), nil
+	})
+	sameSpecNotManagedDynamicClient.PrependReactor("patch", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, correctObj.DeepCopy(), nil
+	})
+	diffSpecNotManagedDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	diffSpecNotManagedDynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, diffOwnerObj.DeepCopy(), nil
+	})
+	diffSpecNotManagedDynamicClient.PrependReactor("patch", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, correctObj.DeepCopy(), nil
+	})
+
+	// the object on the cluster is not managed by the work and differs from the manifest only in the values normalized
+	// or injected on the cluster, which are the same as the result of applying the manifest in dry-run mode
+	normalizedManifestObj := correctObj.DeepCopy()
+	normalizedManifestObj.SetAnnotations(nil)
+	if err := unstructured.SetNestedSlice(normalizedManifestObj.Object, []interface{}{
+		map[string]interface{}{
+			"name":      "nginx",
+			"image":     "nginx",
+			"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "1000m"}},
+		},
+	}, "spec", "template", "spec", "containers"); err != nil {
+		t.Fatalf("failed to set the containers: %s", err)
+	}
+	normalizedSpecHash, err := computeManifestHash(normalizedManifestObj)
+	if err != nil {
+		t.Fatalf("failed to compute manifest hash: %s", err)
+	}
+	takenOverNormalizedObj := normalizedManifestObj.DeepCopy()
+	takenOverNormalizedObj.SetAnnotations(map[string]string{fleetv1beta1.ManifestHashAnnotation: normalizedSpecHash})
+	normalizedNotManagedObj := normalizedManifestObj.DeepCopy()
+	normalizedNotManagedObj.SetOwnerReferences(testDeploymentWithDifferentOwner.OwnerReferences)
+	if err := unstructured.SetNestedSlice(normalizedNotManagedObj.Object, []interface{}{
+		map[string]interface{}{
+			"name":            "nginx",
+			"image":           "nginx",
+			"imagePullPolicy": "Always",
+			"resources":       map[string]interface{}{"limits": map[string]interface{}{"cpu": "1"}},
+		},
+		map[string]interface{}{"name": "sidecar", "image": "sidecar"},
+	}, "spec", "template", "spec", "containers"); err != nil {
+		t.Fatalf("failed to set the containers: %s", err)
+	}
+	normalizedNotManagedDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	normalizedNotManagedDynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, normalizedNotManagedObj.DeepCopy(), nil
+	})
+	normalizedNotManagedDynamicClient.PrependReactor("patch", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		if action.(testingclient.PatchAction).GetPatchType() == types.ApplyPatchType {
+			// the dry-run of the manifest
+			return true, normalizedNotManagedObj.DeepCopy(), nil
+		}
+		return true, takenOverNormalizedObj.DeepCopy(), nil
+	})
 
 	specHashFailObj := correctObj.DeepCopy()
 	specHashFailObj.Object["test"] = math.Inf(1)
@@ -427,9 +489,23 @@ func TestApplyUnstructured(t *testing.T) {
 		return true, nil, errors.New("apply error")
 	})
 
+	// the object on the cluster has the same spec hash as the manifest but its spec is changed on the cluster
+	driftedObj := correctObj.DeepCopy()
+	if err := unstructured.SetNestedField(driftedObj.Object, int64(10), "spec", "minReadySeconds"); err != nil {
+		t.Errorf("failed to set the drifted field: %s", err)
+	}
+	driftedDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	driftedDynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, driftedObj.DeepCopy(), nil
+	})
+	driftedDynamicClient.PrependReactor("patch", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, correctObj.DeepCopy(), nil
+	})
+
 	testCases := map[string]struct {
 		reconciler     ApplyWorkReconciler
 		workObj        *unstructured.Unstructured
+		applyStrategy  *fleetv1beta1.ApplyStrategy
 		resultSpecHash string
 		resultAction   applyAction
 		resultErr      error
@@ -484,6 +560,60 @@ func TestApplyUnstructured(t *testing.T) {
 			resultAction: ManifestNoChangeAction,
 			resultErr:    errors.New("resource is not managed by the work controller"),
 		},
+		"owner reference comparison failure / never take over": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: sameSpecNotManagedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:       correctObj.DeepCopy(),
+			applyStrategy: &fleetv1beta1.ApplyStrategy{WhenToTakeOver: fleetv1beta1.WhenToTakeOverTypeNever},
+			resultAction:  ManifestNoChangeAction,
+			resultErr:     errors.New("resource is not managed by the work controller"),
+		},
+		"owner reference comparison failure / always take over": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: diffSpecNotManagedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToTakeOver: fleetv1beta1.WhenToTakeOverTypeAlways},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestThreeWayMergePatchAction,
+			resultErr:      nil,
+		},
+		"owner reference comparison failure / take over without diffs": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: sameSpecNotManagedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToTakeOver: fleetv1beta1.WhenToTakeOverTypeIfNoDiff},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestThreeWayMergePatchAction,
+			resultErr:      nil,
+		},
+		"owner reference comparison failure / take over with values normalized on the cluster": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: normalizedNotManagedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        normalizedManifestObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToTakeOver: fleetv1beta1.WhenToTakeOverTypeIfNoDiff},
+			resultSpecHash: normalizedSpecHash,
+			resultAction:   ManifestThreeWayMergePatchAction,
+			resultErr:      nil,
+		},
+		"owner reference comparison failure / not take over with diffs": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: diffSpecNotManagedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToTakeOver: fleetv1beta1.WhenToTakeOverTypeIfNoDiff},
+			resultSpecHash: diffOwnerSpecHash,
+			resultAction:   ManifestNotTakenOverAction,
+			resultErr:      nil,
+		},
 		"equal spec hash of current vs work object / succeed without updates": {
 			reconciler: ApplyWorkReconciler{
 				spokeDynamicClient: correctDynamicClient,
@@ -494,6 +624,63 @@ func TestApplyUnstructured(t *testing.T) {
 			resultAction:   ManifestNoChangeAction,
 			resultErr:      nil,
 		},
+		"equal spec hash of drifted current vs work object / overwrite the drifts by default": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: driftedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestThreeWayMergePatchAction,
+			resultErr:      nil,
+		},
+		"equal spec hash of drifted current vs work object / overwrite the drifts": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: driftedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeAlways},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestThreeWayMergePatchAction,
+			resultErr:      nil,
+		},
+		"equal spec hash of drifted current vs work object / leave the drifts": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: driftedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestDriftedAction,
+			resultErr:      nil,
+		},
+		"equal spec hash of drifted current vs work object / server side apply the manifest": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: driftedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj: correctObj.DeepCopy(),
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:                  fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				ServerSideApplyConfig: &fleetv1beta1.ServerSideApplyConfig{ForceConflicts: true},
+			},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestServerSideAppliedAction,
+			resultErr:      nil,
+		},
+		"equal spec hash of drifted current vs work object / server side apply the manifest without forcing conflicts": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: driftedDynamicClient,
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:        correctObj.DeepCopy(),
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeServerSideApply},
+			resultSpecHash: correctSpecHash,
+			resultAction:   ManifestServerSideAppliedAction,
+			resultErr:      nil,
+		},
 		"unequal spec hash of current vs work object / client patch fail": {
 			reconciler: ApplyWorkReconciler{
 				spokeDynamicClient: patchFailClient,
@@ -556,11 +743,22 @@ func TestApplyUnstructured(t *testing.T) {
 			resultAction: ManifestNoChangeAction,
 			resultErr:    errors.New("apply error"),
 		},
+		"test apply fails for large manifest without forcing conflicts per the apply strategy": {
+			reconciler: ApplyWorkReconciler{
+				spokeDynamicClient: dynamicClientLargeObjApplyFail,
+				restMapper:         testMapper{},
+				recorder:           utils.NewFakeRecorder(1),
+			},
+			workObj:       updatedLargeObj,
+			applyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeClientSideApply},
+			resultAction:  ManifestNoChangeAction,
+			resultErr:     errors.New("the manifest is too large for the last applied configuration annotation"),
+		},
 	}
 
 	for testName, testCase := range testCases {
 		t.Run(testName, func(t *testing.T) {
-			applyResult, applyAction, err := testCase.reconciler.applyUnstructured(context.Background(), testGvr, testCase.workObj)
+			applyResult, applyAction, err := testCase.reconciler.applyUnstructured(context.Background(), testGvr, testCase.workObj, testCase.applyStrategy)
 			assert.Equalf(t, testCase.resultAction, applyAction, "updated boolean not matching for Testcase %s", testName)
 			if testCase.resultErr != nil {
 				assert.Containsf(t, err.Error(), testCase.resultErr.Error(), "error not matching for Testcase %s", testName)
@@ -570,7 +768,9 @@ func TestApplyUnstructured(t *testing.T) {
 				// Not checking last applied config because it has live fields.
 				assert.Equalf(t, testCase.resultSpecHash, applyResult.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation],
 					"specHash not matching for Testcase %s", testName)
-				assert.Equalf(t, ownerRef, applyResult.GetOwnerReferences()[0], "ownerRef not matching for Testcase %s", testName)
+				if testCase.resultAction != ManifestNotTakenOverAction {
+					assert.Equalf(t, ownerRef, applyResult.GetOwnerReferences()[0], "ownerRef not matching for Testcase %s", testName)
+				}
 			}
 		})
 	}
@@ -607,14 +807,41 @@ func TestApplyManifest(t *testing.T) {
 	clientFailDynamicClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
 		return true, nil, errors.New(failMsg)
 	})
+	// the report only clients fail any attempt to change the resource
+	noMutationReactor := func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, errors.New("the resource should not be changed")
+	}
+	notFoundReportOnlyClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	notFoundReportOnlyClient.PrependReactor("create", "*", noMutationReactor)
+	notFoundReportOnlyClient.PrependReactor("patch", "*", noMutationReactor)
+	existingObj, _, _, err := createObjAndDynamicClient(testManifest.Raw)
+	if err != nil {
+		t.Fatalf("failed to create obj and dynamic client: %s", err)
+	}
+	existingObj.SetGeneration(2)
+	foundReportOnlyClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	foundReportOnlyClient.PrependReactor("get", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, existingObj.DeepCopy(), nil
+	})
+	foundReportOnlyClient.PrependReactor("create", "*", noMutationReactor)
+	foundReportOnlyClient.PrependReactor("patch", "*", noMutationReactor)
+	differentDeployment := testDeployment.DeepCopy()
+	differentDeployment.Spec.MinReadySeconds = 10
+	rawDifferentDeployment, _ := json.Marshal(differentDeployment)
+	differentManifest := fleetv1beta1.Manifest{RawExtension: runtime.RawExtension{
+		Raw: rawDifferentDeployment,
+	}}
+	reportDiffStrategy := &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff}
 
 	testCases := map[string]struct {
-		reconciler   ApplyWorkReconciler
-		manifestList []fleetv1beta1.Manifest
-		generation   int64
-		action       applyAction
-		wantGvr      schema.GroupVersionResource
-		wantErr      error
+		reconciler    ApplyWorkReconciler
+		manifestList  []fleetv1beta1.Manifest
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		generation    int64
+		action        applyAction
+		diffs         int
+		wantGvr       schema.GroupVersionResource
+		wantErr       error
 	}{
 		"manifest is in proper format/ happy path": {
 			reconciler: ApplyWorkReconciler{
@@ -679,17 +906,65 @@ func TestApplyManifest(t *testing.T) {
 			wantGvr:      expectedGvr,
 			wantErr:      errors.New(failMsg),
 		},
+		"manifest does not exist / report the whole object as the diff": {
+			reconciler: ApplyWorkReconciler{
+				client:             &test.MockClient{},
+				spokeDynamicClient: notFoundReportOnlyClient,
+				spokeClient:        &test.MockClient{},
+				restMapper:         testMapper{},
+				recorder:           utils.NewFakeRecorder(1),
+				joined:             atomic.NewBool(true),
+			},
+			manifestList:  []fleetv1beta1.Manifest{testManifest},
+			applyStrategy: reportDiffStrategy,
+			generation:    0,
+			action:        ManifestDiffReportedAction,
+			diffs:         1,
+			wantGvr:       expectedGvr,
+		},
+		"manifest is the same as the existing object / report no diff": {
+			reconciler: ApplyWorkReconciler{
+				client:             &test.MockClient{},
+				spokeDynamicClient: foundReportOnlyClient,
+				spokeClient:        &test.MockClient{},
+				restMapper:         testMapper{},
+				recorder:           utils.NewFakeRecorder(1),
+				joined:             atomic.NewBool(true),
+			},
+			manifestList:  []fleetv1beta1.Manifest{testManifest},
+			applyStrategy: reportDiffStrategy,
+			generation:    2,
+			action:        ManifestNoChangeAction,
+			wantGvr:       expectedGvr,
+		},
+		"manifest is different from the existing object / report the diff": {
+			reconciler: ApplyWorkReconciler{
+				client:             &test.MockClient{},
+				spokeDynamicClient: foundReportOnlyClient,
+				spokeClient:        &test.MockClient{},
+				restMapper:         testMapper{},
+				recorder:           utils.NewFakeRecorder(1),
+				joined:             atomic.NewBool(true),
+			},
+			manifestList:  []fleetv1beta1.Manifest{differentManifest},
+			applyStrategy: reportDiffStrategy,
+			generation:    2,
+			action:        ManifestDiffReportedAction,
+			diffs:         1,
+			wantGvr:       expectedGvr,
+		},
 	}
 
 	for testName, testCase := range testCases {
 		t.Run(testName, func(t *testing.T) {
-			resultList := testCase.reconciler.applyManifests(context.Background(), testCase.manifestList, ownerRef)
+			resultList := testCase.reconciler.applyManifests(context.Background(), testCase.manifestList, ownerRef, testCase.applyStrategy)
 			for _, result := range resultList {
 				if testCase.wantErr != nil {
 					assert.Containsf(t, result.err.Error(), testCase.wantErr.Error(), "Incorrect error for Testcase %s", testName)
 				} else {
 					assert.Equalf(t, testCase.generation, result.generation, "Testcase %s: generation incorrect", testName)
 					assert.Equalf(t, testCase.action, result.action, "Testcase %s: Updated action incorrect", testName)
+					assert.Lenf(t, result.diffs, testCase.diffs, "Testcase %s: diffs incorrect", testName)
 				}
 			}
 		})
@@ -767,6 +1042,18 @@ func TestReconcile(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to create obj and dynamic client: %s", err)
 	}
+	availableDeployment := happyDeployment.DeepCopy()
+	availableDeployment.Status = appsv1.DeploymentStatus{
+		Replicas:          1,
+		UpdatedReplicas:   1,
+		ReadyReplicas:     1,
+		AvailableReplicas: 1,
+	}
+	rawAvailableDeployment, _ := json.Marshal(availableDeployment)
+	_, availableDynamicClient, _, err := createObjAndDynamicClient(rawAvailableDeployment)
+	if err != nil {
+		t.Errorf("failed to create obj and dynamic client: %s", err)
+	}
 
 	getMockAppliedWork := func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
 		if key.Name != workName {
@@ -799,6 +1086,8 @@ func TestReconcile(t *testing.T) {
 		req        ctrl.Request
 		wantErr    error
 		requeue    bool
+		// notAvailable indicates that the work is applied but not available yet.
+		notAvailable bool
 	}{
 		"controller is being stopped": {
 			reconciler: ApplyWorkReconciler{
@@ -995,6 +1284,30 @@ func TestReconcile(t *testing.T) {
 				recorder:   utils.NewFakeRecorder(1),
 				joined:     atomic.NewBool(true),
 			},
+			req:          req,
+			wantErr:      nil,
+			requeue:      true,
+			notAvailable: true,
+		},
+		"Happy Path with the work available": {
+			reconciler: ApplyWorkReconciler{
+				client: &test.MockClient{
+					MockGet: getMock,
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+				spokeDynamicClient: availableDynamicClient,
+				spokeClient: &test.MockClient{
+					MockGet: getMockAppliedWork,
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+				restMapper: testMapper{},
+				recorder:   utils.NewFakeRecorder(1),
+				joined:     atomic.NewBool(true),
+			},
 			req:     req,
 			wantErr: nil,
 			requeue: true,
@@ -1007,7 +1320,9 @@ func TestReconcile(t *testing.T) {
 				assert.Containsf(t, err.Error(), testCase.wantErr.Error(), "incorrect error for Testcase %s", testName)
 			} else {
 				if testCase.requeue {
-					if testCase.reconciler.joined.Load() {
+					if testCase.notAvailable {
+						assert.Equal(t, ctrl.Result{RequeueAfter: availabilityRecheckInterval}, ctrlResult, "incorrect ctrlResult for Testcase %s", testName)
+					} else if testCase.reconciler.joined.Load() {
 						assert.Equal(t, ctrl.Result{RequeueAfter: time.Minute * 5}, ctrlResult, "incorrect ctrlResult for Testcase %s", testName)
 					} else {
 						assert.Equal(t, ctrl.Result{RequeueAfter: time.Second * 5}, ctrlResult, "incorrect ctrlResult for Testcase %s", testName)
@@ -1064,3 +1379,512 @@ func createLargeObj() (*unstructured.Unstructured, error) {
 	}
 	return &largeObj, nil
 }
+
+func TestBuildManifestAvailableCondition(t *testing.T) {
+	tests := map[string]struct {
+		result     applyResult
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		"manifest failed to be applied": {
+			result:     applyResult{err: errors.New("apply failed")},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestNotAppliedReason,
+		},
+		"manifest has drifted and is not applied": {
+			result:     applyResult{action: ManifestDriftedAction},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestNotAppliedReason,
+		},
+		"manifest is not taken over": {
+			result:     applyResult{action: ManifestNotTakenOverAction},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestNotAppliedReason,
+		},
+		"manifest is waiting for the previous apply waves": {
+			result:     applyResult{action: ManifestApplyWaitingAction},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestNotAppliedReason,
+		},
+		"manifest diff is reported and is not applied": {
+			result:     applyResult{action: ManifestDiffReportedAction},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestNotAppliedReason,
+		},
+		"failed to track the manifest availability": {
+			result:     applyResult{availabilityErr: errors.New("track failed")},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.ManifestAvailabilityUnknownReason,
+		},
+		"manifest is available": {
+			result:     applyResult{availability: manifestAvailable},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.ManifestAvailableReason,
+		},
+		"manifest is not trackable": {
+			result:     applyResult{availability: manifestNotTrackable},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.ManifestNotTrackableReason,
+		},
+		"manifest is not available yet": {
+			result:     applyResult{availability: manifestNotAvailableYet},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: fleetv1beta1.ManifestNotAvailableYetReason,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := buildManifestAvailableCondition(tt.result, 1)
+			if got.Type != fleetv1beta1.WorkConditionTypeAvailable || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.ObservedGeneration != 1 {
+				t.Errorf("buildManifestAvailableCondition() = %+v, want status %s and reason %s", got, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkAppliedCondition(t *testing.T) {
+	manifestCondition := func(status metav1.ConditionStatus) fleetv1beta1.ManifestCondition {
+		return fleetv1beta1.ManifestCondition{
+			Conditions: []metav1.Condition{{Type: fleetv1beta1.WorkConditionTypeApplied, Status: status}},
+		}
+	}
+	tests := map[string]struct {
+		manifestConditions []fleetv1beta1.ManifestCondition
+		wantStatus         metav1.ConditionStatus
+		wantReason         string
+	}{
+		"all the manifests are applied": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{manifestCondition(metav1.ConditionTrue), manifestCondition(metav1.ConditionTrue)},
+			wantStatus:         metav1.ConditionTrue,
+			wantReason:         AppliedWorkCompleteReason,
+		},
+		"some manifests are waiting for the previous apply waves": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{manifestCondition(metav1.ConditionTrue), manifestCondition(metav1.ConditionUnknown)},
+			wantStatus:         metav1.ConditionUnknown,
+			wantReason:         AppliedWorkPendingReason,
+		},
+		"some manifests failed to be applied": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{manifestCondition(metav1.ConditionUnknown), manifestCondition(metav1.ConditionFalse)},
+			wantStatus:         metav1.ConditionFalse,
+			wantReason:         AppliedWorkFailedReason,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := generateWorkAppliedCondition(tt.manifestConditions, 1)
+			if got.Type != fleetv1beta1.WorkConditionTypeApplied || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.ObservedGeneration != 1 {
+				t.Errorf("generateWorkAppliedCondition() = %+v, want status %s and reason %s", got, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkCondition_DriftDetails(t *testing.T) {
+	firstObservedTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	identifier := fleetv1beta1.WorkResourceIdentifier{Ordinal: 0, Version: "v1", Kind: "ConfigMap", Name: "cm"}
+	drifts := []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "new", ValueInHub: "old"}}
+	tests := map[string]struct {
+		results                  []applyResult
+		existingDriftDetails     *fleetv1beta1.DriftDetails
+		wantDriftDetails         bool
+		wantFirstDriftedObserved *metav1.Time
+	}{
+		"no drifts": {
+			results: []applyResult{{identifier: identifier, action: ManifestNoChangeAction, availability: manifestAvailable}},
+		},
+		"drifts are found for the first time": {
+			results:          []applyResult{{identifier: identifier, action: ManifestDriftedAction, generation: 2, drifts: drifts}},
+			wantDriftDetails: true,
+		},
+		"drifts are found again": {
+			results:                  []applyResult{{identifier: identifier, action: ManifestDriftedAction, generation: 2, drifts: drifts}},
+			existingDriftDetails:     &fleetv1beta1.DriftDetails{FirstDriftedObservedTime: firstObservedTime},
+			wantDriftDetails:         true,
+			wantFirstDriftedObserved: &firstObservedTime,
+		},
+		"drifts are gone": {
+			results:              []applyResult{{identifier: identifier, action: ManifestNoChangeAction, availability: manifestAvailable}},
+			existingDriftDetails: &fleetv1beta1.DriftDetails{FirstDriftedObservedTime: firstObservedTime},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{}
+			if tt.existingDriftDetails != nil {
+				work.Status.ManifestConditions = []fleetv1beta1.ManifestCondition{
+					{Identifier: identifier, DriftDetails: tt.existingDriftDetails},
+				}
+			}
+			r := &ApplyWorkReconciler{}
+			if errs := r.generateWorkCondition(tt.results, work); len(errs) != 0 {
+				t.Fatalf("generateWorkCondition() got errors %v, want no error", errs)
+			}
+			got := work.Status.ManifestConditions[0].DriftDetails
+			if !tt.wantDriftDetails {
+				if got != nil {
+					t.Errorf("generateWorkCondition() got drift details %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("generateWorkCondition() got nil drift details, want drift details")
+			}
+			if !reflect.DeepEqual(drifts, got.ObservedDrifts) {
+				t.Errorf("generateWorkCondition() observed drifts = %+v, want %+v", got.ObservedDrifts, drifts)
+			}
+			if got.ObservedInMemberClusterGeneration != 2 {
+				t.Errorf("generateWorkCondition() observed generation = %d, want 2", got.ObservedInMemberClusterGeneration)
+			}
+			if tt.wantFirstDriftedObserved != nil && !got.FirstDriftedObservedTime.Equal(tt.wantFirstDriftedObserved) {
+				t.Errorf("generateWorkCondition() first drifted observed time = %v, want %v", got.FirstDriftedObservedTime, tt.wantFirstDriftedObserved)
+			}
+			if !meta.IsStatusConditionFalse(work.Status.Conditions, fleetv1beta1.WorkConditionTypeApplied) {
+				t.Errorf("generateWorkCondition() got work conditions %+v, want the applied condition to be false", work.Status.Conditions)
+			}
+		})
+	}
+}
+
+func TestBuildObservedStatus(t *testing.T) {
+	tests := map[string]struct {
+		status     interface{}
+		wantStatus *runtime.RawExtension
+	}{
+		"object without status": {},
+		"object with status": {
+			status:     map[string]interface{}{"readyReplicas": int64(3)},
+			wantStatus: &runtime.RawExtension{Raw: []byte(`{"readyReplicas":3}`)},
+		},
+		"object with too large status": {
+			status: map[string]interface{}{"message": strings.Repeat("a", maxBackReportedStatusSize)},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+			}}
+			if tt.status != nil {
+				obj.Object["status"] = tt.status
+			}
+			if got := buildObservedStatus(obj); !reflect.DeepEqual(got, tt.wantStatus) {
+				t.Errorf("buildObservedStatus() = %+v, want %+v", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkCondition_BackReportedStatus(t *testing.T) {
+	identifier := fleetv1beta1.WorkResourceIdentifier{Ordinal: 0, Group: "apps", Version: "v1", Kind: "Deployment", Name: "deploy"}
+	observedStatus := &runtime.RawExtension{Raw: []byte(`{"readyReplicas":3}`)}
+	tests := map[string]struct {
+		result     applyResult
+		wantStatus *runtime.RawExtension
+	}{
+		"status is not reported back": {
+			result: applyResult{identifier: identifier, action: ManifestNoChangeAction, availability: manifestAvailable},
+		},
+		"status is reported back": {
+			result:     applyResult{identifier: identifier, action: ManifestNoChangeAction, availability: manifestAvailable, observedStatus: observedStatus},
+			wantStatus: observedStatus,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{}
+			r := &ApplyWorkReconciler{}
+			if errs := r.generateWorkCondition([]applyResult{tt.result}, work); len(errs) != 0 {
+				t.Fatalf("generateWorkCondition() got errors %v, want no error", errs)
+			}
+			got := work.Status.ManifestConditions[0].BackReportedStatus
+			if tt.wantStatus == nil {
+				if got != nil {
+					t.Errorf("generateWorkCondition() got back reported status %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || !reflect.DeepEqual(got.ObservedStatus, *tt.wantStatus) || got.ObservationTime.IsZero() {
+				t.Errorf("generateWorkCondition() got back reported status %+v, want observed status %s", got, tt.wantStatus.Raw)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkAvailableCondition(t *testing.T) {
+	manifestCondition := func(status metav1.ConditionStatus, reason string) fleetv1beta1.ManifestCondition {
+		return fleetv1beta1.ManifestCondition{
+			Conditions: []metav1.Condition{
+				{
+					Type:   fleetv1beta1.WorkConditionTypeAvailable,
+					Status: status,
+					Reason: reason,
+				},
+			},
+		}
+	}
+	tests := map[string]struct {
+		manifestConditions []fleetv1beta1.ManifestCondition
+		wantStatus         metav1.ConditionStatus
+		wantReason         string
+	}{
+		"no manifests": {
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.WorkAvailableReason,
+		},
+		"all the manifests are available": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestAvailableReason),
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestAvailableReason),
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.WorkAvailableReason,
+		},
+		"some manifests are not trackable": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestAvailableReason),
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestNotTrackableReason),
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.WorkNotTrackableReason,
+		},
+		"some manifests are not available yet": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{
+				manifestCondition(metav1.ConditionFalse, fleetv1beta1.ManifestNotAvailableYetReason),
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestNotTrackableReason),
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: fleetv1beta1.WorkNotAvailableYetReason,
+		},
+		"the availability of some manifests is unknown": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{
+				manifestCondition(metav1.ConditionFalse, fleetv1beta1.ManifestNotAvailableYetReason),
+				manifestCondition(metav1.ConditionUnknown, fleetv1beta1.ManifestNotAppliedReason),
+			},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.WorkAvailabilityUnknownReason,
+		},
+		"some manifests have no available condition": {
+			manifestConditions: []fleetv1beta1.ManifestCondition{
+				manifestCondition(metav1.ConditionTrue, fleetv1beta1.ManifestAvailableReason),
+				{},
+			},
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: fleetv1beta1.WorkAvailabilityUnknownReason,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := generateWorkAvailableCondition(tt.manifestConditions, 2)
+			if got.Type != fleetv1beta1.WorkConditionTypeAvailable || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.ObservedGeneration != 2 {
+				t.Errorf("generateWorkAvailableCondition() = %+v, want status %s and reason %s", got, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGarbageCollectAppliedWork(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	deletingWork := func(deletedAt time.Time, deletionPolicy *fleetv1beta1.DeletionPolicy) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "work",
+				Namespace:         "cluster-x",
+				Finalizers:        []string{fleetv1beta1.WorkFinalizer},
+				DeletionTimestamp: &metav1.Time{Time: deletedAt},
+			},
+			Spec: fleetv1beta1.WorkSpec{DeletionPolicy: deletionPolicy},
+		}
+	}
+	retain := &fleetv1beta1.DeletionPolicy{
+		Type:                     fleetv1beta1.DeletionPolicyTypeRetain,
+		RetainGracePeriodSeconds: pointer.Int32(60),
+	}
+	tests := map[string]struct {
+		work              *fleetv1beta1.Work
+		wantOrphan        bool
+		wantAppliedDelete bool
+		wantRetainUntil   string
+	}{
+		"delete the appliedWork by default": {
+			work:              deletingWork(now, nil),
+			wantAppliedDelete: true,
+		},
+		"orphan the manifests before deleting the appliedWork": {
+			work:              deletingWork(now, &fleetv1beta1.DeletionPolicy{Type: fleetv1beta1.DeletionPolicyTypeOrphan}),
+			wantOrphan:        true,
+			wantAppliedDelete: true,
+		},
+		"record the end of the grace period on the appliedWork within the grace period": {
+			work:            deletingWork(now.Add(-time.Second), retain),
+			wantRetainUntil: now.Add(59 * time.Second).UTC().Format(time.RFC3339),
+		},
+		"delete the appliedWork after the grace period": {
+			work:              deletingWork(now.Add(-2*time.Minute), retain),
+			wantAppliedDelete: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotGet, gotAppliedDelete bool
+			var gotRetainUntil string
+			r := &ApplyWorkReconciler{
+				client: &test.MockClient{
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						return nil
+					},
+				},
+				spokeClient: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						gotGet = true
+						obj.SetName(key.Name)
+						return nil
+					},
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						gotRetainUntil = obj.GetAnnotations()[fleetv1beta1.RetainUntilAnnotation]
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						gotAppliedDelete = true
+						return nil
+					},
+				},
+			}
+			got, err := r.garbageCollectAppliedWork(context.Background(), tt.work)
+			if err != nil {
+				t.Fatalf("garbageCollectAppliedWork() got error %v, want no error", err)
+			}
+			if got.RequeueAfter > 0 {
+				t.Errorf("garbageCollectAppliedWork() got result %+v, want no requeue", got)
+			}
+			if gotOrphan := gotGet && gotAppliedDelete; gotOrphan != tt.wantOrphan {
+				t.Errorf("garbageCollectAppliedWork() orphaned the manifests %t, want %t", gotOrphan, tt.wantOrphan)
+			}
+			if gotAppliedDelete != tt.wantAppliedDelete {
+				t.Errorf("garbageCollectAppliedWork() deleted the appliedWork %t, want %t", gotAppliedDelete, tt.wantAppliedDelete)
+			}
+			if gotRetainUntil != tt.wantRetainUntil {
+				t.Errorf("garbageCollectAppliedWork() retained the appliedWork until %q, want %q", gotRetainUntil, tt.wantRetainUntil)
+			}
+			if len(tt.work.Finalizers) != 0 {
+				t.Errorf("garbageCollectAppliedWork() got finalizers %v, want removed", tt.work.Finalizers)
+			}
+		})
+	}
+}
+
+func TestReconcileRetainedAppliedWork(t *testing.T) {
+	retainedAppliedWork := func(retainUntil time.Time) *fleetv1beta1.AppliedWork {
+		return &fleetv1beta1.AppliedWork{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "work",
+				Annotations: map[string]string{
+					fleetv1beta1.RetainUntilAnnotation: retainUntil.UTC().Format(time.RFC3339),
+				},
+			},
+			Spec: fleetv1beta1.AppliedWorkSpec{
+				WorkName:      "work",
+				WorkNamespace: "cluster-x",
+			},
+		}
+	}
+	deletingWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "work",
+			Namespace:         "cluster-x",
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		},
+	}
+	tests := map[string]struct {
+		joined            bool
+		appliedWork       *fleetv1beta1.AppliedWork
+		work              *fleetv1beta1.Work
+		getWorkErr        error
+		deleteErr         error
+		wantRequeue       bool
+		wantAppliedDelete bool
+		wantErr           bool
+	}{
+		"delete the appliedWork after the grace period": {
+			appliedWork:       retainedAppliedWork(time.Now().Add(-time.Second)),
+			wantAppliedDelete: true,
+		},
+		"requeue the appliedWork within the grace period": {
+			appliedWork: retainedAppliedWork(time.Now().Add(time.Minute)),
+			wantRequeue: true,
+		},
+		"keep the appliedWork which is no longer retained": {
+			appliedWork: &fleetv1beta1.AppliedWork{ObjectMeta: metav1.ObjectMeta{Name: "work"}},
+		},
+		"keep the appliedWork whose work is placed on the cluster again": {
+			joined:      true,
+			appliedWork: retainedAppliedWork(time.Now().Add(-time.Second)),
+			work:        &fleetv1beta1.Work{ObjectMeta: metav1.ObjectMeta{Name: "work", Namespace: "cluster-x"}},
+		},
+		"delete the appliedWork whose work is being deleted after the cluster joins again": {
+			joined:            true,
+			appliedWork:       retainedAppliedWork(time.Now().Add(-time.Second)),
+			work:              deletingWork,
+			wantAppliedDelete: true,
+		},
+		"delete the appliedWork whose work is gone after the cluster joins again": {
+			joined:            true,
+			appliedWork:       retainedAppliedWork(time.Now().Add(-time.Second)),
+			getWorkErr:        apierrors.NewNotFound(schema.GroupResource{}, "work"),
+			wantAppliedDelete: true,
+		},
+		"return the error when failing to get the work": {
+			joined:      true,
+			appliedWork: retainedAppliedWork(time.Now().Add(-time.Second)),
+			getWorkErr:  errors.New("get error"),
+			wantErr:     true,
+		},
+		"return the error when failing to delete the appliedWork": {
+			appliedWork:       retainedAppliedWork(time.Now().Add(-time.Second)),
+			deleteErr:         errors.New("delete error"),
+			wantAppliedDelete: true,
+			wantErr:           true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotAppliedDelete bool
+			r := &ApplyWorkReconciler{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if tt.getWorkErr != nil {
+							return tt.getWorkErr
+						}
+						tt.work.DeepCopyInto(obj.(*fleetv1beta1.Work))
+						return nil
+					},
+				},
+				spokeClient: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						tt.appliedWork.DeepCopyInto(obj.(*fleetv1beta1.AppliedWork))
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						gotAppliedDelete = true
+						return tt.deleteErr
+					},
+				},
+				joined:        atomic.NewBool(tt.joined),
+				workNameSpace: "cluster-x",
+			}
+			got, err := r.ReconcileRetainedAppliedWork(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: tt.appliedWork.Name}})
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("ReconcileRetainedAppliedWork() got error %v, want error %t", err, tt.wantErr)
+			}
+			if gotRequeue := got.RequeueAfter > 0; gotRequeue != tt.wantRequeue {
+				t.Errorf("ReconcileRetainedAppliedWork() got result %+v, want requeue %t", got, tt.wantRequeue)
+			}
+			if gotAppliedDelete != tt.wantAppliedDelete {
+				t.Errorf("ReconcileRetainedAppliedWork() deleted the appliedWork %t, want %t", gotAppliedDelete, tt.wantAppliedDelete)
+			}
+		})
+	}
+}
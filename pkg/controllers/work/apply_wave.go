@@ -0,0 +1,103 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+const (
+	// namespaceApplyWave is the wave of the objects that other objects live in or are instances of.
+	namespaceApplyWave = iota
+	// rbacApplyWave is the wave of the identities and permissions used by the workloads.
+	rbacApplyWave
+	// configApplyWave is the wave of the configurations and storage consumed by the workloads.
+	configApplyWave
+	// serviceApplyWave is the wave of the services exposing the workloads.
+	serviceApplyWave
+	// workloadApplyWave is the wave of the workloads.
+	workloadApplyWave
+	// defaultApplyWave is the wave of all the other objects, e.g., custom resources and webhook configurations
+	// which usually depend on the workloads serving them.
+	defaultApplyWave
+)
+
+// kindApplyWaves are the apply waves of the well-known kinds, ordered by how they depend on each other.
+var kindApplyWaves = map[schema.GroupKind]int{
+	namespaceGK: namespaceApplyWave,
+	crdGK:       namespaceApplyWave,
+	{Group: schedulingv1.GroupName, Kind: "PriorityClass"}: namespaceApplyWave,
+	{Group: storagev1.GroupName, Kind: "StorageClass"}:     namespaceApplyWave,
+
+	{Group: v1.GroupName, Kind: "ServiceAccount"}:         rbacApplyWave,
+	{Group: rbacv1.GroupName, Kind: "Role"}:               rbacApplyWave,
+	{Group: rbacv1.GroupName, Kind: "ClusterRole"}:        rbacApplyWave,
+	{Group: rbacv1.GroupName, Kind: "RoleBinding"}:        rbacApplyWave,
+	{Group: rbacv1.GroupName, Kind: "ClusterRoleBinding"}: rbacApplyWave,
+
+	{Group: v1.GroupName, Kind: "ConfigMap"}:               configApplyWave,
+	{Group: v1.GroupName, Kind: "Secret"}:                  configApplyWave,
+	{Group: v1.GroupName, Kind: "LimitRange"}:              configApplyWave,
+	{Group: v1.GroupName, Kind: "ResourceQuota"}:           configApplyWave,
+	{Group: v1.GroupName, Kind: "PersistentVolume"}:        configApplyWave,
+	{Group: networkingv1.GroupName, Kind: "NetworkPolicy"}: configApplyWave,
+	pvcGK: configApplyWave,
+
+	serviceGK: serviceApplyWave,
+
+	deploymentGK:  workloadApplyWave,
+	statefulSetGK: workloadApplyWave,
+	daemonSetGK:   workloadApplyWave,
+	jobGK:         workloadApplyWave,
+	{Group: appsv1.GroupName, Kind: "ReplicaSet"}: workloadApplyWave,
+	{Group: batchv1.GroupName, Kind: "CronJob"}:   workloadApplyWave,
+	{Group: v1.GroupName, Kind: "Pod"}:            workloadApplyWave,
+}
+
+// applyWaveOf returns the wave in which the object is applied. The wave explicitly specified in the apply wave
+// annotation takes precedence over the one derived from the kind of the object.
+func applyWaveOf(obj *unstructured.Unstructured) (int, error) {
+	if value, ok := obj.GetAnnotations()[fleetv1beta1.ApplyWaveAnnotation]; ok {
+		wave, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("the value `%s` of the annotation %s is not an integer: %w", value, fleetv1beta1.ApplyWaveAnnotation, err)
+		}
+		return wave, nil
+	}
+	if wave, ok := kindApplyWaves[obj.GroupVersionKind().GroupKind()]; ok {
+		return wave, nil
+	}
+	return defaultApplyWave, nil
+}
+
+// blocksNextApplyWave returns true if the manifest is not ready for the manifests in the next waves to be applied,
+// i.e., it failed to be applied or it is known to be not available yet.
+func blocksNextApplyWave(result applyResult) bool {
+	return result.err != nil || result.availability == manifestNotAvailableYet
+}
+
+// hasWaitingManifests returns true if some manifests are waiting for the manifests in the previous waves.
+func hasWaitingManifests(results []applyResult) bool {
+	for _, result := range results {
+		if result.action == ManifestApplyWaitingAction {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	testingclient "k8s.io/client-go/testing"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+// waveTestMapper maps the deployments, the configMaps and the namespaces used in the apply wave and the dry-run tests.
+type waveTestMapper struct {
+	meta.RESTMapper
+}
+
+func (m waveTestMapper) RESTMapping(gk schema.GroupKind, _ ...string) (*meta.RESTMapping, error) {
+	switch gk.Kind {
+	case "Deployment":
+		return &meta.RESTMapping{
+			Resource:         schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			GroupVersionKind: appsv1.SchemeGroupVersion.WithKind("Deployment"),
+		}, nil
+	case "ConfigMap":
+		return &meta.RESTMapping{
+			Resource:         schema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+			GroupVersionKind: v1.SchemeGroupVersion.WithKind("ConfigMap"),
+		}, nil
+	case "Namespace":
+		return &meta.RESTMapping{
+			Resource:         schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+			GroupVersionKind: v1.SchemeGroupVersion.WithKind("Namespace"),
+		}, nil
+	}
+	return nil, errors.New("test error: mapping does not exist")
+}
+
+func TestApplyWaveOf(t *testing.T) {
+	tests := map[string]struct {
+		obj      *unstructured.Unstructured
+		wantWave int
+		wantErr  bool
+	}{
+		"namespace": {
+			obj:      waveTestObject("v1", "Namespace", ""),
+			wantWave: namespaceApplyWave,
+		},
+		"custom resource definition": {
+			obj:      waveTestObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", ""),
+			wantWave: namespaceApplyWave,
+		},
+		"cluster role binding": {
+			obj:      waveTestObject("rbac.authorization.k8s.io/v1", "ClusterRoleBinding", ""),
+			wantWave: rbacApplyWave,
+		},
+		"configMap": {
+			obj:      waveTestObject("v1", "ConfigMap", ""),
+			wantWave: configApplyWave,
+		},
+		"service": {
+			obj:      waveTestObject("v1", "Service", ""),
+			wantWave: serviceApplyWave,
+		},
+		"deployment": {
+			obj:      waveTestObject("apps/v1", "Deployment", ""),
+			wantWave: workloadApplyWave,
+		},
+		"custom resource": {
+			obj:      waveTestObject("example.com/v1", "Deployment", ""),
+			wantWave: defaultApplyWave,
+		},
+		"explicit wave takes precedence": {
+			obj:      waveTestObject("v1", "Namespace", "-1"),
+			wantWave: -1,
+		},
+		"invalid explicit wave": {
+			obj:     waveTestObject("v1", "ConfigMap", "first"),
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := applyWaveOf(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyWaveOf() got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.wantWave {
+				t.Errorf("applyWaveOf() = %d, want %d", got, tt.wantWave)
+			}
+		})
+	}
+}
+
+func TestApplyManifests_Waves(t *testing.T) {
+	deploy := waveTestManifest(t, "apps/v1", "Deployment", "deploy", "")
+	configMap := waveTestManifest(t, "v1", "ConfigMap", "cm", "")
+	firstDeploy := waveTestManifest(t, "apps/v1", "Deployment", "first-deploy", "-1")
+	failedGetReactor := func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		if action.(testingclient.GetAction).GetName() == "first-deploy" {
+			return true, nil, errors.New("get failed")
+		}
+		return false, nil, nil
+	}
+
+	tests := map[string]struct {
+		manifests     []fleetv1beta1.Manifest
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		getReactor    testingclient.ReactionFunc
+		wantActions   []applyAction
+		wantErrs      []bool
+	}{
+		"manifests are applied after the available previous waves": {
+			manifests:   []fleetv1beta1.Manifest{deploy, configMap},
+			wantActions: []applyAction{ManifestCreatedAction, ManifestCreatedAction},
+			wantErrs:    []bool{false, false},
+		},
+		"manifests wait for the previous waves not available yet": {
+			manifests:   []fleetv1beta1.Manifest{configMap, firstDeploy, deploy},
+			wantActions: []applyAction{ManifestApplyWaitingAction, ManifestCreatedAction, ManifestApplyWaitingAction},
+			wantErrs:    []bool{false, false, false},
+		},
+		"manifests wait for the previous waves failed to be applied": {
+			manifests:   []fleetv1beta1.Manifest{configMap, firstDeploy},
+			getReactor:  failedGetReactor,
+			wantActions: []applyAction{ManifestApplyWaitingAction, ManifestNoChangeAction},
+			wantErrs:    []bool{false, true},
+		},
+		"manifests in the same wave are all applied": {
+			manifests:   []fleetv1beta1.Manifest{waveTestManifest(t, "v1", "ConfigMap", "cm", "-1"), firstDeploy},
+			wantActions: []applyAction{ManifestCreatedAction, ManifestCreatedAction},
+			wantErrs:    []bool{false, false},
+		},
+		"waves are ignored when only reporting the differences": {
+			manifests:     []fleetv1beta1.Manifest{configMap, firstDeploy},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff},
+			wantActions:   []applyAction{ManifestDiffReportedAction, ManifestDiffReportedAction},
+			wantErrs:      []bool{false, false},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+			if tt.getReactor != nil {
+				dynamicClient.PrependReactor("get", "*", tt.getReactor)
+			}
+			r := &ApplyWorkReconciler{
+				spokeDynamicClient: dynamicClient,
+				restMapper:         waveTestMapper{},
+			}
+			results := r.applyManifests(context.Background(), tt.manifests, ownerRef, tt.applyStrategy)
+			gotActions := make([]applyAction, len(results))
+			gotErrs := make([]bool, len(results))
+			for i, result := range results {
+				gotActions[i] = result.action
+				gotErrs[i] = result.err != nil
+			}
+			if diff := cmp.Diff(tt.wantActions, gotActions); diff != "" {
+				t.Errorf("applyManifests() actions mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantErrs, gotErrs); diff != "" {
+				t.Errorf("applyManifests() errors mismatch (-want, +got):\n%s", diff)
+			}
+			if got, want := hasWaitingManifests(results), containsAction(tt.wantActions, ManifestApplyWaitingAction); got != want {
+				t.Errorf("hasWaitingManifests() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func containsAction(actions []applyAction, action applyAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func waveTestObject(apiVersion, kind, wave string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName("test")
+	if wave != "" {
+		obj.SetAnnotations(map[string]string{fleetv1beta1.ApplyWaveAnnotation: wave})
+	}
+	return obj
+}
+
+func waveTestManifest(t *testing.T, apiVersion, kind, name, wave string) fleetv1beta1.Manifest {
+	obj := waveTestObject(apiVersion, kind, wave)
+	obj.SetName(name)
+	obj.SetNamespace("app")
+	obj.SetOwnerReferences([]metav1.OwnerReference{ownerRef})
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal the manifest: %v", err)
+	}
+	return fleetv1beta1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}}
+}
+
+func TestApplyManifests_Parallel(t *testing.T) {
+	manifests := make([]fleetv1beta1.Manifest, 20)
+	for i := range manifests {
+		manifests[i] = waveTestManifest(t, "v1", "ConfigMap", fmt.Sprintf("cm-%d", i), "")
+	}
+	r := &ApplyWorkReconciler{
+		spokeDynamicClient: fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		restMapper:         waveTestMapper{},
+		applyConcurrency:   4,
+	}
+	results := r.applyManifests(context.Background(), manifests, ownerRef, nil)
+	for i, result := range results {
+		if result.err != nil {
+			t.Fatalf("applyManifests() got error %v for manifest %d, want no error", result.err, i)
+		}
+		wantIdentifier := fleetv1beta1.WorkResourceIdentifier{
+			Ordinal:   i,
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Resource:  "configmaps",
+			Namespace: "app",
+			Name:      fmt.Sprintf("cm-%d", i),
+		}
+		if diff := cmp.Diff(wantIdentifier, result.identifier); diff != "" {
+			t.Errorf("applyManifests() identifier of manifest %d mismatch (-want, +got):\n%s", i, diff)
+		}
+		if result.action != ManifestCreatedAction {
+			t.Errorf("applyManifests() action of manifest %d = %s, want %s", i, result.action, ManifestCreatedAction)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// manifestAvailabilityType describes the availability of a manifest applied to the member cluster.
+type manifestAvailabilityType string
+
+const (
+	// manifestAvailable indicates that the applied manifest is available for use.
+	manifestAvailable manifestAvailabilityType = "Available"
+
+	// manifestNotAvailableYet indicates that the applied manifest is not available for use yet,
+	// e.g., the pods of a deployment are still being rolled out.
+	manifestNotAvailableYet manifestAvailabilityType = "NotAvailableYet"
+
+	// manifestNotTrackable indicates that the agent does not know how to track the availability
+	// of the applied manifest; such a manifest is considered available as soon as it is applied.
+	manifestNotTrackable manifestAvailabilityType = "NotTrackable"
+)
+
+var (
+	deploymentGK  = schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}
+	statefulSetGK = schema.GroupKind{Group: appsv1.GroupName, Kind: "StatefulSet"}
+	daemonSetGK   = schema.GroupKind{Group: appsv1.GroupName, Kind: "DaemonSet"}
+	jobGK         = schema.GroupKind{Group: batchv1.GroupName, Kind: "Job"}
+	serviceGK     = schema.GroupKind{Group: v1.GroupName, Kind: "Service"}
+	pvcGK         = schema.GroupKind{Group: v1.GroupName, Kind: "PersistentVolumeClaim"}
+	namespaceGK   = schema.GroupKind{Group: v1.GroupName, Kind: "Namespace"}
+	crdGK         = schema.GroupKind{Group: apiextensionsv1.GroupName, Kind: "CustomResourceDefinition"}
+
+	// dataOnlyGKs are the kinds of objects that have no status to wait for;
+	// they are available for use as soon as they are applied.
+	dataOnlyGKs = map[schema.GroupKind]bool{
+		{Group: v1.GroupName, Kind: "ConfigMap"}:              true,
+		{Group: v1.GroupName, Kind: "Secret"}:                 true,
+		{Group: v1.GroupName, Kind: "ServiceAccount"}:         true,
+		{Group: v1.GroupName, Kind: "LimitRange"}:             true,
+		{Group: v1.GroupName, Kind: "ResourceQuota"}:          true,
+		{Group: rbacv1.GroupName, Kind: "Role"}:               true,
+		{Group: rbacv1.GroupName, Kind: "ClusterRole"}:        true,
+		{Group: rbacv1.GroupName, Kind: "RoleBinding"}:        true,
+		{Group: rbacv1.GroupName, Kind: "ClusterRoleBinding"}: true,
+	}
+)
+
+// trackResourceAvailability checks whether an applied object is available for use on the member cluster.
+func trackResourceAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	switch gk {
+	case deploymentGK:
+		return trackDeploymentAvailability(obj)
+	case statefulSetGK:
+		return trackStatefulSetAvailability(obj)
+	case daemonSetGK:
+		return trackDaemonSetAvailability(obj)
+	case jobGK:
+		return trackJobAvailability(obj)
+	case serviceGK:
+		return trackServiceAvailability(obj)
+	case pvcGK:
+		return trackPVCAvailability(obj)
+	case namespaceGK:
+		return trackNamespaceAvailability(obj)
+	case crdGK:
+		return trackCRDAvailability(obj)
+	default:
+		if dataOnlyGKs[gk] {
+			klog.V(2).InfoS("Data only object is available once applied", "gk", gk, "object", klog.KObj(obj))
+			return manifestAvailable, nil
+		}
+		klog.V(2).InfoS("Cannot track the availability of the object", "gk", gk, "object", klog.KObj(obj))
+		return manifestNotTrackable, nil
+	}
+}
+
+func trackDeploymentAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var deploy appsv1.Deployment
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &deploy); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a deployment: %w", err)
+	}
+	requiredReplicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		requiredReplicas = *deploy.Spec.Replicas
+	}
+	// A deployment is available when the latest spec has been observed and all the required
+	// replicas are updated and available.
+	if deploy.Status.ObservedGeneration == deploy.Generation &&
+		deploy.Status.UpdatedReplicas == requiredReplicas &&
+		deploy.Status.AvailableReplicas == requiredReplicas &&
+		deploy.Status.UnavailableReplicas == 0 {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("Deployment is not available yet", "deployment", klog.KObj(obj),
+		"requiredReplicas", requiredReplicas, "availableReplicas", deploy.Status.AvailableReplicas,
+		"updatedReplicas", deploy.Status.UpdatedReplicas)
+	return manifestNotAvailableYet, nil
+}
+
+func trackStatefulSetAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var sts appsv1.StatefulSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &sts); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a statefulSet: %w", err)
+	}
+	requiredReplicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		requiredReplicas = *sts.Spec.Replicas
+	}
+	// A statefulSet is available when the latest spec has been observed, all the required replicas
+	// are available and running the current revision, and no rollout is in progress.
+	if sts.Status.ObservedGeneration == sts.Generation &&
+		sts.Status.AvailableReplicas == requiredReplicas &&
+		sts.Status.CurrentReplicas == requiredReplicas &&
+		sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("StatefulSet is not available yet", "statefulSet", klog.KObj(obj),
+		"requiredReplicas", requiredReplicas, "availableReplicas", sts.Status.AvailableReplicas,
+		"currentReplicas", sts.Status.CurrentReplicas)
+	return manifestNotAvailableYet, nil
+}
+
+func trackDaemonSetAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var ds appsv1.DaemonSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &ds); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a daemonSet: %w", err)
+	}
+	// A daemonSet is available when the latest spec has been observed and the pods on all the
+	// scheduled nodes are updated and available.
+	if ds.Status.ObservedGeneration == ds.Generation &&
+		ds.Status.NumberAvailable == ds.Status.DesiredNumberScheduled &&
+		ds.Status.UpdatedNumberScheduled == ds.Status.DesiredNumberScheduled {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("DaemonSet is not available yet", "daemonSet", klog.KObj(obj),
+		"desiredNumberScheduled", ds.Status.DesiredNumberScheduled, "numberAvailable", ds.Status.NumberAvailable,
+		"updatedNumberScheduled", ds.Status.UpdatedNumberScheduled)
+	return manifestNotAvailableYet, nil
+}
+
+func trackJobAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var job batchv1.Job
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a job: %w", err)
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != v1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return manifestAvailable, nil
+		case batchv1.JobFailed:
+			klog.V(2).InfoS("Job has failed", "job", klog.KObj(obj), "reason", cond.Reason)
+			return manifestNotAvailableYet, nil
+		}
+	}
+	// A running job is available once at least one of its pods has succeeded or is ready.
+	if job.Status.Succeeded > 0 || (job.Status.Ready != nil && *job.Status.Ready > 0) {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("Job is not available yet", "job", klog.KObj(obj), "active", job.Status.Active)
+	return manifestNotAvailableYet, nil
+}
+
+func trackServiceAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var svc v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &svc); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a service: %w", err)
+	}
+	switch svc.Spec.Type {
+	case v1.ServiceTypeExternalName:
+		return manifestAvailable, nil
+	case v1.ServiceTypeLoadBalancer:
+		// A load balancer service is available once the load balancer has been provisioned.
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" || ingress.Hostname != "" {
+				return manifestAvailable, nil
+			}
+		}
+	default:
+		// ClusterIP and NodePort services are available once a cluster IP is allocated;
+		// headless services are always allocated the "None" cluster IP.
+		if svc.Spec.ClusterIP != "" {
+			return manifestAvailable, nil
+		}
+	}
+	klog.V(2).InfoS("Service is not available yet", "service", klog.KObj(obj), "type", svc.Spec.Type)
+	return manifestNotAvailableYet, nil
+}
+
+func trackPVCAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var pvc v1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pvc); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a persistentVolumeClaim: %w", err)
+	}
+	if pvc.Status.Phase == v1.ClaimBound {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("PersistentVolumeClaim is not bound yet", "persistentVolumeClaim", klog.KObj(obj), "phase", pvc.Status.Phase)
+	return manifestNotAvailableYet, nil
+}
+
+func trackNamespaceAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var ns v1.Namespace
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &ns); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a namespace: %w", err)
+	}
+	if ns.Status.Phase == v1.NamespaceActive {
+		return manifestAvailable, nil
+	}
+	klog.V(2).InfoS("Namespace is not active", "namespace", klog.KObj(obj), "phase", ns.Status.Phase)
+	return manifestNotAvailableYet, nil
+}
+
+func trackCRDAvailability(obj *unstructured.Unstructured) (manifestAvailabilityType, error) {
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &crd); err != nil {
+		return "", fmt.Errorf("failed to convert the unstructured object to a customResourceDefinition: %w", err)
+	}
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextensionsv1.Established && cond.Status == apiextensionsv1.ConditionTrue {
+			return manifestAvailable, nil
+		}
+	}
+	klog.V(2).InfoS("CustomResourceDefinition is not established yet", "customResourceDefinition", klog.KObj(obj))
+	return manifestNotAvailableYet, nil
+}
@@ -0,0 +1,313 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to convert the object to unstructured: %v", err)
+	}
+	return &unstructured.Unstructured{Object: unstructuredObj}
+}
+
+func TestTrackResourceAvailability(t *testing.T) {
+	deploymentMeta := metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+	statefulSetMeta := metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"}
+	daemonSetMeta := metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"}
+	jobMeta := metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"}
+	serviceMeta := metav1.TypeMeta{APIVersion: "v1", Kind: "Service"}
+
+	tests := map[string]struct {
+		obj  runtime.Object
+		want manifestAvailabilityType
+	}{
+		"deployment with all the replicas available": {
+			obj: &appsv1.Deployment{
+				TypeMeta:   deploymentMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "deploy", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Replicas:           3,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+				},
+			},
+			want: manifestAvailable,
+		},
+		"deployment with default replicas available": {
+			obj: &appsv1.Deployment{
+				TypeMeta:   deploymentMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "deploy", Generation: 1},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    1,
+					AvailableReplicas:  1,
+				},
+			},
+			want: manifestAvailable,
+		},
+		"deployment with the latest generation not observed": {
+			obj: &appsv1.Deployment{
+				TypeMeta:   deploymentMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "deploy", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"deployment with some replicas unavailable": {
+			obj: &appsv1.Deployment{
+				TypeMeta:   deploymentMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "deploy", Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration:  1,
+					UpdatedReplicas:     3,
+					AvailableReplicas:   2,
+					UnavailableReplicas: 1,
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"statefulSet with all the replicas available": {
+			obj: &appsv1.StatefulSet{
+				TypeMeta:   statefulSetMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "sts", Generation: 1},
+				Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.StatefulSetStatus{
+					ObservedGeneration: 1,
+					AvailableReplicas:  2,
+					CurrentReplicas:    2,
+					CurrentRevision:    "rev-1",
+					UpdateRevision:     "rev-1",
+				},
+			},
+			want: manifestAvailable,
+		},
+		"statefulSet with a rollout in progress": {
+			obj: &appsv1.StatefulSet{
+				TypeMeta:   statefulSetMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "sts", Generation: 1},
+				Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.StatefulSetStatus{
+					ObservedGeneration: 1,
+					AvailableReplicas:  2,
+					CurrentReplicas:    2,
+					CurrentRevision:    "rev-1",
+					UpdateRevision:     "rev-2",
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"daemonSet with all the pods available": {
+			obj: &appsv1.DaemonSet{
+				TypeMeta:   daemonSetMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "ds", Generation: 1},
+				Status: appsv1.DaemonSetStatus{
+					ObservedGeneration:     1,
+					DesiredNumberScheduled: 3,
+					NumberAvailable:        3,
+					UpdatedNumberScheduled: 3,
+				},
+			},
+			want: manifestAvailable,
+		},
+		"daemonSet with some pods not updated": {
+			obj: &appsv1.DaemonSet{
+				TypeMeta:   daemonSetMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "ds", Generation: 1},
+				Status: appsv1.DaemonSetStatus{
+					ObservedGeneration:     1,
+					DesiredNumberScheduled: 3,
+					NumberAvailable:        3,
+					UpdatedNumberScheduled: 2,
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"completed job": {
+			obj: &batchv1.Job{
+				TypeMeta:   jobMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "job"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+					},
+				},
+			},
+			want: manifestAvailable,
+		},
+		"failed job": {
+			obj: &batchv1.Job{
+				TypeMeta:   jobMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "job"},
+				Status: batchv1.JobStatus{
+					Succeeded: 1,
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: v1.ConditionTrue},
+					},
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"running job with ready pods": {
+			obj: &batchv1.Job{
+				TypeMeta:   jobMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "job"},
+				Status: batchv1.JobStatus{
+					Active: 1,
+					Ready:  pointer.Int32(1),
+				},
+			},
+			want: manifestAvailable,
+		},
+		"running job without ready pods": {
+			obj: &batchv1.Job{
+				TypeMeta:   jobMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "job"},
+				Status: batchv1.JobStatus{
+					Active: 1,
+				},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"clusterIP service with an allocated IP": {
+			obj: &v1.Service{
+				TypeMeta:   serviceMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP, ClusterIP: "10.0.0.1"},
+			},
+			want: manifestAvailable,
+		},
+		"clusterIP service without an allocated IP": {
+			obj: &v1.Service{
+				TypeMeta:   serviceMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"externalName service": {
+			obj: &v1.Service{
+				TypeMeta:   serviceMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeExternalName, ExternalName: "example.com"},
+			},
+			want: manifestAvailable,
+		},
+		"loadBalancer service with an ingress": {
+			obj: &v1.Service{
+				TypeMeta:   serviceMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ClusterIP: "10.0.0.1"},
+				Status: v1.ServiceStatus{
+					LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "20.0.0.1"}}},
+				},
+			},
+			want: manifestAvailable,
+		},
+		"loadBalancer service without an ingress": {
+			obj: &v1.Service{
+				TypeMeta:   serviceMeta,
+				ObjectMeta: metav1.ObjectMeta{Name: "svc"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ClusterIP: "10.0.0.1"},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"bound persistentVolumeClaim": {
+			obj: &v1.PersistentVolumeClaim{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc"},
+				Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+			},
+			want: manifestAvailable,
+		},
+		"pending persistentVolumeClaim": {
+			obj: &v1.PersistentVolumeClaim{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc"},
+				Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"terminating namespace": {
+			obj: &v1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+				ObjectMeta: metav1.ObjectMeta{Name: "ns"},
+				Status:     v1.NamespaceStatus{Phase: v1.NamespaceTerminating},
+			},
+			want: manifestNotAvailableYet,
+		},
+		"established customResourceDefinition": {
+			obj: &apiextensionsv1.CustomResourceDefinition{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+				ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+						{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+					},
+				},
+			},
+			want: manifestAvailable,
+		},
+		"configMap": {
+			obj: &v1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "cm"},
+			},
+			want: manifestAvailable,
+		},
+		"untrackable object": {
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Foo",
+				"metadata":   map[string]interface{}{"name": "foo"},
+			}},
+			want: manifestNotTrackable,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := trackResourceAvailability(toUnstructured(t, tt.obj))
+			if err != nil {
+				t.Fatalf("trackResourceAvailability() got error %v, want no error", err)
+			}
+			if got != tt.want {
+				t.Errorf("trackResourceAvailability() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrackResourceAvailability_InvalidObject(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "deploy"},
+		"spec":       map[string]interface{}{"replicas": "three"},
+	}}
+	if _, err := trackResourceAvailability(obj); err == nil {
+		t.Errorf("trackResourceAvailability() got no error, want error")
+	}
+}
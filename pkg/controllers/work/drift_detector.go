@@ -0,0 +1,196 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+var (
+	// driftIgnoredTopLevelFields are the top level fields of a manifest which are never considered as drifted.
+	driftIgnoredTopLevelFields = map[string]bool{
+		"apiVersion": true,
+		"kind":       true,
+		"status":     true,
+	}
+
+	// driftComparedMetadataFields are the only metadata fields of a manifest which can drift.
+	driftComparedMetadataFields = map[string]bool{
+		"labels":      true,
+		"annotations": true,
+	}
+
+	// driftIgnoredAnnotations are the annotations added by the member agent itself.
+	driftIgnoredAnnotations = map[string]bool{
+		fleetv1beta1.ManifestHashAnnotation:      true,
+		fleetv1beta1.LastAppliedConfigAnnotation: true,
+	}
+)
+
+// detectDriftsOnCluster compares the manifest with the live object on the member cluster as detectDrifts does, but
+// first applies the manifest to the live object in server side dry-run mode, so that the values normalized or
+// defaulted by the API server, such as the resource quantities and the defaulted fields of the list entries, are not
+// considered as drifts. It falls back to compare the manifest as it is if the dry-run fails.
+func (r *ApplyWorkReconciler) detectDriftsOnCluster(ctx context.Context, gvr schema.GroupVersionResource,
+	manifestObj, curObj *unstructured.Unstructured) ([]fleetv1beta1.PatchDetail, error) {
+	options := metav1.ApplyOptions{
+		FieldManager: workFieldManagerName,
+		Force:        true,
+		DryRun:       []string{metav1.DryRunAll},
+	}
+	desiredObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestObj.GetNamespace()).Apply(ctx, manifestObj.GetName(), manifestObj, options)
+	if err != nil {
+		klog.V(2).InfoS("failed to apply the manifest in dry-run mode, compare the manifest as it is",
+			"gvr", gvr, "manifest", klog.KObj(manifestObj), "error", err)
+		return detectDrifts(manifestObj, curObj)
+	}
+	return detectDrifts(desiredObj, curObj)
+}
+
+// detectDrifts compares the fields specified in the manifest with the ones in the live object on the member cluster
+// and returns the fields whose values differ, sorted by their paths.
+// Only the fields present in the manifest are compared, so that the fields defaulted or managed by other controllers
+// on the member cluster are not considered as drifts.
+func detectDrifts(manifestObj, curObj *unstructured.Unstructured) ([]fleetv1beta1.PatchDetail, error) {
+	var drifts []fleetv1beta1.PatchDetail
+	for field, hubValue := range manifestObj.Object {
+		if driftIgnoredTopLevelFields[field] {
+			continue
+		}
+		memberValue, exist := curObj.Object[field]
+		if field != "metadata" {
+			if err := compareField("/"+escapeJSONPointer(field), hubValue, memberValue, exist, &drifts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := compareMetadata(hubValue, memberValue, &drifts); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Path < drifts[j].Path
+	})
+	return drifts, nil
+}
+
+// compareMetadata compares the labels and annotations in the metadata of the manifest and the live object.
+func compareMetadata(hubValue, memberValue interface{}, drifts *[]fleetv1beta1.PatchDetail) error {
+	hubMetadata, ok := hubValue.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the metadata of the manifest is of unexpected type %T", hubValue)
+	}
+	memberMetadata, _ := memberValue.(map[string]interface{})
+	for field, hubFieldValue := range hubMetadata {
+		if !driftComparedMetadataFields[field] {
+			continue
+		}
+		hubMap, ok := hubFieldValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		memberMap, _ := memberMetadata[field].(map[string]interface{})
+		path := "/metadata/" + field
+		for key, hubMapValue := range hubMap {
+			if field == "annotations" && driftIgnoredAnnotations[key] {
+				continue
+			}
+			memberMapValue, exist := memberMap[key]
+			if err := compareField(path+"/"+escapeJSONPointer(key), hubMapValue, memberMapValue, exist, drifts); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compareField recursively compares a field in the manifest with the same field in the live object.
+func compareField(path string, hubValue, memberValue interface{}, memberExist bool, drifts *[]fleetv1beta1.PatchDetail) error {
+	if hubValue == nil {
+		// a null field in the manifest does not specify any value
+		return nil
+	}
+	if !memberExist {
+		return addDrift(path, hubValue, nil, false, drifts)
+	}
+	switch hv := hubValue.(type) {
+	case map[string]interface{}:
+		mv, ok := memberValue.(map[string]interface{})
+		if !ok {
+			return addDrift(path, hubValue, memberValue, true, drifts)
+		}
+		for key, hubFieldValue := range hv {
+			memberFieldValue, exist := mv[key]
+			if err := compareField(path+"/"+escapeJSONPointer(key), hubFieldValue, memberFieldValue, exist, drifts); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		mv, ok := memberValue.([]interface{})
+		if !ok || len(mv) != len(hv) {
+			return addDrift(path, hubValue, memberValue, true, drifts)
+		}
+		for i := range hv {
+			if err := compareField(path+"/"+strconv.Itoa(i), hv[i], mv[i], true, drifts); err != nil {
+				return err
+			}
+		}
+	default:
+		if !reflect.DeepEqual(hubValue, memberValue) {
+			return addDrift(path, hubValue, memberValue, true, drifts)
+		}
+	}
+	return nil
+}
+
+func addDrift(path string, hubValue, memberValue interface{}, memberExist bool, drifts *[]fleetv1beta1.PatchDetail) error {
+	drift := fleetv1beta1.PatchDetail{Path: path}
+	var err error
+	if drift.ValueInHub, err = formatFieldValue(hubValue); err != nil {
+		return err
+	}
+	if memberExist {
+		if drift.ValueInMember, err = formatFieldValue(memberValue); err != nil {
+			return err
+		}
+	}
+	*drifts = append(*drifts, drift)
+	return nil
+}
+
+// formatFieldValue formats a scalar field value as is and a composite field value as JSON.
+func formatFieldValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal the field value: %w", err)
+		}
+		return string(data), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// escapeJSONPointer escapes a reference token of a JSON pointer as defined in RFC 6901.
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
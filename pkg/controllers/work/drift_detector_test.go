@@ -0,0 +1,262 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	testingclient "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestDetectDrifts(t *testing.T) {
+	deploymentMeta := metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+	manifest := &appsv1.Deployment{
+		TypeMeta: deploymentMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deploy",
+			Namespace: "app",
+			Labels:    map[string]string{"app": "nginx"},
+			Annotations: map[string]string{
+				fleetv1beta1.ManifestHashAnnotation: "hash",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(3),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "nginx", Image: "nginx:1.25"}},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		curObj *appsv1.Deployment
+		want   []fleetv1beta1.PatchDetail
+	}{
+		"no drifts": {
+			curObj: manifest.DeepCopy(),
+		},
+		"fields not in the manifest are ignored": {
+			curObj: func() *appsv1.Deployment {
+				d := manifest.DeepCopy()
+				d.Generation = 3
+				d.Labels["extra"] = "label"
+				d.Annotations[fleetv1beta1.ManifestHashAnnotation] = "another-hash"
+				d.Spec.MinReadySeconds = 10
+				d.Spec.Template.Spec.Containers[0].ImagePullPolicy = v1.PullAlways
+				d.Status.Replicas = 3
+				return d
+			}(),
+		},
+		"scalar fields drifted": {
+			curObj: func() *appsv1.Deployment {
+				d := manifest.DeepCopy()
+				d.Spec.Replicas = pointer.Int32(5)
+				d.Spec.Template.Spec.Containers[0].Image = "nginx:latest"
+				return d
+			}(),
+			want: []fleetv1beta1.PatchDetail{
+				{
+					Path:          "/spec/replicas",
+					ValueInMember: "5",
+					ValueInHub:    "3",
+				},
+				{
+					Path:          "/spec/template/spec/containers/0/image",
+					ValueInMember: "nginx:latest",
+					ValueInHub:    "nginx:1.25",
+				},
+			},
+		},
+		"labels drifted and removed": {
+			curObj: func() *appsv1.Deployment {
+				d := manifest.DeepCopy()
+				d.Labels = nil
+				return d
+			}(),
+			want: []fleetv1beta1.PatchDetail{
+				{
+					Path:       "/metadata/labels/app",
+					ValueInHub: "nginx",
+				},
+			},
+		},
+		"list length drifted": {
+			curObj: func() *appsv1.Deployment {
+				d := manifest.DeepCopy()
+				d.Spec.Template.Spec.Containers = append(d.Spec.Template.Spec.Containers, v1.Container{Name: "sidecar", Image: "busybox"})
+				return d
+			}(),
+			want: []fleetv1beta1.PatchDetail{
+				{
+					Path:          "/spec/template/spec/containers",
+					ValueInMember: `[{"image":"nginx:1.25","name":"nginx","resources":{}},{"image":"busybox","name":"sidecar","resources":{}}]`,
+					ValueInHub:    `[{"image":"nginx:1.25","name":"nginx","resources":{}}]`,
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := detectDrifts(toUnstructured(t, manifest), toUnstructured(t, tt.curObj))
+			if err != nil {
+				t.Fatalf("detectDrifts() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("detectDrifts() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDetectDrifts_EscapedPath(t *testing.T) {
+	manifest := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name": "cm",
+			"annotations": map[string]interface{}{
+				"example.com/owner": "team-a",
+			},
+		},
+		"data": map[string]interface{}{"a~b": "1"},
+	}}
+	curObj := manifest.DeepCopy()
+	curObj.SetAnnotations(map[string]string{"example.com/owner": "team-b"})
+	if err := unstructured.SetNestedField(curObj.Object, "2", "data", "a~b"); err != nil {
+		t.Fatalf("failed to set the drifted field: %v", err)
+	}
+	want := []fleetv1beta1.PatchDetail{
+		{
+			Path:          "/data/a~0b",
+			ValueInMember: "2",
+			ValueInHub:    "1",
+		},
+		{
+			Path:          "/metadata/annotations/example.com~1owner",
+			ValueInMember: "team-b",
+			ValueInHub:    "team-a",
+		},
+	}
+	got, err := detectDrifts(manifest, curObj)
+	if err != nil {
+		t.Fatalf("detectDrifts() got error %v, want no error", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("detectDrifts() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestDetectDriftsOnCluster(t *testing.T) {
+	manifest := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "pod",
+			"namespace": "app",
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":      "nginx",
+					"image":     "nginx:1.25",
+					"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "1000m"}},
+				},
+			},
+		},
+	}}
+	// the live object has the quantities normalized and the fields of the list entries defaulted by the API server
+	normalizedObj := manifest.DeepCopy()
+	normalizedObj.Object["spec"] = map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{
+				"name":            "nginx",
+				"image":           "nginx:1.25",
+				"imagePullPolicy": "IfNotPresent",
+				"resources":       map[string]interface{}{"limits": map[string]interface{}{"cpu": "1"}},
+			},
+		},
+	}
+	driftedObj := normalizedObj.DeepCopy()
+	if err := unstructured.SetNestedSlice(driftedObj.Object, []interface{}{
+		map[string]interface{}{
+			"name":            "nginx",
+			"image":           "nginx:latest",
+			"imagePullPolicy": "IfNotPresent",
+			"resources":       map[string]interface{}{"limits": map[string]interface{}{"cpu": "1"}},
+		},
+	}, "spec", "containers"); err != nil {
+		t.Fatalf("failed to set the drifted field: %v", err)
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+
+	tests := map[string]struct {
+		curObj     *unstructured.Unstructured
+		dryRunObj  *unstructured.Unstructured
+		dryRunErr  error
+		wantDrifts []fleetv1beta1.PatchDetail
+	}{
+		"normalized and defaulted fields are not drifts": {
+			curObj:    normalizedObj,
+			dryRunObj: normalizedObj,
+		},
+		"drifts are found against the dry-run result": {
+			curObj:    driftedObj,
+			dryRunObj: normalizedObj,
+			wantDrifts: []fleetv1beta1.PatchDetail{
+				{
+					Path:          "/spec/containers/0/image",
+					ValueInMember: "nginx:latest",
+					ValueInHub:    "nginx:1.25",
+				},
+			},
+		},
+		"compare the manifest as it is if the dry-run fails": {
+			curObj:    normalizedObj,
+			dryRunErr: fmt.Errorf("dry-run failed"),
+			wantDrifts: []fleetv1beta1.PatchDetail{
+				{
+					Path:          "/spec/containers/0/resources/limits/cpu",
+					ValueInMember: "1",
+					ValueInHub:    "1000m",
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("patch", "pods", func(action testingclient.Action) (bool, runtime.Object, error) {
+				if tt.dryRunErr != nil {
+					return true, nil, tt.dryRunErr
+				}
+				return true, tt.dryRunObj.DeepCopy(), nil
+			})
+			r := &ApplyWorkReconciler{spokeDynamicClient: dynamicClient}
+			got, err := r.detectDriftsOnCluster(context.Background(), gvr, manifest, tt.curObj)
+			if err != nil {
+				t.Fatalf("detectDriftsOnCluster() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tt.wantDrifts, got); diff != "" {
+				t.Errorf("detectDriftsOnCluster() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
+)
+
+// dryRunRecheckInterval is the interval at which a dry-run work is validated again as the result changes with
+// the state of the cluster.
+const dryRunRecheckInterval = time.Minute * 5
+
+// dryRunResult contains the result of a manifest being validated with dry-run.
+type dryRunResult struct {
+	identifier fleetv1beta1.WorkResourceIdentifier
+	// skipped is true if the manifest cannot be validated because it depends on a namespace or a custom resource
+	// definition in the same work which does not exist on the cluster yet.
+	skipped bool
+	err     error
+}
+
+// dryRunWork validates the manifests of a dry-run work against the cluster with server-side dry-run requests and
+// reports the result in the status of the work.
+// Nothing is persisted on the cluster, so the dry-run work needs neither an appliedWork nor the finalizer.
+func (r *ApplyWorkReconciler) dryRunWork(ctx context.Context, work *fleetv1beta1.Work) (ctrl.Result, error) {
+	logObjRef := klog.KObj(work)
+	results := r.dryRunManifests(ctx, work.Spec.Workload.Manifests, work.Spec.ApplyStrategy)
+
+	manifestConditions := make([]fleetv1beta1.ManifestCondition, len(results))
+	for index, result := range results {
+		manifestConditions[index] = fleetv1beta1.ManifestCondition{
+			Identifier: result.identifier,
+			Conditions: []metav1.Condition{buildManifestDryRunCondition(result, work.Generation)},
+		}
+	}
+	work.Status.ManifestConditions = manifestConditions
+	work.Status.Conditions = []metav1.Condition{generateWorkDryRunCondition(results, work.Generation)}
+	if err := r.client.Status().Update(ctx, work, &client.SubResourceUpdateOptions{}); err != nil {
+		klog.ErrorS(err, "failed to update work status", "work", logObjRef)
+		return ctrl.Result{}, err
+	}
+	klog.V(2).InfoS("validated the work with dry-run", "work", logObjRef,
+		"dryRunSucceeded", meta.IsStatusConditionTrue(work.Status.Conditions, fleetv1beta1.WorkConditionTypeDryRunSucceeded))
+	// a rejected manifest is not retried right away as it stays rejected until the work or the cluster is changed
+	return ctrl.Result{RequeueAfter: dryRunRecheckInterval}, nil
+}
+
+// dryRunManifests validates the manifests against the cluster with server-side dry-run requests in parallel.
+// As nothing is persisted, the manifests whose namespace or custom resource definition is in the same work but
+// does not exist on the cluster yet are skipped.
+func (r *ApplyWorkReconciler) dryRunManifests(ctx context.Context, manifests []fleetv1beta1.Manifest,
+	applyStrategy *fleetv1beta1.ApplyStrategy) []dryRunResult {
+	type decodedManifest struct {
+		index  int
+		gvr    schema.GroupVersionResource
+		rawObj *unstructured.Unstructured
+	}
+	results := make([]dryRunResult, len(manifests))
+	workNamespaces, workKinds := collectWorkNamespacesAndKinds(manifests)
+	decodedManifests := make([]decodedManifest, 0, len(manifests))
+	for index, manifest := range manifests {
+		gvr, rawObj, err := r.decodeManifest(manifest)
+		if err != nil {
+			result := dryRunResult{
+				err: err,
+				identifier: fleetv1beta1.WorkResourceIdentifier{
+					Ordinal: index,
+				},
+			}
+			if rawObj != nil {
+				result.identifier = buildResourceIdentifier(index, rawObj, gvr)
+				// the kind is unknown to the cluster until the custom resource definition in the work is created
+				result.skipped = workKinds[rawObj.GroupVersionKind().GroupKind()]
+			}
+			if result.skipped {
+				result.err = nil
+			}
+			results[index] = result
+			continue
+		}
+		decodedManifests = append(decodedManifests, decodedManifest{index: index, gvr: gvr, rawObj: rawObj})
+	}
+
+	workers := r.applyConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+	parallelizer.NewParallelizer(workers).ParallelizeUntil(ctx, len(decodedManifests), func(piece int) {
+		m := decodedManifests[piece]
+		results[m.index] = r.dryRunManifest(ctx, m.index, m.gvr, m.rawObj, workNamespaces, applyStrategy)
+	}, "dryRunManifests")
+	return results
+}
+
+// dryRunManifest sends a dry-run create request for a manifest which does not exist on the cluster, or otherwise
+// the same patch or server side apply request as the one which applies the manifest, so that the fields not
+// specified in the manifest are kept as they are on the cluster.
+func (r *ApplyWorkReconciler) dryRunManifest(ctx context.Context, index int, gvr schema.GroupVersionResource,
+	rawObj *unstructured.Unstructured, workNamespaces map[string]bool, applyStrategy *fleetv1beta1.ApplyStrategy) dryRunResult {
+	result := dryRunResult{identifier: buildResourceIdentifier(index, rawObj, gvr)}
+	logObjRef := klog.ObjectRef{
+		Name:      result.identifier.Name,
+		Namespace: result.identifier.Namespace,
+	}
+	resourceInterface := r.spokeDynamicClient.Resource(gvr).Namespace(rawObj.GetNamespace())
+	curObj, err := resourceInterface.Get(ctx, rawObj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, result.err = resourceInterface.Create(ctx, rawObj, metav1.CreateOptions{
+			DryRun:       []string{metav1.DryRunAll},
+			FieldManager: workFieldManagerName,
+		})
+	case err != nil:
+		result.err = err
+	default:
+		if result.err = setManifestHashAnnotation(rawObj); result.err == nil {
+			_, _, result.err = r.updateObject(ctx, gvr, rawObj, curObj, applyStrategy, true)
+		}
+	}
+	if result.err != nil && workNamespaces[rawObj.GetNamespace()] && isNamespaceNotFoundError(result.err) {
+		// the namespace is not created until the work is applied
+		result.skipped = true
+		result.err = nil
+	}
+	switch {
+	case result.skipped:
+		klog.V(2).InfoS("skipped the manifest dry-run as its namespace does not exist yet", "gvr", gvr, "manifest", logObjRef)
+	case result.err != nil:
+		klog.V(2).InfoS("manifest dry-run failed", "gvr", gvr, "manifest", logObjRef, "err", result.err)
+	default:
+		klog.V(2).InfoS("manifest dry-run succeeded", "gvr", gvr, "manifest", logObjRef)
+	}
+	return result
+}
+
+// dryRunOption returns the dry-run option of the requests to the cluster.
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// collectWorkNamespacesAndKinds returns the namespaces and the custom resource kinds created by the manifests.
+func collectWorkNamespacesAndKinds(manifests []fleetv1beta1.Manifest) (map[string]bool, map[schema.GroupKind]bool) {
+	namespaces := make(map[string]bool)
+	kinds := make(map[schema.GroupKind]bool)
+	for _, manifest := range manifests {
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(manifest.Raw); err != nil {
+			continue
+		}
+		switch obj.GroupVersionKind().GroupKind() {
+		case namespaceGK:
+			namespaces[obj.GetName()] = true
+		case crdGK:
+			group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+			kinds[schema.GroupKind{Group: group, Kind: kind}] = true
+		}
+	}
+	return namespaces, kinds
+}
+
+// isNamespaceNotFoundError returns true if the request is rejected because the namespace does not exist.
+func isNamespaceNotFoundError(err error) bool {
+	var statusErr apierrors.APIStatus
+	if !apierrors.IsNotFound(err) || !errors.As(err, &statusErr) {
+		return false
+	}
+	details := statusErr.Status().Details
+	return details != nil && details.Kind == "namespaces"
+}
+
+func buildManifestDryRunCondition(result dryRunResult, observedGeneration int64) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: observedGeneration,
+		LastTransitionTime: metav1.Now(),
+		Reason:             fleetv1beta1.ManifestDryRunSucceededReason,
+		Message:            "Manifest is accepted by the cluster with a dry-run request",
+	}
+	switch {
+	case result.err != nil:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = fleetv1beta1.ManifestDryRunFailedReason
+		cond.Message = fmt.Sprintf("Failed to dry-run manifest: %v", result.err)
+	case result.skipped:
+		cond.Reason = fleetv1beta1.ManifestDryRunSkippedReason
+		cond.Message = "Manifest depends on a namespace or a custom resource definition in the work which does not exist on the cluster yet"
+	}
+	return cond
+}
+
+// generateWorkDryRunCondition generates the dry-run status condition for work.
+// If one of the manifests fails the dry-run, the dry-run status condition of the work is false and its message
+// contains the error of the first failed manifest.
+func generateWorkDryRunCondition(results []dryRunResult, observedGeneration int64) metav1.Condition {
+	failed := 0
+	var firstErr string
+	for _, result := range results {
+		if result.err != nil {
+			if failed == 0 {
+				firstErr = fmt.Sprintf("%s %s/%s: %v", result.identifier.Kind, result.identifier.Namespace, result.identifier.Name, result.err)
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             fleetv1beta1.WorkDryRunFailedReason,
+			Message:            fmt.Sprintf("%d of %d manifests failed the dry-run, the first failure is %s", failed, len(results), firstErr),
+			ObservedGeneration: observedGeneration,
+		}
+	}
+	return metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             fleetv1beta1.WorkDryRunSucceededReason,
+		Message:            "All the manifests passed the dry-run",
+		ObservedGeneration: observedGeneration,
+	}
+}
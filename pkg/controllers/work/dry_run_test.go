@@ -0,0 +1,182 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	testingclient "k8s.io/client-go/testing"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestDryRunManifests(t *testing.T) {
+	configMap := waveTestManifest(t, "v1", "ConfigMap", "cm", "")
+	deploy := waveTestManifest(t, "apps/v1", "Deployment", "deploy", "")
+	namespace := waveTestManifest(t, "v1", "Namespace", "app", "")
+	customResource := waveTestManifest(t, "example.com/v1", "Foo", "foo", "")
+	crd := fleetv1beta1.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(
+		`{"apiVersion":"apiextensions.k8s.io/v1","kind":"CustomResourceDefinition","metadata":{"name":"foos.example.com"},` +
+			`"spec":{"group":"example.com","names":{"kind":"Foo","plural":"foos"}}}`)}}
+	existingConfigMap := waveTestObject("v1", "ConfigMap", "")
+	existingConfigMap.SetName("cm")
+	existingConfigMap.SetNamespace("app")
+	namespaceNotFoundReactor := func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+		if action.GetResource().Resource == "namespaces" {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "app")
+	}
+
+	tests := map[string]struct {
+		manifests      []fleetv1beta1.Manifest
+		existingObjs   []runtime.Object
+		applyStrategy  *fleetv1beta1.ApplyStrategy
+		createReactor  testingclient.ReactionFunc
+		wantVerbs      []string
+		wantPatchTypes []types.PatchType
+		wantSkipped    []bool
+		wantErrs       []bool
+		wantIdentifier fleetv1beta1.WorkResourceIdentifier
+	}{
+		"dry-run create the manifest not on the cluster": {
+			manifests:   []fleetv1beta1.Manifest{configMap},
+			wantVerbs:   []string{"get", "create"},
+			wantSkipped: []bool{false},
+			wantErrs:    []bool{false},
+		},
+		"dry-run patch the manifest on the cluster": {
+			manifests:      []fleetv1beta1.Manifest{configMap},
+			existingObjs:   []runtime.Object{existingConfigMap},
+			wantVerbs:      []string{"get", "patch"},
+			wantPatchTypes: []types.PatchType{types.StrategicMergePatchType},
+			wantSkipped:    []bool{false},
+			wantErrs:       []bool{false},
+		},
+		"dry-run server side apply the manifest on the cluster per the apply strategy": {
+			manifests:      []fleetv1beta1.Manifest{configMap},
+			existingObjs:   []runtime.Object{existingConfigMap},
+			applyStrategy:  &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeServerSideApply},
+			wantVerbs:      []string{"get", "patch"},
+			wantPatchTypes: []types.PatchType{types.ApplyPatchType},
+			wantSkipped:    []bool{false},
+			wantErrs:       []bool{false},
+		},
+		"manifest rejected by the cluster": {
+			manifests: []fleetv1beta1.Manifest{deploy},
+			createReactor: func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+				return true, nil, apierrors.NewBadRequest("spec.replicas: Invalid value")
+			},
+			wantVerbs:   []string{"get", "create"},
+			wantSkipped: []bool{false},
+			wantErrs:    []bool{true},
+		},
+		"manifest skipped when its namespace is in the work": {
+			manifests:     []fleetv1beta1.Manifest{namespace, configMap},
+			createReactor: namespaceNotFoundReactor,
+			wantVerbs:     []string{"get", "create", "get", "create"},
+			wantSkipped:   []bool{false, true},
+			wantErrs:      []bool{false, false},
+		},
+		"manifest rejected when its namespace is not in the work": {
+			manifests:     []fleetv1beta1.Manifest{configMap},
+			createReactor: namespaceNotFoundReactor,
+			wantVerbs:     []string{"get", "create"},
+			wantSkipped:   []bool{false},
+			wantErrs:      []bool{true},
+		},
+		"custom resource skipped when its definition is in the work": {
+			manifests:   []fleetv1beta1.Manifest{customResource, crd},
+			wantSkipped: []bool{true, false},
+			wantErrs:    []bool{false, true},
+		},
+		"custom resource rejected when its definition is not in the work": {
+			manifests:   []fleetv1beta1.Manifest{customResource},
+			wantSkipped: []bool{false},
+			wantErrs:    []bool{true},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), tt.existingObjs...)
+			if tt.createReactor != nil {
+				dynamicClient.PrependReactor("create", "*", tt.createReactor)
+			}
+			var gotPatchTypes []types.PatchType
+			dynamicClient.PrependReactor("patch", "*", func(action testingclient.Action) (handled bool, ret runtime.Object, err error) {
+				gotPatchTypes = append(gotPatchTypes, action.(testingclient.PatchAction).GetPatchType())
+				return true, existingConfigMap.DeepCopy(), nil
+			})
+			r := &ApplyWorkReconciler{
+				spokeDynamicClient: dynamicClient,
+				restMapper:         waveTestMapper{},
+			}
+			results := r.dryRunManifests(context.Background(), tt.manifests, tt.applyStrategy)
+			gotSkipped := make([]bool, len(results))
+			gotErrs := make([]bool, len(results))
+			for i, result := range results {
+				gotSkipped[i] = result.skipped
+				gotErrs[i] = result.err != nil
+				if result.identifier.Ordinal != i {
+					t.Errorf("dryRunManifests() identifier ordinal of manifest %d = %d, want %d", i, result.identifier.Ordinal, i)
+				}
+			}
+			if diff := cmp.Diff(tt.wantSkipped, gotSkipped); diff != "" {
+				t.Errorf("dryRunManifests() skipped mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantErrs, gotErrs); diff != "" {
+				t.Errorf("dryRunManifests() errors mismatch (-want, +got):\n%s", diff)
+			}
+			var gotVerbs []string
+			for _, action := range dynamicClient.Actions() {
+				gotVerbs = append(gotVerbs, action.GetVerb())
+			}
+			if diff := cmp.Diff(tt.wantVerbs, gotVerbs); diff != "" {
+				t.Errorf("dryRunManifests() verbs mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPatchTypes, gotPatchTypes); diff != "" {
+				t.Errorf("dryRunManifests() patch types mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkDryRunCondition(t *testing.T) {
+	tests := map[string]struct {
+		results    []dryRunResult
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		"all manifests passed": {
+			results:    []dryRunResult{{}, {skipped: true}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: fleetv1beta1.WorkDryRunSucceededReason,
+		},
+		"some manifests failed": {
+			results:    []dryRunResult{{}, {err: errors.New("rejected")}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: fleetv1beta1.WorkDryRunFailedReason,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := generateWorkDryRunCondition(tt.results, 3)
+			if got.Type != fleetv1beta1.WorkConditionTypeDryRunSucceeded || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.ObservedGeneration != 3 {
+				t.Errorf("generateWorkDryRunCondition() = %+v, want status %s and reason %s at generation 3", got, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
+	"go.goms.io/fleet/pkg/utils/condition"
+)
+
+const (
+	// rolloutGateRecheckInterval is the interval at which the rollout gates are checked again as the result changes
+	// with the state of the application.
+	rolloutGateRecheckInterval = time.Second * 30
+
+	// maxRolloutGateResponseSize is the max size of the response body read from a rollout gate.
+	maxRolloutGateResponseSize = 1024 * 1024
+)
+
+// rolloutGateHTTPClient is the HTTP client which checks the rollout gates. The gates are set on the hub cluster, so
+// the client does not follow the redirects, which could otherwise send the requests to an address not in the gates.
+var rolloutGateHTTPClient = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// checkRolloutGatesOfWork checks the rollout gates of a rollout gate work and reports the result in the status of the work.
+// Nothing is applied to the cluster, so the rollout gate work needs neither an appliedWork nor the finalizer.
+func (r *ApplyWorkReconciler) checkRolloutGatesOfWork(ctx context.Context, work *fleetv1beta1.Work) (ctrl.Result, error) {
+	logObjRef := klog.KObj(work)
+	// the gates are checked in parallel so that a slow gate does not hold the others
+	errs := make([]error, len(work.Spec.RolloutGates))
+	if len(work.Spec.RolloutGates) > 0 {
+		parallelizer.NewParallelizer(len(work.Spec.RolloutGates)).ParallelizeUntil(ctx, len(work.Spec.RolloutGates), func(piece int) {
+			errs[piece] = checkRolloutGate(ctx, &work.Spec.RolloutGates[piece])
+		}, "checkRolloutGates")
+	}
+	cond := generateWorkRolloutGatesCondition(work.Spec.RolloutGates, errs, work.Generation)
+	existingCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed)
+	if len(work.Status.ManifestConditions) == 0 && condition.EqualCondition(existingCond, &cond) && existingCond.Message == cond.Message {
+		klog.V(2).InfoS("the rollout gates result of the work is not changed", "work", logObjRef)
+		return ctrl.Result{RequeueAfter: rolloutGateRecheckInterval}, nil
+	}
+	work.Status.ManifestConditions = nil
+	meta.SetStatusCondition(&work.Status.Conditions, cond)
+	if err := r.client.Status().Update(ctx, work, &client.SubResourceUpdateOptions{}); err != nil {
+		klog.ErrorS(err, "failed to update work status", "work", logObjRef)
+		return ctrl.Result{}, err
+	}
+	klog.V(2).InfoS("checked the rollout gates of the work", "work", logObjRef,
+		"rolloutGatesPassed", meta.IsStatusConditionTrue(work.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed))
+	return ctrl.Result{RequeueAfter: rolloutGateRecheckInterval}, nil
+}
+
+// checkRolloutGate runs a single check of the rollout gate and returns the reason why it fails, if any.
+func checkRolloutGate(ctx context.Context, gate *fleetv1beta1.RolloutGate) error {
+	timeout := time.Duration(fleetv1beta1.DefaultRolloutGateTimeoutSeconds) * time.Second
+	if gate.TimeoutSeconds != nil {
+		timeout = time.Duration(*gate.TimeoutSeconds) * time.Second
+	}
+	cctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	switch {
+	case gate.Type == fleetv1beta1.RolloutGateTypeHTTPProbe && gate.HTTPProbe != nil:
+		_, err := httpGet(cctx, gate.HTTPProbe.URL)
+		return err
+	case gate.Type == fleetv1beta1.RolloutGateTypeMetricsQuery && gate.MetricsQuery != nil:
+		return checkMetricsQuery(cctx, gate.MetricsQuery)
+	default:
+		return fmt.Errorf("unsupported rollout gate type `%s` or missing config", gate.Type)
+	}
+}
+
+// httpGet sends an HTTP GET request and returns the response body if the response status code is in [200, 400).
+// Only the http and https URLs are allowed.
+func httpGet(ctx context.Context, address string) ([]byte, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported URL scheme `%s`, only http and https are allowed", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("the URL `%s` has no host", address)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rolloutGateHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRolloutGateResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("got unexpected status code %d", resp.StatusCode)
+	}
+	return body, nil
+}
+
+// metricsQueryResponse is the response of an instant query of the Prometheus HTTP API.
+type metricsQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// checkMetricsQuery sends the instant query to the metrics server and checks that all the samples in the result
+// are no greater than the threshold.
+func checkMetricsQuery(ctx context.Context, gate *fleetv1beta1.MetricsQueryGate) error {
+	address := strings.TrimSuffix(gate.Address, "/") + "/api/v1/query?query=" + url.QueryEscape(gate.Query)
+	body, err := httpGet(ctx, address)
+	if err != nil {
+		return err
+	}
+	values, err := parseMetricsQueryResponse(body)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("the query returned no data")
+	}
+	threshold := gate.Threshold.AsApproximateFloat64()
+	for _, value := range values {
+		if value > threshold {
+			return fmt.Errorf("the query returned %v which exceeds the threshold %s", value, gate.Threshold.String())
+		}
+	}
+	return nil
+}
+
+// parseMetricsQueryResponse returns the values of the samples in the response of an instant query.
+func parseMetricsQueryResponse(body []byte) ([]float64, error) {
+	var resp metricsQueryResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode the query response: %w", err)
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("the query failed: %s", resp.Error)
+	}
+	// a sample value is a [timestamp, "value"] pair
+	var samples [][]interface{}
+	switch resp.Data.ResultType {
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(resp.Data.Result, &sample); err != nil {
+			return nil, fmt.Errorf("failed to decode the scalar result: %w", err)
+		}
+		samples = append(samples, sample)
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(resp.Data.Result, &vector); err != nil {
+			return nil, fmt.Errorf("failed to decode the vector result: %w", err)
+		}
+		for _, sample := range vector {
+			samples = append(samples, sample.Value)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported query result type `%s`", resp.Data.ResultType)
+	}
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		if len(sample) != 2 {
+			return nil, fmt.Errorf("invalid sample %v", sample)
+		}
+		str, ok := sample[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid sample value %v", sample[1])
+		}
+		value, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample value %s: %w", str, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// generateWorkRolloutGatesCondition generates the rollout gates status condition for work.
+// If some of the rollout gates fail, the rollout gates status condition of the work is false and its message
+// contains the reasons of all the failed gates.
+func generateWorkRolloutGatesCondition(gates []fleetv1beta1.RolloutGate, errs []error, observedGeneration int64) metav1.Condition {
+	var failures []string
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("gate %s: %v", gates[i].Name, err))
+		}
+	}
+	if len(failures) > 0 {
+		return metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             fleetv1beta1.WorkRolloutGatesFailedReason,
+			Message:            fmt.Sprintf("%d of %d rollout gates failed: %s", len(failures), len(gates), strings.Join(failures, "; ")),
+			ObservedGeneration: observedGeneration,
+		}
+	}
+	return metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             fleetv1beta1.WorkRolloutGatesPassedReason,
+		Message:            "All the rollout gates passed",
+		ObservedGeneration: observedGeneration,
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package work
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestCheckRolloutGate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/readyz", http.StatusFound)
+		case "/api/v1/query":
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("query") {
+			case "error_rate":
+				_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"pod":"a"},"value":[1700000000.1,"0.01"]},{"metric":{"pod":"b"},"value":[1700000000.1,"0.2"]}]}}`))
+			case "scalar(error_rate)":
+				_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"0.01"]}}`))
+			case "absent_metric":
+				_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"status":"error","error":"bad query"}`))
+			}
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	metricsQueryGate := func(query, threshold string) *fleetv1beta1.RolloutGate {
+		return &fleetv1beta1.RolloutGate{
+			Name: "error-rate",
+			Type: fleetv1beta1.RolloutGateTypeMetricsQuery,
+			MetricsQuery: &fleetv1beta1.MetricsQueryGate{
+				Address:   server.URL,
+				Query:     query,
+				Threshold: resource.MustParse(threshold),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		gate    *fleetv1beta1.RolloutGate
+		wantErr bool
+	}{
+		"http probe succeeds": {
+			gate: &fleetv1beta1.RolloutGate{
+				Name:      "health",
+				Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+				HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: server.URL + "/healthz"},
+			},
+		},
+		"http probe fails with an error status code": {
+			gate: &fleetv1beta1.RolloutGate{
+				Name:      "health",
+				Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+				HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: server.URL + "/readyz"},
+			},
+			wantErr: true,
+		},
+		"http probe does not follow the redirect": {
+			gate: &fleetv1beta1.RolloutGate{
+				Name:      "health",
+				Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+				HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: server.URL + "/redirect"},
+			},
+		},
+		"http probe fails with an unsupported scheme": {
+			gate: &fleetv1beta1.RolloutGate{
+				Name:      "health",
+				Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+				HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: "file:///etc/hosts"},
+			},
+			wantErr: true,
+		},
+		"all the samples of the vector are under the threshold": {
+			gate: metricsQueryGate("error_rate", "0.5"),
+		},
+		"some of the samples of the vector exceed the threshold": {
+			gate:    metricsQueryGate("error_rate", "0.1"),
+			wantErr: true,
+		},
+		"the scalar is under the threshold": {
+			gate: metricsQueryGate("scalar(error_rate)", "0.05"),
+		},
+		"the query returns no data": {
+			gate:    metricsQueryGate("absent_metric", "1"),
+			wantErr: true,
+		},
+		"the query fails": {
+			gate:    metricsQueryGate("invalid(", "1"),
+			wantErr: true,
+		},
+		"missing config": {
+			gate:    &fleetv1beta1.RolloutGate{Name: "health", Type: fleetv1beta1.RolloutGateTypeHTTPProbe},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := checkRolloutGate(context.Background(), tt.gate); (err != nil) != tt.wantErr {
+				t.Errorf("checkRolloutGate() got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkRolloutGatesCondition(t *testing.T) {
+	gates := []fleetv1beta1.RolloutGate{{Name: "health"}, {Name: "error-rate"}}
+	tests := map[string]struct {
+		errs []error
+		want metav1.Condition
+	}{
+		"all the gates pass": {
+			errs: []error{nil, nil},
+			want: metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionTrue,
+				Reason:             fleetv1beta1.WorkRolloutGatesPassedReason,
+				Message:            "All the rollout gates passed",
+				ObservedGeneration: 2,
+			},
+		},
+		"some of the gates fail": {
+			errs: []error{nil, errors.New("the query returned no data")},
+			want: metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionFalse,
+				Reason:             fleetv1beta1.WorkRolloutGatesFailedReason,
+				Message:            "1 of 2 rollout gates failed: gate error-rate: the query returned no data",
+				ObservedGeneration: 2,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := generateWorkRolloutGatesCondition(gates, tt.errs, 2)
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("generateWorkRolloutGatesCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckRolloutGatesOfWork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	passedCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: lastTransitionTime,
+		Reason:             fleetv1beta1.WorkRolloutGatesPassedReason,
+		Message:            "All the rollout gates passed",
+		ObservedGeneration: 1,
+	}
+	gate := func(name, path string) fleetv1beta1.RolloutGate {
+		return fleetv1beta1.RolloutGate{
+			Name:      name,
+			Type:      fleetv1beta1.RolloutGateTypeHTTPProbe,
+			HTTPProbe: &fleetv1beta1.HTTPProbeGate{URL: server.URL + path},
+		}
+	}
+
+	tests := map[string]struct {
+		gates      []fleetv1beta1.RolloutGate
+		wantUpdate bool
+		wantCond   metav1.Condition
+	}{
+		"the status is not updated if the result is not changed": {
+			gates:    []fleetv1beta1.RolloutGate{gate("health", "/healthz"), gate("health-2", "/healthz")},
+			wantCond: passedCond,
+		},
+		"the condition transitions if the result is changed": {
+			gates:      []fleetv1beta1.RolloutGate{gate("health", "/healthz"), gate("ready", "/readyz")},
+			wantUpdate: true,
+			wantCond: metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionFalse,
+				Reason:             fleetv1beta1.WorkRolloutGatesFailedReason,
+				Message:            "1 of 2 rollout gates failed: gate ready: got unexpected status code 503",
+				ObservedGeneration: 1,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "work", Namespace: "cluster-x", Generation: 1},
+				Spec:       fleetv1beta1.WorkSpec{RolloutGates: tt.gates},
+				Status:     fleetv1beta1.WorkStatus{Conditions: []metav1.Condition{passedCond}},
+			}
+			gotUpdate := false
+			r := &ApplyWorkReconciler{
+				client: &test.MockClient{
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						gotUpdate = true
+						return nil
+					},
+				},
+			}
+			if _, err := r.checkRolloutGatesOfWork(context.Background(), work); err != nil {
+				t.Fatalf("checkRolloutGatesOfWork() got error %v, want no error", err)
+			}
+			if gotUpdate != tt.wantUpdate {
+				t.Errorf("checkRolloutGatesOfWork() updated the status %t, want %t", gotUpdate, tt.wantUpdate)
+			}
+			gotCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed)
+			if diff := cmp.Diff(&tt.wantCond, gotCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("checkRolloutGatesOfWork() condition mismatch (-want, +got):\n%s", diff)
+			}
+			if !tt.wantUpdate && !gotCond.LastTransitionTime.Equal(&lastTransitionTime) {
+				t.Errorf("checkRolloutGatesOfWork() got last transition time %v, want %v", gotCond.LastTransitionTime, lastTransitionTime)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework/parallelizer"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -103,6 +104,7 @@ func createControllers(ctx context.Context, hubCfg, spokeCfg *rest.Config, setup
 		restMapper,
 		hubMgr.GetEventRecorderFor("work_controller"),
 		maxWorkConcurrency,
+		parallelizer.DefaultNumOfWorkers,
 		opts.Namespace,
 	)
 
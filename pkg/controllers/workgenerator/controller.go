@@ -10,22 +10,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"go.uber.org/atomic"
 	"golang.org/x/sync/errgroup"
-	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -48,11 +44,13 @@ import (
 )
 
 const (
-	allWorkSyncedReason  = "AllWorkSynced"
-	syncWorkFailedReason = "SyncWorkFailed"
-	workNeedSyncedReason = "StillNeedToSyncWork"
-	workNotAppliedReason = "NotAllWorkHasBeenApplied"
-	allWorkAppliedReason = "AllWorkHasBeenApplied"
+	allWorkSyncedReason    = "AllWorkSynced"
+	syncWorkFailedReason   = "SyncWorkFailed"
+	workNeedSyncedReason   = "StillNeedToSyncWork"
+	workNotAppliedReason   = "NotAllWorkHasBeenApplied"
+	allWorkAppliedReason   = "AllWorkHasBeenApplied"
+	workNotAvailableReason = "NotAllWorkAreAvailable"
+	allWorkAvailableReason = "AllWorkAreAvailable"
 )
 
 var (
@@ -62,10 +60,13 @@ var (
 
 // Reconciler watches binding objects and generate work objects in the designated cluster namespace
 // according to the information in the binding objects.
-// TODO: incorporate an overriding policy if one exists
 type Reconciler struct {
 	client.Client
-	recorder record.EventRecorder
+	// StripRules contains the fields that we should not propagate for each kind of resources. The resources selected by
+	// the placements are stripped when the resource snapshots are created, while the resources wrapped in the envelopes
+	// are stripped here once they are extracted.
+	StripRules *utils.StripRules
+	recorder   record.EventRecorder
 }
 
 // Reconcile triggers a single binding reconcile round.
@@ -105,9 +106,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	workUpdated := false
 	// list all the corresponding works
 	works, syncErr := r.listAllWorksAssociated(ctx, &resourceBinding)
+	var dryRunErr, rolloutGateErr error
+	var rolloutGateWork *fleetv1beta1.Work
 	if syncErr == nil {
+		// the dry-run work and the rollout gate work are synced separately as they are not placing any resources
+		dryRunWork := extractDryRunWork(works)
+		rolloutGateWork = extractRolloutGateWork(works)
 		// generate and apply the workUpdated works if we have all the works
 		workUpdated, syncErr = r.syncAllWork(ctx, &resourceBinding, works)
+		dryRunErr = r.syncDryRunWork(ctx, &resourceBinding, dryRunWork)
 	}
 
 	if syncErr != nil {
@@ -135,10 +142,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				Message:            "The work needs to be synced first",
 				ObservedGeneration: resourceBinding.Generation,
 			})
+			meta.RemoveStatusCondition(&resourceBinding.Status.Conditions, string(fleetv1beta1.ResourceBindingAvailable))
 		} else {
 			// try to gather the resource binding applied status if we didn't update any associated work spec this time
-			resourceBinding.SetConditions(buildAllWorkAppliedCondition(works, &resourceBinding))
+			appliedCond := buildAllWorkAppliedCondition(works, &resourceBinding)
+			resourceBinding.SetConditions(appliedCond)
+			// only try to gather the available status if all the works are applied
+			if appliedCond.Status == metav1.ConditionTrue {
+				resourceBinding.SetConditions(buildAllWorkAvailableCondition(works, &resourceBinding))
+			} else {
+				meta.RemoveStatusCondition(&resourceBinding.Status.Conditions, string(fleetv1beta1.ResourceBindingAvailable))
+			}
 		}
+		// the rollout gates are only checked once all the resources are available on the target cluster
+		rolloutGateErr = r.syncRolloutGateWork(ctx, &resourceBinding, rolloutGateWork)
 	}
 
 	// update the resource binding status
@@ -155,6 +172,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		// This error can also happen if the user uses a customized rollout controller that does not share the same informer cache with this controller.
 		return ctrl.Result{Requeue: true}, nil
 	}
+	if syncErr == nil && dryRunErr != nil {
+		klog.ErrorS(dryRunErr, "Failed to sync the dry-run work", "resourceBinding", bindingRef)
+		return ctrl.Result{}, dryRunErr
+	}
+	if syncErr == nil && rolloutGateErr != nil {
+		klog.ErrorS(rolloutGateErr, "Failed to sync the rollout gate work", "resourceBinding", bindingRef)
+		return ctrl.Result{}, rolloutGateErr
+	}
 	// requeue if we did an update, or we failed to sync the work
 	return ctrl.Result{Requeue: workUpdated}, syncErr
 }
@@ -238,12 +263,22 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding *fleetv1be
 	resourceBindingRef := klog.KObj(resourceBinding)
 
 	// Gather all the resource resourceSnapshots
-	resourceSnapshots, err := r.fetchAllResourceSnapshots(ctx, resourceBinding)
+	resourceSnapshots, err := r.fetchAllResourceSnapshots(ctx, resourceBinding, resourceBinding.Spec.ResourceSnapshotName)
 	if err != nil {
 		// TODO(RZ): handle errResourceNotFullyCreated error so we don't need to wait for all the snapshots to be created
 		return false, err
 	}
 
+	// Gather all the override snapshots that the rollout controller picked for the target cluster
+	cros, ros, clusterLabels, err := r.fetchOverrideSnapshots(ctx, resourceBinding)
+	if err != nil {
+		return false, err
+	}
+	namespaceLabels, err := collectNamespaceLabels(resourceSnapshots, cros)
+	if err != nil {
+		return false, err
+	}
+
 	// issue all the create/update requests for the corresponding works for each snapshot in parallel
 	activeWork := make(map[string]*fleetv1beta1.Work, len(resourceSnapshots))
 	errs, cctx := errgroup.WithContext(ctx)
@@ -258,17 +293,19 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding *fleetv1be
 		}
 		var simpleManifests []fleetv1beta1.Manifest
 		for _, selectedResource := range snapshot.Spec.SelectedResources {
-			// we need to special treat configMap with envelopeConfigMapAnnotation annotation,
-			// so we need to check the GVK and annotation of the selected resource
-			var uResource unstructured.Unstructured
-			if err := uResource.UnmarshalJSON(selectedResource.Raw); err != nil {
-				klog.ErrorS(err, "work has invalid content", "snapshot", klog.KObj(snapshot), "selectedResource", selectedResource.Raw)
-				return false, controller.NewUnexpectedBehaviorError(err)
+			uResource, err := overrideSelectedResource(&selectedResource, snapshot, resourceBinding, clusterLabels, namespaceLabels, cros, ros)
+			if err != nil {
+				return false, err
 			}
-			if uResource.GetObjectKind().GroupVersionKind() == utils.ConfigMapGVK &&
-				len(uResource.GetAnnotations()[fleetv1beta1.EnvelopeConfigMapAnnotation]) != 0 {
-				// get a work object for the enveloped configMap
-				work, err := r.getConfigMapEnvelopWorkObj(ctx, workNamePrefix, resourceBinding, snapshot, &uResource)
+			// we need to special treat the envelope objects, e.g. configMap with envelopeConfigMapAnnotation annotation,
+			// so we need to check the GVK and annotation of the selected resource
+			if envelopeType, isEnvelope := utils.GetEnvelopeType(uResource); isEnvelope {
+				manifests, err := r.extractEnvelopedManifests(resourceBinding, snapshot, uResource, envelopeType, clusterLabels, namespaceLabels, cros, ros)
+				if err != nil {
+					return false, err
+				}
+				// get a work object for the envelope object
+				work, err := r.getEnvelopeWorkObj(ctx, workNamePrefix, resourceBinding, snapshot, uResource, envelopeType, manifests)
 				if err != nil {
 					return false, err
 				}
@@ -331,18 +368,20 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding *fleetv1be
 	return updateAny.Load(), nil
 }
 
-// fetchAllResourceSnapshots gathers all the resource snapshots for the resource binding.
-func (r *Reconciler) fetchAllResourceSnapshots(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding) (map[string]*fleetv1beta1.ClusterResourceSnapshot, error) {
+// fetchAllResourceSnapshots gathers all the resource snapshots in the index group of the given master resource snapshot
+// for the resource binding.
+func (r *Reconciler) fetchAllResourceSnapshots(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding,
+	masterResourceSnapshotName string) (map[string]*fleetv1beta1.ClusterResourceSnapshot, error) {
 	// fetch the master snapshot first
 	resourceSnapshots := make(map[string]*fleetv1beta1.ClusterResourceSnapshot)
 	masterResourceSnapshot := fleetv1beta1.ClusterResourceSnapshot{}
-	if err := r.Client.Get(ctx, client.ObjectKey{Name: resourceBinding.Spec.ResourceSnapshotName}, &masterResourceSnapshot); err != nil {
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: masterResourceSnapshotName}, &masterResourceSnapshot); err != nil {
 		if apierrors.IsNotFound(err) {
-			klog.V(2).InfoS("The master resource snapshot is deleted", "resourceBinding", klog.KObj(resourceBinding), "resourceSnapshotName", resourceBinding.Spec.ResourceSnapshotName)
+			klog.V(2).InfoS("The master resource snapshot is deleted", "resourceBinding", klog.KObj(resourceBinding), "resourceSnapshotName", masterResourceSnapshotName)
 			return nil, errResourceSnapshotNotFound
 		}
 		klog.ErrorS(err, "Failed to get the resource snapshot from resource masterResourceSnapshot",
-			"resourceBinding", klog.KObj(resourceBinding), "masterResourceSnapshot", resourceBinding.Spec.ResourceSnapshotName)
+			"resourceBinding", klog.KObj(resourceBinding), "masterResourceSnapshot", masterResourceSnapshotName)
 		return nil, controller.NewAPIServerError(true, err)
 	}
 	resourceSnapshots[masterResourceSnapshot.Name] = &masterResourceSnapshot
@@ -387,26 +426,69 @@ func (r *Reconciler) fetchAllResourceSnapshots(ctx context.Context, resourceBind
 	return resourceSnapshots, nil
 }
 
-// getConfigMapEnvelopWorkObj first try to locate a work object for the corresponding envelopObj of type configMap.
-// we create a new one if the work object doesn't exist. We do this to avoid repeatedly delete and create the same work object.
-// TODO: take into consider the override policy in the future
-func (r *Reconciler) getConfigMapEnvelopWorkObj(ctx context.Context, workNamePrefix string, resourceBinding *fleetv1beta1.ClusterResourceBinding,
-	resourceSnapshot *fleetv1beta1.ClusterResourceSnapshot, envelopeObj *unstructured.Unstructured) (*fleetv1beta1.Work, error) {
-	// we group all the resources in one configMap to one work
-	manifest, err := extractResFromConfigMap(envelopeObj)
+// overrideSelectedResource decodes a selected resource in the resource snapshot and applies the overrides picked for
+// the target cluster on it. The raw content of the selected resource is updated if any override is applied.
+// The envelope objects are returned as they are, as the overrides are applied to the resources wrapped in them.
+func overrideSelectedResource(selectedResource *fleetv1beta1.ResourceContent, snapshot *fleetv1beta1.ClusterResourceSnapshot,
+	resourceBinding *fleetv1beta1.ClusterResourceBinding, clusterLabels map[string]string, namespaceLabels map[string]map[string]string,
+	cros []*fleetv1beta1.ClusterResourceOverrideSnapshot, ros []*fleetv1beta1.ResourceOverrideSnapshot) (*unstructured.Unstructured, error) {
+	var uResource unstructured.Unstructured
+	if err := uResource.UnmarshalJSON(selectedResource.Raw); err != nil {
+		klog.ErrorS(err, "work has invalid content", "snapshot", klog.KObj(snapshot), "selectedResource", selectedResource.Raw)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	if _, isEnvelope := utils.GetEnvelopeType(&uResource); isEnvelope {
+		return &uResource, nil
+	}
+	overridden, err := applyOverrides(&uResource, clusterLabels, namespaceLabels, cros, ros)
+	if err != nil {
+		klog.ErrorS(err, "Failed to apply the overrides on the selected resource", "snapshot", klog.KObj(snapshot),
+			"resourceBinding", klog.KObj(resourceBinding), "selectedResource", klog.KObj(&uResource))
+		return nil, controller.NewUserError(err)
+	}
+	if overridden {
+		if selectedResource.Raw, err = uResource.MarshalJSON(); err != nil {
+			klog.ErrorS(err, "Failed to marshal the overridden resource", "snapshot", klog.KObj(snapshot), "selectedResource", klog.KObj(&uResource))
+			return nil, controller.NewUnexpectedBehaviorError(err)
+		}
+	}
+	return &uResource, nil
+}
+
+// extractEnvelopedManifests extracts the resources wrapped in an envelope object of the given type, removes the fields
+// matching the strip rules and applies the overrides picked for the target cluster on them.
+func (r *Reconciler) extractEnvelopedManifests(resourceBinding *fleetv1beta1.ClusterResourceBinding, resourceSnapshot *fleetv1beta1.ClusterResourceSnapshot,
+	envelopeObj *unstructured.Unstructured, envelopeType fleetv1beta1.EnvelopeType, clusterLabels map[string]string, namespaceLabels map[string]map[string]string,
+	cros []*fleetv1beta1.ClusterResourceOverrideSnapshot, ros []*fleetv1beta1.ResourceOverrideSnapshot) ([]fleetv1beta1.Manifest, error) {
+	manifests, err := utils.ExtractResFromEnvelope(envelopeObj, envelopeType)
+	if err == nil {
+		err = stripEnvelopedManifests(manifests, r.StripRules)
+	}
+	if err == nil {
+		err = overrideEnvelopedManifests(manifests, clusterLabels, namespaceLabels, cros, ros)
+	}
 	if err != nil {
-		klog.ErrorS(err, "configMap has invalid content", "snapshot", klog.KObj(resourceSnapshot),
-			"resourceBinding", klog.KObj(resourceBinding), "configMapWrapper", klog.KObj(envelopeObj))
+		klog.ErrorS(err, "envelope object has invalid content", "snapshot", klog.KObj(resourceSnapshot),
+			"resourceBinding", klog.KObj(resourceBinding), "envelopeType", envelopeType, "envelope", klog.KObj(envelopeObj))
 		return nil, controller.NewUserError(err)
 	}
-	klog.V(2).InfoS("Successfully extract the enveloped resources from the configMap", "numOfResources", len(manifest),
-		"snapshot", klog.KObj(resourceSnapshot), "resourceBinding", klog.KObj(resourceBinding), "configMapWrapper", klog.KObj(envelopeObj))
+	klog.V(2).InfoS("Successfully extract the enveloped resources from the envelope object", "numOfResources", len(manifests),
+		"snapshot", klog.KObj(resourceSnapshot), "resourceBinding", klog.KObj(resourceBinding), "envelopeType", envelopeType, "envelope", klog.KObj(envelopeObj))
+	return manifests, nil
+}
+
+// getEnvelopeWorkObj first try to locate a work object for the corresponding envelopObj of the given type.
+// we create a new one if the work object doesn't exist. We do this to avoid repeatedly delete and create the same work object.
+// The manifests are the resources wrapped in the envelopObj, which are expected to have the override policies applied already.
+func (r *Reconciler) getEnvelopeWorkObj(ctx context.Context, workNamePrefix string, resourceBinding *fleetv1beta1.ClusterResourceBinding,
+	resourceSnapshot *fleetv1beta1.ClusterResourceSnapshot, envelopeObj *unstructured.Unstructured, envelopeType fleetv1beta1.EnvelopeType,
+	manifest []fleetv1beta1.Manifest) (*fleetv1beta1.Work, error) {
 	// Try to see if we already have a work represent the same enveloped object for this CRP in the same cluster
 	// The ParentResourceSnapshotIndexLabel can change between snapshots so we have to exclude that label in the match
 	envelopWorkLabelMatcher := client.MatchingLabels{
 		fleetv1beta1.ParentBindingLabel:     resourceBinding.Name,
 		fleetv1beta1.CRPTrackingLabel:       resourceBinding.Labels[fleetv1beta1.CRPTrackingLabel],
-		fleetv1beta1.EnvelopeTypeLabel:      string(fleetv1beta1.ConfigMapEnvelopeType),
+		fleetv1beta1.EnvelopeTypeLabel:      string(envelopeType),
 		fleetv1beta1.EnvelopeNameLabel:      envelopeObj.GetName(),
 		fleetv1beta1.EnvelopeNamespaceLabel: envelopeObj.GetNamespace(),
 	}
@@ -418,7 +500,7 @@ func (r *Reconciler) getConfigMapEnvelopWorkObj(ctx context.Context, workNamePre
 	if len(workList.Items) == 0 {
 		// we limit the CRP name length to be 63 (DNS1123LabelMaxLength) characters,
 		// so we have plenty of characters left to fit into 253 (DNS1123SubdomainMaxLength) characters for a CR
-		workName := fmt.Sprintf(fleetv1beta1.WorkNameWithConfigEnvelopeFmt, workNamePrefix, uuid.NewUUID())
+		workName := fmt.Sprintf(envelopeWorkNameFmt(envelopeType), workNamePrefix, uuid.NewUUID())
 		return &fleetv1beta1.Work{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      workName,
@@ -427,7 +509,7 @@ func (r *Reconciler) getConfigMapEnvelopWorkObj(ctx context.Context, workNamePre
 					fleetv1beta1.ParentBindingLabel:               resourceBinding.Name,
 					fleetv1beta1.CRPTrackingLabel:                 resourceBinding.Labels[fleetv1beta1.CRPTrackingLabel],
 					fleetv1beta1.ParentResourceSnapshotIndexLabel: resourceSnapshot.Labels[fleetv1beta1.ResourceIndexLabel],
-					fleetv1beta1.EnvelopeTypeLabel:                string(fleetv1beta1.ConfigMapEnvelopeType),
+					fleetv1beta1.EnvelopeTypeLabel:                string(envelopeType),
 					fleetv1beta1.EnvelopeNameLabel:                envelopeObj.GetName(),
 					fleetv1beta1.EnvelopeNamespaceLabel:           envelopeObj.GetNamespace(),
 				},
@@ -445,23 +527,27 @@ func (r *Reconciler) getConfigMapEnvelopWorkObj(ctx context.Context, workNamePre
 				Workload: fleetv1beta1.WorkloadTemplate{
 					Manifests: manifest,
 				},
+				ApplyStrategy:  resourceBinding.Spec.ApplyStrategy,
+				DeletionPolicy: resourceBinding.Spec.DeletionPolicy,
 			},
 		}, nil
 	}
 	if len(workList.Items) > 1 {
 		// return error here won't get us out of this
-		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("find %d work representing configMap", len(workList.Items))),
-			"snapshot", klog.KObj(resourceSnapshot), "resourceBinding", klog.KObj(resourceBinding), "configMapWrapper", klog.KObj(envelopeObj))
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("find %d work representing the %s envelope", len(workList.Items), envelopeType)),
+			"snapshot", klog.KObj(resourceSnapshot), "resourceBinding", klog.KObj(resourceBinding), "envelope", klog.KObj(envelopeObj))
 	}
 	// we just pick the first one if there are more than one.
 	work := workList.Items[0]
 	work.Labels[fleetv1beta1.ParentResourceSnapshotIndexLabel] = resourceSnapshot.Labels[fleetv1beta1.ResourceIndexLabel]
 	work.Spec.Workload.Manifests = manifest
+	work.Spec.ApplyStrategy = resourceBinding.Spec.ApplyStrategy
+	work.Spec.DeletionPolicy = resourceBinding.Spec.DeletionPolicy
 	return &work, nil
 }
 
-// generateSnapshotWorkObj generates the work object for the corresponding snapshot
-// TODO: take into consider the override policy in the future
+// generateSnapshotWorkObj generates the work object for the corresponding snapshot.
+// The manifests are expected to have the override policies for the target cluster applied already.
 func generateSnapshotWorkObj(workName string, resourceBinding *fleetv1beta1.ClusterResourceBinding, resourceSnapshot *fleetv1beta1.ClusterResourceSnapshot, manifest []fleetv1beta1.Manifest) *fleetv1beta1.Work {
 	work := &fleetv1beta1.Work{
 		ObjectMeta: metav1.ObjectMeta{
@@ -484,6 +570,8 @@ func generateSnapshotWorkObj(workName string, resourceBinding *fleetv1beta1.Clus
 		},
 	}
 	work.Spec.Workload.Manifests = append(work.Spec.Workload.Manifests, manifest...)
+	work.Spec.ApplyStrategy = resourceBinding.Spec.ApplyStrategy
+	work.Spec.DeletionPolicy = resourceBinding.Spec.DeletionPolicy
 	return work
 }
 
@@ -509,14 +597,19 @@ func (r *Reconciler) upsertWork(ctx context.Context, newWork, existingWork *flee
 	}
 	// we already checked the label in fetchAllResourceSnapShots function so no need to check again
 	resourceIndex, _ := labels.ExtractResourceIndexFromClusterResourceSnapshot(resourceSnapshot)
-	if workResourceIndex == resourceIndex {
-		// no need to do anything if the work is generated from the same resource snapshot group since the resource snapshot is immutable.
+	if workResourceIndex == resourceIndex && areManifestsEqual(existingWork.Spec.Workload.Manifests, newWork.Spec.Workload.Manifests) &&
+		equality.Semantic.DeepEqual(existingWork.Spec.ApplyStrategy, newWork.Spec.ApplyStrategy) &&
+		equality.Semantic.DeepEqual(existingWork.Spec.DeletionPolicy, newWork.Spec.DeletionPolicy) {
+		// no need to do anything if the work is generated from the same resource snapshot group since the resource snapshot is immutable
+		// and neither the override snapshots applied on the resources nor the apply strategy or deletion policy are changed.
 		klog.V(2).InfoS("Work is already associated with the desired resourceSnapshot", "resourceIndex", resourceIndex, "work", workObj, "resourceSnapshot", resourceSnapshotObj)
 		return false, nil
 	}
-	// need to update the existing work, only two possible changes:
+	// need to update the existing work, only four possible changes:
 	existingWork.Labels[fleetv1beta1.ParentResourceSnapshotIndexLabel] = resourceSnapshot.Labels[fleetv1beta1.ResourceIndexLabel]
 	existingWork.Spec.Workload.Manifests = newWork.Spec.Workload.Manifests
+	existingWork.Spec.ApplyStrategy = newWork.Spec.ApplyStrategy
+	existingWork.Spec.DeletionPolicy = newWork.Spec.DeletionPolicy
 	if err := r.Client.Update(ctx, existingWork); err != nil {
 		klog.ErrorS(err, "Failed to update the work associated with the resourceSnapshot", "resourceSnapshot", resourceSnapshotObj, "work", workObj)
 		return true, controller.NewUpdateIgnoreConflictError(err)
@@ -574,31 +667,75 @@ func buildAllWorkAppliedCondition(works map[string]*fleetv1beta1.Work, binding *
 	}
 }
 
-func extractResFromConfigMap(uConfigMap *unstructured.Unstructured) ([]fleetv1beta1.Manifest, error) {
-	manifests := make([]fleetv1beta1.Manifest, 0)
-	var configMap v1.ConfigMap
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(uConfigMap.Object, &configMap)
-	if err != nil {
-		return nil, err
+// buildAllWorkAvailableCondition builds the available condition of the binding based on the available conditions
+// of its works. The binding is available with the WorkNotTrackable reason if all the works are available but
+// the availability of some of them cannot be tracked.
+func buildAllWorkAvailableCondition(works map[string]*fleetv1beta1.Work, binding *fleetv1beta1.ClusterResourceBinding) metav1.Condition {
+	allAvailable := true
+	var notAvailableWork, notTrackableWork string
+	for _, work := range works {
+		availableCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+		if !condition.IsConditionStatusTrue(availableCond, work.GetGeneration()) {
+			allAvailable = false
+			notAvailableWork = work.Name
+			break
+		}
+		if availableCond.Reason == fleetv1beta1.WorkNotTrackableReason {
+			notTrackableWork = work.Name
+		}
 	}
-	// the list order is not stable as the map traverse is random
-	for _, value := range configMap.Data {
-		content, jsonErr := yaml.ToJSON([]byte(value))
-		if jsonErr != nil {
-			return nil, jsonErr
+	if allAvailable {
+		klog.V(2).InfoS("All works associated with the binding are available", "binding", klog.KObj(binding), "notTrackableWork", notTrackableWork)
+		reason := allWorkAvailableReason
+		if notTrackableWork != "" {
+			reason = fleetv1beta1.WorkNotTrackableReason
+		}
+		return metav1.Condition{
+			Status:             metav1.ConditionTrue,
+			Type:               string(fleetv1beta1.ResourceBindingAvailable),
+			Reason:             reason,
+			ObservedGeneration: binding.GetGeneration(),
 		}
-		manifests = append(manifests, fleetv1beta1.Manifest{
-			RawExtension: runtime.RawExtension{Raw: content},
-		})
 	}
-	// stable sort the manifests so that we can have a deterministic order
-	sort.Slice(manifests, func(i, j int) bool {
-		obj1 := manifests[i].Raw
-		obj2 := manifests[j].Raw
-		// order by its json formatted string
-		return strings.Compare(string(obj1), string(obj2)) > 0
-	})
-	return manifests, nil
+	return metav1.Condition{
+		Status:             metav1.ConditionFalse,
+		Type:               string(fleetv1beta1.ResourceBindingAvailable),
+		Reason:             workNotAvailableReason,
+		Message:            fmt.Sprintf("work object %s is not available", notAvailableWork),
+		ObservedGeneration: binding.GetGeneration(),
+	}
+}
+
+// envelopeWorkNameFmt returns the format of the name of a work generated with the given type of envelope object.
+func envelopeWorkNameFmt(envelopeType fleetv1beta1.EnvelopeType) string {
+	switch envelopeType {
+	case fleetv1beta1.SecretEnvelopeType:
+		return fleetv1beta1.WorkNameWithSecretEnvelopeFmt
+	case fleetv1beta1.ClusterResourceEnvelopeType, fleetv1beta1.ResourceEnvelopeType:
+		return fleetv1beta1.WorkNameWithResourceEnvelopeFmt
+	default:
+		return fleetv1beta1.WorkNameWithConfigEnvelopeFmt
+	}
+}
+
+// stripEnvelopedManifests removes the fields matching the strip rules from the manifests extracted from an envelope.
+func stripEnvelopedManifests(manifests []fleetv1beta1.Manifest, stripRules *utils.StripRules) error {
+	if stripRules == nil {
+		return nil
+	}
+	for i := range manifests {
+		var uResource unstructured.Unstructured
+		if err := uResource.UnmarshalJSON(manifests[i].Raw); err != nil {
+			return fmt.Errorf("failed to unmarshal the enveloped resource: %w", err)
+		}
+		stripRules.Apply(&uResource)
+		content, err := uResource.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal the enveloped resource %s: %w", klog.KObj(&uResource), err)
+		}
+		manifests[i].Raw = content
+	}
+	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -656,10 +793,22 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 				}
 				oldAppliedStatus := meta.FindStatusCondition(oldWork.Status.Conditions, fleetv1beta1.WorkConditionTypeApplied)
 				newAppliedStatus := meta.FindStatusCondition(newWork.Status.Conditions, fleetv1beta1.WorkConditionTypeApplied)
-				// we only need to handle the case the applied condition is flipped between true and NOT true between the
-				// new and old work objects. Otherwise, it won't affect the binding applied condition
-				if condition.IsConditionStatusTrue(oldAppliedStatus, oldWork.GetGeneration()) == condition.IsConditionStatusTrue(newAppliedStatus, newWork.GetGeneration()) {
-					klog.V(2).InfoS("The work applied condition didn't flip between true and false, no need to reconcile", "oldWork", klog.KObj(oldWork), "newWork", klog.KObj(newWork))
+				oldAvailableStatus := meta.FindStatusCondition(oldWork.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+				newAvailableStatus := meta.FindStatusCondition(newWork.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+				oldDryRunStatus := meta.FindStatusCondition(oldWork.Status.Conditions, fleetv1beta1.WorkConditionTypeDryRunSucceeded)
+				newDryRunStatus := meta.FindStatusCondition(newWork.Status.Conditions, fleetv1beta1.WorkConditionTypeDryRunSucceeded)
+				oldRolloutGatesStatus := meta.FindStatusCondition(oldWork.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed)
+				newRolloutGatesStatus := meta.FindStatusCondition(newWork.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed)
+				// we only need to handle the case the applied or available condition is flipped between true and NOT true between the
+				// new and old work objects, or the dry-run or rollout gates condition is flipped between true, false and unknown.
+				// Otherwise, it won't affect the binding applied, available, dry-run or rollout gates condition
+				if condition.IsConditionStatusTrue(oldAppliedStatus, oldWork.GetGeneration()) == condition.IsConditionStatusTrue(newAppliedStatus, newWork.GetGeneration()) &&
+					condition.IsConditionStatusTrue(oldAvailableStatus, oldWork.GetGeneration()) == condition.IsConditionStatusTrue(newAvailableStatus, newWork.GetGeneration()) &&
+					condition.IsConditionStatusTrue(oldDryRunStatus, oldWork.GetGeneration()) == condition.IsConditionStatusTrue(newDryRunStatus, newWork.GetGeneration()) &&
+					condition.IsConditionStatusFalse(oldDryRunStatus, oldWork.GetGeneration()) == condition.IsConditionStatusFalse(newDryRunStatus, newWork.GetGeneration()) &&
+					condition.IsConditionStatusTrue(oldRolloutGatesStatus, oldWork.GetGeneration()) == condition.IsConditionStatusTrue(newRolloutGatesStatus, newWork.GetGeneration()) &&
+					condition.IsConditionStatusFalse(oldRolloutGatesStatus, oldWork.GetGeneration()) == condition.IsConditionStatusFalse(newRolloutGatesStatus, newWork.GetGeneration()) {
+					klog.V(2).InfoS("The work applied or available condition didn't flip between true and false, no need to reconcile", "oldWork", klog.KObj(oldWork), "newWork", klog.KObj(newWork))
 					return
 				}
 				klog.V(2).InfoS("Received a work update event", "work", klog.KObj(newWork), "parentBindingName", parentBindingName)
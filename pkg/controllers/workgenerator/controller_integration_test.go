@@ -323,6 +323,12 @@ var _ = Describe("Test Work Generator Controller", func() {
 							Reason:             allWorkAppliedReason,
 							ObservedGeneration: binding.GetGeneration(),
 						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							Reason:             allWorkAvailableReason,
+							ObservedGeneration: binding.GetGeneration(),
+						},
 					},
 				}
 				Eventually(func() string {
@@ -757,6 +763,12 @@ var _ = Describe("Test Work Generator Controller", func() {
 							Reason:             allWorkAppliedReason,
 							ObservedGeneration: binding.GetGeneration(),
 						},
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAvailable),
+							Status:             metav1.ConditionTrue,
+							Reason:             allWorkAvailableReason,
+							ObservedGeneration: binding.GetGeneration(),
+						},
 					},
 				}
 				Eventually(func() string {
@@ -1037,7 +1049,15 @@ func markWorkApplied(work *fleetv1beta1.Work) {
 			ObservedGeneration: work.Generation,
 			LastTransitionTime: metav1.Now(),
 		},
+		{
+			Status:             metav1.ConditionTrue,
+			Type:               fleetv1beta1.WorkConditionTypeAvailable,
+			Reason:             fleetv1beta1.WorkAvailableReason,
+			Message:            "fake available manifest",
+			ObservedGeneration: work.Generation,
+			LastTransitionTime: metav1.Now(),
+		},
 	}
 	Expect(k8sClient.Status().Update(ctx, work)).Should(Succeed())
-	By(fmt.Sprintf("resource work `%s` is marked as applied", work.Name))
+	By(fmt.Sprintf("resource work `%s` is marked as applied and available", work.Name))
 }
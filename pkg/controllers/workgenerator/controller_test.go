@@ -12,8 +12,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
 	"go.goms.io/fleet/pkg/utils/controller"
 )
 
@@ -299,3 +301,243 @@ func TestBuildAllWorkAppliedCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAllWorkAvailableCondition(t *testing.T) {
+	tests := map[string]struct {
+		works      map[string]*fleetv1beta1.Work
+		generation int64
+		want       metav1.Condition
+	}{
+		"available should be true if all work are available": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work1",
+						Generation: 123,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 123,
+								Reason:             fleetv1beta1.WorkAvailableReason,
+							},
+						},
+					},
+				},
+				"work2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work2",
+						Generation: 12,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 12,
+								Reason:             fleetv1beta1.WorkAvailableReason,
+							},
+						},
+					},
+				},
+			},
+			generation: 1,
+			want: metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Reason:             allWorkAvailableReason,
+				ObservedGeneration: 1,
+			},
+		},
+		"available should be true with the not trackable reason if some work are not trackable": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work1",
+						Generation: 123,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 123,
+								Reason:             fleetv1beta1.WorkAvailableReason,
+							},
+						},
+					},
+				},
+				"work2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work2",
+						Generation: 12,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 12,
+								Reason:             fleetv1beta1.WorkNotTrackableReason,
+							},
+						},
+					},
+				},
+			},
+			generation: 1,
+			want: metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Reason:             fleetv1beta1.WorkNotTrackableReason,
+				ObservedGeneration: 1,
+			},
+		},
+		"available should be false if some work are not available yet": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work1",
+						Generation: 123,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionFalse,
+								ObservedGeneration: 123,
+								Reason:             fleetv1beta1.WorkNotAvailableYetReason,
+							},
+						},
+					},
+				},
+			},
+			generation: 1,
+			want: metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Reason:             workNotAvailableReason,
+				ObservedGeneration: 1,
+			},
+		},
+		"available should be false if some work are available for a previous generation": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work1",
+						Generation: 123,
+					},
+					Status: fleetv1beta1.WorkStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               fleetv1beta1.WorkConditionTypeAvailable,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 122, // not the latest generation
+								Reason:             fleetv1beta1.WorkAvailableReason,
+							},
+						},
+					},
+				},
+			},
+			generation: 1,
+			want: metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Reason:             workNotAvailableReason,
+				ObservedGeneration: 1,
+			},
+		},
+		"available should be false if some work have no available condition": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work1",
+						Generation: 123,
+					},
+				},
+			},
+			generation: 1,
+			want: metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingAvailable),
+				Reason:             workNotAvailableReason,
+				ObservedGeneration: 1,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test",
+					Generation: tt.generation,
+				},
+			}
+			got := buildAllWorkAvailableCondition(tt.works, binding)
+			if diff := cmp.Diff(got, tt.want, ignoreConditionOption); diff != "" {
+				t.Errorf("buildAllWorkAvailableCondition test `%s` mismatch (-got +want):\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestStripEnvelopedManifests(t *testing.T) {
+	pvcManifest := `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"data","namespace":"app"},"spec":{"storageClassName":"default","volumeName":"pvc-1234"}}`
+	strippedPVCManifest := `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"data","namespace":"app"},"spec":{"storageClassName":"default"}}`
+	configMapManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"app"}}`
+	stripRules, err := utils.NewStripRules([]utils.StripRule{
+		{
+			Version: "v1",
+			Kind:    "PersistentVolumeClaim",
+			Paths:   []string{"/spec/volumeName"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewStripRules() failed: %v", err)
+	}
+	tests := map[string]struct {
+		stripRules *utils.StripRules
+		manifests  []string
+		want       []string
+		wantErr    bool
+	}{
+		"strip the enveloped resources": {
+			stripRules: stripRules,
+			manifests:  []string{pvcManifest, configMapManifest},
+			// the manifests are encoded again once they are stripped
+			want: []string{strippedPVCManifest + "\n", configMapManifest + "\n"},
+		},
+		"no strip rules": {
+			manifests: []string{pvcManifest, configMapManifest},
+			want:      []string{pvcManifest, configMapManifest},
+		},
+		"invalid enveloped resource": {
+			stripRules: stripRules,
+			manifests:  []string{`{"kind":`},
+			wantErr:    true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			manifests := make([]fleetv1beta1.Manifest, len(tt.manifests))
+			for i := range tt.manifests {
+				manifests[i] = fleetv1beta1.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(tt.manifests[i])}}
+			}
+			err := stripEnvelopedManifests(manifests, tt.stripRules)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("stripEnvelopedManifests() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := make([]string, len(manifests))
+			for i := range manifests {
+				got[i] = string(manifests[i].Raw)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("stripEnvelopedManifests() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
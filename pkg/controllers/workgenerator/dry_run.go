@@ -0,0 +1,211 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/controller"
+)
+
+const (
+	dryRunPendingReason        = "DryRunPending"
+	dryRunSucceededReason      = "DryRunSucceeded"
+	dryRunFailedReason         = "DryRunFailed"
+	syncDryRunWorkFailedReason = "SyncDryRunWorkFailed"
+)
+
+// extractDryRunWork removes the dry-run work from the works associated with a binding and returns it.
+func extractDryRunWork(works map[string]*fleetv1beta1.Work) *fleetv1beta1.Work {
+	for name, work := range works {
+		if work.Spec.DryRun {
+			delete(works, name)
+			return work
+		}
+	}
+	return nil
+}
+
+// syncDryRunWork generates the dry-run work with all the resources in the resource snapshot that the binding is going
+// to be rolled to, so that the member agent validates them against the target cluster before the rollout, and reports
+// the dry-run result in the DryRunSucceeded condition of the binding.
+// The dry-run work is deleted once the binding is not waiting for a dry-run anymore.
+func (r *Reconciler) syncDryRunWork(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding, existingWork *fleetv1beta1.Work) error {
+	dryRunSnapshotName := resourceBinding.Spec.DryRunResourceSnapshotName
+	if resourceBinding.Spec.State != fleetv1beta1.BindingStateBound || dryRunSnapshotName == "" ||
+		dryRunSnapshotName == resourceBinding.Spec.ResourceSnapshotName {
+		meta.RemoveStatusCondition(&resourceBinding.Status.Conditions, string(fleetv1beta1.ResourceBindingDryRunSucceeded))
+		if existingWork == nil {
+			return nil
+		}
+		if err := r.Client.Delete(ctx, existingWork); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the no longer needed dry-run work", "work", klog.KObj(existingWork))
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the dry-run work as the binding is not waiting for a dry-run", "work", klog.KObj(existingWork))
+		return nil
+	}
+
+	manifests, masterSnapshot, err := r.buildDryRunManifests(ctx, resourceBinding)
+	if err != nil {
+		resourceBinding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionFalse,
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Reason:             syncDryRunWorkFailedReason,
+			Message:            err.Error(),
+			ObservedGeneration: resourceBinding.Generation,
+		})
+		if errors.Is(err, errResourceSnapshotNotFound) {
+			// the resource snapshot will not come back, and the rollout controller will ask for another dry-run
+			// when there is a newer resource snapshot
+			return nil
+		}
+		return err
+	}
+	newWork := generateDryRunWorkObj(resourceBinding, masterSnapshot, manifests)
+	updated, err := r.upsertWork(ctx, newWork, existingWork, masterSnapshot)
+	if err != nil {
+		return err
+	}
+	if updated {
+		resourceBinding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionUnknown,
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Reason:             dryRunPendingReason,
+			Message:            "The dry-run work needs to be validated by the member agent first",
+			ObservedGeneration: resourceBinding.Generation,
+		})
+		return nil
+	}
+	resourceBinding.SetConditions(buildDryRunCondition(existingWork, resourceBinding))
+	return nil
+}
+
+// buildDryRunManifests returns the manifests of all the resources in the resource snapshot that the binding is going
+// to be rolled to, with the overrides picked for the target cluster applied and the enveloped resources extracted,
+// together with the master resource snapshot.
+func (r *Reconciler) buildDryRunManifests(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding) (
+	[]fleetv1beta1.Manifest, *fleetv1beta1.ClusterResourceSnapshot, error) {
+	dryRunSnapshotName := resourceBinding.Spec.DryRunResourceSnapshotName
+	resourceSnapshots, err := r.fetchAllResourceSnapshots(ctx, resourceBinding, dryRunSnapshotName)
+	if err != nil {
+		return nil, nil, err
+	}
+	cros, ros, clusterLabels, err := r.fetchOverrideSnapshots(ctx, resourceBinding)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespaceLabels, err := collectNamespaceLabels(resourceSnapshots, cros)
+	if err != nil {
+		return nil, nil, err
+	}
+	// keep the order of the manifests stable so that the dry-run work is not updated needlessly
+	snapshotNames := make([]string, 0, len(resourceSnapshots))
+	for name := range resourceSnapshots {
+		snapshotNames = append(snapshotNames, name)
+	}
+	sort.Strings(snapshotNames)
+	var manifests []fleetv1beta1.Manifest
+	for _, name := range snapshotNames {
+		snapshot := resourceSnapshots[name]
+		for _, selectedResource := range snapshot.Spec.SelectedResources {
+			uResource, err := overrideSelectedResource(&selectedResource, snapshot, resourceBinding, clusterLabels, namespaceLabels, cros, ros)
+			if err != nil {
+				return nil, nil, err
+			}
+			envelopeType, isEnvelope := utils.GetEnvelopeType(uResource)
+			if !isEnvelope {
+				manifests = append(manifests, fleetv1beta1.Manifest(selectedResource))
+				continue
+			}
+			envelopedManifests, err := r.extractEnvelopedManifests(resourceBinding, snapshot, uResource, envelopeType, clusterLabels, namespaceLabels, cros, ros)
+			if err != nil {
+				return nil, nil, err
+			}
+			manifests = append(manifests, envelopedManifests...)
+		}
+	}
+	return manifests, resourceSnapshots[dryRunSnapshotName], nil
+}
+
+// generateDryRunWorkObj generates the dry-run work object for the binding.
+func generateDryRunWorkObj(resourceBinding *fleetv1beta1.ClusterResourceBinding, masterSnapshot *fleetv1beta1.ClusterResourceSnapshot,
+	manifests []fleetv1beta1.Manifest) *fleetv1beta1.Work {
+	crpName := resourceBinding.Labels[fleetv1beta1.CRPTrackingLabel]
+	return &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(fleetv1beta1.DryRunWorkNameFmt, crpName),
+			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, resourceBinding.Spec.TargetCluster),
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel:               resourceBinding.Name,
+				fleetv1beta1.CRPTrackingLabel:                 crpName,
+				fleetv1beta1.ParentResourceSnapshotIndexLabel: masterSnapshot.Labels[fleetv1beta1.ResourceIndexLabel],
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         fleetv1beta1.GroupVersion.String(),
+					Kind:               resourceBinding.Kind,
+					Name:               resourceBinding.Name,
+					UID:                resourceBinding.UID,
+					BlockOwnerDeletion: pointer.Bool(true), // make sure that the k8s will call work delete when the binding is deleted
+				},
+			},
+		},
+		Spec: fleetv1beta1.WorkSpec{
+			Workload: fleetv1beta1.WorkloadTemplate{
+				Manifests: manifests,
+			},
+			ApplyStrategy:  resourceBinding.Spec.ApplyStrategy,
+			DeletionPolicy: resourceBinding.Spec.DeletionPolicy,
+			DryRun:         true,
+		},
+	}
+}
+
+// buildDryRunCondition builds the DryRunSucceeded condition of the binding from the status of its dry-run work.
+func buildDryRunCondition(work *fleetv1beta1.Work, binding *fleetv1beta1.ClusterResourceBinding) metav1.Condition {
+	dryRunCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeDryRunSucceeded)
+	switch {
+	case dryRunCond == nil || dryRunCond.ObservedGeneration != work.GetGeneration():
+		klog.V(2).InfoS("The dry-run work is not validated yet", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionUnknown,
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Reason:             dryRunPendingReason,
+			Message:            fmt.Sprintf("The dry-run work %s is not validated by the member agent yet", work.Name),
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	case dryRunCond.Status == metav1.ConditionTrue:
+		klog.V(2).InfoS("The dry-run work succeeded", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionTrue,
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Reason:             dryRunSucceededReason,
+			Message:            "The target cluster accepts all the resources with dry-run requests",
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	default:
+		klog.V(2).InfoS("The dry-run work failed", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionFalse,
+			Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+			Reason:             dryRunFailedReason,
+			Message:            fmt.Sprintf("The dry-run work %s failed: %s", work.Name, dryRunCond.Message),
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestExtractDryRunWork(t *testing.T) {
+	work := &fleetv1beta1.Work{ObjectMeta: metav1.ObjectMeta{Name: "placement-work"}}
+	dryRunWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: "placement-dryrun"},
+		Spec:       fleetv1beta1.WorkSpec{DryRun: true},
+	}
+	tests := map[string]struct {
+		works      map[string]*fleetv1beta1.Work
+		wantWork   *fleetv1beta1.Work
+		wantRemain []string
+	}{
+		"the dry-run work is extracted": {
+			works:      map[string]*fleetv1beta1.Work{work.Name: work, dryRunWork.Name: dryRunWork},
+			wantWork:   dryRunWork,
+			wantRemain: []string{work.Name},
+		},
+		"there is no dry-run work": {
+			works:      map[string]*fleetv1beta1.Work{work.Name: work},
+			wantRemain: []string{work.Name},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := extractDryRunWork(tt.works); got != tt.wantWork {
+				t.Errorf("extractDryRunWork() = %v, want %v", got, tt.wantWork)
+			}
+			var gotRemain []string
+			for name := range tt.works {
+				gotRemain = append(gotRemain, name)
+			}
+			if diff := cmp.Diff(tt.wantRemain, gotRemain); diff != "" {
+				t.Errorf("extractDryRunWork() remaining works mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildDryRunCondition(t *testing.T) {
+	binding := &fleetv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding", Generation: 3},
+	}
+	tests := map[string]struct {
+		workCond *metav1.Condition
+		want     metav1.Condition
+	}{
+		"the dry-run work is not validated yet": {
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+				Reason:             dryRunPendingReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the dry-run work is validated with an old generation": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+				Reason:             dryRunPendingReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the dry-run work succeeded": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 2,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+				Reason:             dryRunSucceededReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the dry-run work failed": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeDryRunSucceeded,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingDryRunSucceeded),
+				Reason:             dryRunFailedReason,
+				ObservedGeneration: 3,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement-dryrun", Generation: 2},
+			}
+			if tt.workCond != nil {
+				work.Status.Conditions = []metav1.Condition{*tt.workCond}
+			}
+			got := buildDryRunCondition(work, binding)
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(metav1.Condition{}, "Message")); diff != "" {
+				t.Errorf("buildDryRunCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/controller"
+	"go.goms.io/fleet/pkg/utils/overrider"
+)
+
+// fetchOverrideSnapshots fetches all the clusterResourceOverrideSnapshots and resourceOverrideSnapshots
+// recorded on the binding, together with the labels of the target cluster so that we can decide which override rules
+// apply to the resources placed on the target cluster.
+func (r *Reconciler) fetchOverrideSnapshots(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding) (
+	[]*fleetv1beta1.ClusterResourceOverrideSnapshot, []*fleetv1beta1.ResourceOverrideSnapshot, map[string]string, error) {
+	if len(resourceBinding.Spec.ClusterResourceOverrideSnapshots) == 0 && len(resourceBinding.Spec.ResourceOverrideSnapshots) == 0 {
+		return nil, nil, nil, nil
+	}
+	cros := make([]*fleetv1beta1.ClusterResourceOverrideSnapshot, 0, len(resourceBinding.Spec.ClusterResourceOverrideSnapshots))
+	for _, name := range resourceBinding.Spec.ClusterResourceOverrideSnapshots {
+		snapshot := &fleetv1beta1.ClusterResourceOverrideSnapshot{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: name}, snapshot); err != nil {
+			if apierrors.IsNotFound(err) {
+				// the rollout controller will update the binding to point to the latest override snapshots
+				klog.V(2).InfoS("The clusterResourceOverrideSnapshot is deleted", "resourceBinding", klog.KObj(resourceBinding), "clusterResourceOverrideSnapshot", name)
+				return nil, nil, nil, controller.NewExpectedBehaviorError(fmt.Errorf("clusterResourceOverrideSnapshot %s is not found", name))
+			}
+			klog.ErrorS(err, "Failed to get the clusterResourceOverrideSnapshot", "resourceBinding", klog.KObj(resourceBinding), "clusterResourceOverrideSnapshot", name)
+			return nil, nil, nil, controller.NewAPIServerError(true, err)
+		}
+		cros = append(cros, snapshot)
+	}
+	ros := make([]*fleetv1beta1.ResourceOverrideSnapshot, 0, len(resourceBinding.Spec.ResourceOverrideSnapshots))
+	for _, name := range resourceBinding.Spec.ResourceOverrideSnapshots {
+		snapshot := &fleetv1beta1.ResourceOverrideSnapshot{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: name.Name, Namespace: name.Namespace}, snapshot); err != nil {
+			if apierrors.IsNotFound(err) {
+				klog.V(2).InfoS("The resourceOverrideSnapshot is deleted", "resourceBinding", klog.KObj(resourceBinding), "resourceOverrideSnapshot", name)
+				return nil, nil, nil, controller.NewExpectedBehaviorError(fmt.Errorf("resourceOverrideSnapshot %s/%s is not found", name.Namespace, name.Name))
+			}
+			klog.ErrorS(err, "Failed to get the resourceOverrideSnapshot", "resourceBinding", klog.KObj(resourceBinding), "resourceOverrideSnapshot", name)
+			return nil, nil, nil, controller.NewAPIServerError(true, err)
+		}
+		ros = append(ros, snapshot)
+	}
+	clusterLabels, err := overrider.FetchClusterLabels(ctx, r.Client, resourceBinding.Spec.TargetCluster)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	klog.V(2).InfoS("Found the override snapshots of the binding", "resourceBinding", klog.KObj(resourceBinding),
+		"numberOfClusterResourceOverrides", len(cros), "numberOfResourceOverrides", len(ros))
+	return cros, ros, clusterLabels, nil
+}
+
+// collectNamespaceLabels returns the labels of the namespaces selected in the resource snapshots keyed by their names,
+// so that the clusterResourceOverrides can select the resources in the namespaces by the namespace labels.
+func collectNamespaceLabels(resourceSnapshots map[string]*fleetv1beta1.ClusterResourceSnapshot,
+	cros []*fleetv1beta1.ClusterResourceOverrideSnapshot) (map[string]map[string]string, error) {
+	if len(cros) == 0 {
+		return nil, nil
+	}
+	namespaceLabels := make(map[string]map[string]string)
+	for _, snapshot := range resourceSnapshots {
+		for _, selectedResource := range snapshot.Spec.SelectedResources {
+			var uResource unstructured.Unstructured
+			if err := uResource.UnmarshalJSON(selectedResource.Raw); err != nil {
+				klog.ErrorS(err, "work has invalid content", "snapshot", klog.KObj(snapshot), "selectedResource", selectedResource.Raw)
+				return nil, controller.NewUnexpectedBehaviorError(err)
+			}
+			if uResource.GroupVersionKind() == utils.NamespaceGVK {
+				namespaceLabels[uResource.GetName()] = uResource.GetLabels()
+			}
+		}
+	}
+	return namespaceLabels, nil
+}
+
+// applyOverrides applies the JSON patch overrides of the override snapshots that select both the resource and the
+// target cluster. The clusterResourceOverrides are applied before the resourceOverrides, and each of them is applied
+// in the order of the snapshots and their rules.
+// It returns whether any of the overrides is applied to the resource.
+func applyOverrides(resource *unstructured.Unstructured, clusterLabels map[string]string, namespaceLabels map[string]map[string]string,
+	cros []*fleetv1beta1.ClusterResourceOverrideSnapshot, ros []*fleetv1beta1.ResourceOverrideSnapshot) (bool, error) {
+	applied := false
+	for _, cro := range cros {
+		if !overrider.IsClusterResourceSelected(resource, cro.Spec.OverrideSpec.ClusterResourceSelectors, namespaceLabels) {
+			continue
+		}
+		overridden, err := applyOverrideRules(resource, clusterLabels, cro.Spec.OverrideSpec.Policy)
+		if err != nil {
+			klog.ErrorS(err, "Failed to apply the override rules on the resource", "clusterResourceOverrideSnapshot", klog.KObj(cro), "resource", klog.KObj(resource))
+			return false, fmt.Errorf("failed to apply the clusterResourceOverrideSnapshot %s: %w", cro.Name, err)
+		}
+		applied = applied || overridden
+	}
+	for _, ro := range ros {
+		if !overrider.IsResourceSelected(resource, ro.Namespace, ro.Spec.OverrideSpec.ResourceSelectors) {
+			continue
+		}
+		overridden, err := applyOverrideRules(resource, clusterLabels, ro.Spec.OverrideSpec.Policy)
+		if err != nil {
+			klog.ErrorS(err, "Failed to apply the override rules on the resource", "resourceOverrideSnapshot", klog.KObj(ro), "resource", klog.KObj(resource))
+			return false, fmt.Errorf("failed to apply the resourceOverrideSnapshot %s/%s: %w", ro.Namespace, ro.Name, err)
+		}
+		applied = applied || overridden
+	}
+	return applied, nil
+}
+
+// overrideEnvelopedManifests applies the overrides picked for the target cluster on the resources wrapped in an envelope
+// object in place.
+func overrideEnvelopedManifests(manifests []fleetv1beta1.Manifest, clusterLabels map[string]string, namespaceLabels map[string]map[string]string,
+	cros []*fleetv1beta1.ClusterResourceOverrideSnapshot, ros []*fleetv1beta1.ResourceOverrideSnapshot) error {
+	if len(cros) == 0 && len(ros) == 0 {
+		return nil
+	}
+	for i := range manifests {
+		var uResource unstructured.Unstructured
+		if err := uResource.UnmarshalJSON(manifests[i].Raw); err != nil {
+			return fmt.Errorf("failed to unmarshal the enveloped resource: %w", err)
+		}
+		overridden, err := applyOverrides(&uResource, clusterLabels, namespaceLabels, cros, ros)
+		if err != nil {
+			return err
+		}
+		if !overridden {
+			continue
+		}
+		content, err := uResource.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal the enveloped resource %s: %w", klog.KObj(&uResource), err)
+		}
+		manifests[i].Raw = content
+	}
+	return nil
+}
+
+// applyOverrideRules applies the rules of an override policy which select the target cluster.
+func applyOverrideRules(resource *unstructured.Unstructured, clusterLabels map[string]string, policy *fleetv1beta1.OverridePolicy) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+	applied := false
+	for _, rule := range policy.OverrideRules {
+		matched, err := overrider.IsClusterSelectedByRule(clusterLabels, rule.ClusterSelector)
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			continue
+		}
+		if err := applyJSONPatchOverride(resource, rule.JSONPatchOverrides); err != nil {
+			return false, err
+		}
+		applied = true
+	}
+	return applied, nil
+}
+
+// applyJSONPatchOverride applies a list of JSON patch overrides on the resource in place.
+func applyJSONPatchOverride(resource *unstructured.Unstructured, overrides []fleetv1beta1.JSONPatchOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	patchBytes, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	patch, err := jsonpatch.DecodePatch(patchBytes)
+	if err != nil {
+		return err
+	}
+	resourceBytes, err := resource.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	patchedBytes, err := patch.Apply(resourceBytes)
+	if err != nil {
+		return err
+	}
+	return resource.UnmarshalJSON(patchedBytes)
+}
+
+// areManifestsEqual compares the content of two lists of manifests regardless of their json formatting, as the
+// manifests read back from the API server may be encoded differently from the ones we generate.
+func areManifestsEqual(a, b []fleetv1beta1.Manifest) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if bytes.Equal(a[i].Raw, b[i].Raw) {
+			continue
+		}
+		var objA, objB interface{}
+		if err := json.Unmarshal(a[i].Raw, &objA); err != nil {
+			return false
+		}
+		if err := json.Unmarshal(b[i].Raw, &objB); err != nil {
+			return false
+		}
+		if !reflect.DeepEqual(objA, objB) {
+			return false
+		}
+	}
+	return true
+}
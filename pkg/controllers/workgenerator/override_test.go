@@ -0,0 +1,571 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func clusterRoleForOverride() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"name": "clusterrole-name",
+				"labels": map[string]interface{}{
+					"app": "test",
+				},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{""},
+					"resources": []interface{}{"secrets"},
+					"verbs":     []interface{}{"get"},
+				},
+			},
+		},
+	}
+}
+
+func deploymentForOverride() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "deployment-name",
+				"namespace": "app",
+				"labels": map[string]interface{}{
+					"app": "test",
+				},
+			},
+		},
+	}
+}
+
+func clusterRoleSelector() fleetv1beta1.ClusterResourceSelector {
+	return fleetv1beta1.ClusterResourceSelector{
+		Group:   "rbac.authorization.k8s.io",
+		Version: "v1",
+		Kind:    "ClusterRole",
+		Name:    "clusterrole-name",
+	}
+}
+
+func deploymentSelector() fleetv1beta1.ResourceSelector {
+	return fleetv1beta1.ResourceSelector{
+		Group:   "apps",
+		Version: "v1",
+		Kind:    "Deployment",
+		Name:    "deployment-name",
+	}
+}
+
+func removeAppLabelPolicy(clusterSelector *fleetv1beta1.ClusterSelector) *fleetv1beta1.OverridePolicy {
+	return &fleetv1beta1.OverridePolicy{
+		OverrideRules: []fleetv1beta1.OverrideRule{
+			{
+				ClusterSelector: clusterSelector,
+				JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+					{
+						Operator: fleetv1beta1.JSONPatchOverrideOpRemove,
+						Path:     "/metadata/labels/app",
+					},
+				},
+			},
+		},
+	}
+}
+
+// clusterRoleOverrideSnapshot returns a clusterResourceOverrideSnapshot which applies the JSON patch override on
+// the clusterRole on all the clusters.
+func clusterRoleOverrideSnapshot(override fleetv1beta1.JSONPatchOverride) *fleetv1beta1.ClusterResourceOverrideSnapshot {
+	return &fleetv1beta1.ClusterResourceOverrideSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+		Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+			OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+				ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{clusterRoleSelector()},
+				Policy: &fleetv1beta1.OverridePolicy{
+					OverrideRules: []fleetv1beta1.OverrideRule{
+						{JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{override}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	tests := map[string]struct {
+		resource        *unstructured.Unstructured
+		clusterLabels   map[string]string
+		namespaceLabels map[string]map[string]string
+		cros            []*fleetv1beta1.ClusterResourceOverrideSnapshot
+		ros             []*fleetv1beta1.ResourceOverrideSnapshot
+		wantApplied     bool
+		wantLabels      map[string]string
+		wantErr         bool
+	}{
+		"no override": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			wantApplied:   false,
+			wantLabels:    map[string]string{"app": "test"},
+		},
+		"clusterResourceOverride does not select the resource": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+								{
+									Group:   "rbac.authorization.k8s.io",
+									Version: "v1",
+									Kind:    "ClusterRole",
+									Name:    "other-name",
+								},
+							},
+							Policy: removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			wantApplied: false,
+			wantLabels:  map[string]string{"app": "test"},
+		},
+		"override rule does not select the cluster": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{clusterRoleSelector()},
+							Policy: removeAppLabelPolicy(&fleetv1beta1.ClusterSelector{
+								ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+									{
+										LabelSelector: metav1.LabelSelector{
+											MatchLabels: map[string]string{"env": "test"},
+										},
+									},
+								},
+							}),
+						},
+					},
+				},
+			},
+			wantApplied: false,
+			wantLabels:  map[string]string{"app": "test"},
+		},
+		"override rules are applied in order on the selected cluster": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+								{
+									Group:   "rbac.authorization.k8s.io",
+									Version: "v1",
+									Kind:    "ClusterRole",
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "test"},
+									},
+								},
+							},
+							Policy: &fleetv1beta1.OverridePolicy{
+								OverrideRules: []fleetv1beta1.OverrideRule{
+									{
+										ClusterSelector: &fleetv1beta1.ClusterSelector{
+											ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+												{
+													LabelSelector: metav1.LabelSelector{
+														MatchLabels: map[string]string{"env": "test"},
+													},
+												},
+												{
+													LabelSelector: metav1.LabelSelector{
+														MatchLabels: map[string]string{"env": "prod"},
+													},
+												},
+											},
+										},
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpAdd,
+												Path:     "/metadata/labels/env",
+												Value:    apiextensionsv1.JSON{Raw: []byte(`"prod"`)},
+											},
+										},
+									},
+									{
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpReplace,
+												Path:     "/metadata/labels/env",
+												Value:    apiextensionsv1.JSON{Raw: []byte(`"prod-east"`)},
+											},
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpRemove,
+												Path:     "/metadata/labels/app",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantApplied: true,
+			wantLabels:  map[string]string{"env": "prod-east"},
+		},
+		"invalid patch path": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{clusterRoleSelector()},
+							Policy: &fleetv1beta1.OverridePolicy{
+								OverrideRules: []fleetv1beta1.OverrideRule{
+									{
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpReplace,
+												Path:     "/metadata/annotations/not-exist",
+												Value:    apiextensionsv1.JSON{Raw: []byte(`"value"`)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"add replaces the value of the existing path": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterRoleOverrideSnapshot(fleetv1beta1.JSONPatchOverride{
+					Operator: fleetv1beta1.JSONPatchOverrideOpAdd,
+					Path:     "/metadata/labels/app",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"cro"`)},
+				}),
+			},
+			wantApplied: true,
+			wantLabels:  map[string]string{"app": "cro"},
+		},
+		"add to a path whose parent does not exist": {
+			resource:      clusterRoleForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				clusterRoleOverrideSnapshot(fleetv1beta1.JSONPatchOverride{
+					Operator: fleetv1beta1.JSONPatchOverrideOpAdd,
+					Path:     "/metadata/annotations/key",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"value"`)},
+				}),
+			},
+			wantErr: true,
+		},
+		"clusterResourceOverride selects the resource in the selected namespace": {
+			resource:        deploymentForOverride(),
+			clusterLabels:   map[string]string{"env": "prod"},
+			namespaceLabels: map[string]map[string]string{"app": {"team": "a"}},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+								{
+									Group:   "",
+									Version: "v1",
+									Kind:    "Namespace",
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"team": "a"},
+									},
+									NamespacedResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+										{Group: "apps", Version: "v1", Kind: "Deployment"},
+									},
+								},
+							},
+							Policy: removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			wantApplied: true,
+			wantLabels:  map[string]string{},
+		},
+		"clusterResourceOverride does not select the resource in another namespace": {
+			resource:        deploymentForOverride(),
+			clusterLabels:   map[string]string{"env": "prod"},
+			namespaceLabels: map[string]map[string]string{"app": {"team": "a"}},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+								{
+									Group:   "",
+									Version: "v1",
+									Kind:    "Namespace",
+									Name:    "other",
+									NamespacedResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+										{Group: "apps", Version: "v1", Kind: "Deployment"},
+									},
+								},
+							},
+							Policy: removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			wantApplied: false,
+			wantLabels:  map[string]string{"app": "test"},
+		},
+		"resourceOverride in another namespace does not select the resource": {
+			resource:      deploymentForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			ros: []*fleetv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ro-1-0", Namespace: "other"},
+					Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+							ResourceSelectors: []fleetv1beta1.ResourceSelector{deploymentSelector()},
+							Policy:            removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			wantApplied: false,
+			wantLabels:  map[string]string{"app": "test"},
+		},
+		"resourceOverrides are applied in order": {
+			resource:      deploymentForOverride(),
+			clusterLabels: map[string]string{"env": "prod"},
+			cros: []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cro-1-0"},
+					Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+							// a clusterResourceOverride only selects a namespaced resource in a selected namespace
+							ClusterResourceSelectors: []fleetv1beta1.ClusterResourceSelector{
+								{
+									Group:   "apps",
+									Version: "v1",
+									Kind:    "Deployment",
+								},
+							},
+							Policy: removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			ros: []*fleetv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ro-1-0", Namespace: "app"},
+					Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+							ResourceSelectors: []fleetv1beta1.ResourceSelector{deploymentSelector()},
+							Policy: &fleetv1beta1.OverridePolicy{
+								OverrideRules: []fleetv1beta1.OverrideRule{
+									{
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpReplace,
+												Path:     "/metadata/labels/app",
+												Value:    apiextensionsv1.JSON{Raw: []byte(`"ro"`)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ro-2-0", Namespace: "app"},
+					Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+							ResourceSelectors: []fleetv1beta1.ResourceSelector{deploymentSelector()},
+							Policy: &fleetv1beta1.OverridePolicy{
+								OverrideRules: []fleetv1beta1.OverrideRule{
+									{
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpAdd,
+												Path:     "/metadata/labels/env",
+												Value:    apiextensionsv1.JSON{Raw: []byte(`"prod"`)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantApplied: true,
+			wantLabels:  map[string]string{"app": "ro", "env": "prod"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			applied, err := applyOverrides(tt.resource, tt.clusterLabels, tt.namespaceLabels, tt.cros, tt.ros)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyOverrides() got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if applied != tt.wantApplied {
+				t.Errorf("applyOverrides() = %t, want %t", applied, tt.wantApplied)
+			}
+			if diff := cmp.Diff(tt.wantLabels, tt.resource.GetLabels()); diff != "" {
+				t.Errorf("applyOverrides() labels mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOverrideEnvelopedManifests(t *testing.T) {
+	configMapManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"labels":{"app":"test"},"name":"config","namespace":"app"}}`
+	roleManifest := `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"Role","metadata":{"labels":{"app":"test"},"name":"role","namespace":"app"}}`
+	tests := map[string]struct {
+		ros     []*fleetv1beta1.ResourceOverrideSnapshot
+		want    []string
+		wantErr bool
+	}{
+		"no override": {
+			want: []string{configMapManifest, roleManifest},
+		},
+		"override the selected wrapped resource": {
+			ros: []*fleetv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ro-1-0", Namespace: "app"},
+					Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+							ResourceSelectors: []fleetv1beta1.ResourceSelector{
+								{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role", Name: "role"},
+							},
+							Policy: removeAppLabelPolicy(nil),
+						},
+					},
+				},
+			},
+			want: []string{
+				configMapManifest,
+				// the overridden resource is re-encoded
+				`{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"Role","metadata":{"labels":{},"name":"role","namespace":"app"}}` + "\n",
+			},
+		},
+		"invalid override on the wrapped resource": {
+			ros: []*fleetv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ro-1-0", Namespace: "app"},
+					Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+							ResourceSelectors: []fleetv1beta1.ResourceSelector{
+								{Group: "", Version: "v1", Kind: "ConfigMap", Name: "config"},
+							},
+							Policy: &fleetv1beta1.OverridePolicy{
+								OverrideRules: []fleetv1beta1.OverrideRule{
+									{
+										JSONPatchOverrides: []fleetv1beta1.JSONPatchOverride{
+											{
+												Operator: fleetv1beta1.JSONPatchOverrideOpRemove,
+												Path:     "/metadata/annotations/not-exist",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			manifests := []fleetv1beta1.Manifest{
+				{RawExtension: runtime.RawExtension{Raw: []byte(configMapManifest)}},
+				{RawExtension: runtime.RawExtension{Raw: []byte(roleManifest)}},
+			}
+			err := overrideEnvelopedManifests(manifests, nil, nil, nil, tt.ros)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("overrideEnvelopedManifests() got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := make([]string, len(manifests))
+			for i := range manifests {
+				got[i] = string(manifests[i].Raw)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("overrideEnvelopedManifests() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreManifestsEqual(t *testing.T) {
+	tests := map[string]struct {
+		a    []fleetv1beta1.Manifest
+		b    []fleetv1beta1.Manifest
+		want bool
+	}{
+		"both empty": {
+			want: true,
+		},
+		"different length": {
+			a:    []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(`{"a":1}`)}}},
+			want: false,
+		},
+		"same content with different formatting": {
+			a:    []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(`{"a":1,"b":{"c":"d"}}`)}}},
+			b:    []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(`{ "b": {"c": "d"}, "a": 1 }`)}}},
+			want: true,
+		},
+		"different content": {
+			a:    []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(`{"a":1}`)}}},
+			b:    []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(`{"a":2}`)}}},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := areManifestsEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("areManifestsEqual() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/condition"
+	"go.goms.io/fleet/pkg/utils/controller"
+)
+
+const (
+	rolloutGatesPendingReason = "RolloutGatesPending"
+	rolloutGatesPassedReason  = "RolloutGatesPassed"
+	rolloutGatesFailedReason  = "RolloutGatesFailed"
+)
+
+// extractRolloutGateWork removes the rollout gate work from the works associated with a binding and returns it.
+func extractRolloutGateWork(works map[string]*fleetv1beta1.Work) *fleetv1beta1.Work {
+	for name, work := range works {
+		if len(work.Spec.RolloutGates) != 0 {
+			delete(works, name)
+			return work
+		}
+	}
+	return nil
+}
+
+// syncRolloutGateWork generates the rollout gate work with the rollout gates of the binding once all of its resources
+// are available on the target cluster, so that the member agent checks the gates against the resources being rolled
+// out, and reports the result in the RolloutGatesPassed condition of the binding.
+// The rollout gate work is deleted once the resources are not available, e.g. when they are being updated, so that
+// the result of the gates always comes from the resources placed on the target cluster now.
+func (r *Reconciler) syncRolloutGateWork(ctx context.Context, resourceBinding *fleetv1beta1.ClusterResourceBinding, existingWork *fleetv1beta1.Work) error {
+	available := condition.IsConditionStatusTrue(resourceBinding.GetCondition(string(fleetv1beta1.ResourceBindingAvailable)), resourceBinding.Generation)
+	if resourceBinding.Spec.State != fleetv1beta1.BindingStateBound || len(resourceBinding.Spec.RolloutGates) == 0 || !available {
+		meta.RemoveStatusCondition(&resourceBinding.Status.Conditions, string(fleetv1beta1.ResourceBindingRolloutGatesPassed))
+		if existingWork == nil {
+			return nil
+		}
+		if err := r.Client.Delete(ctx, existingWork); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the no longer needed rollout gate work", "work", klog.KObj(existingWork))
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the rollout gate work as the binding is not waiting for its rollout gates", "work", klog.KObj(existingWork))
+		return nil
+	}
+
+	pendingCond := metav1.Condition{
+		Status:             metav1.ConditionUnknown,
+		Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+		Reason:             rolloutGatesPendingReason,
+		Message:            "The rollout gates need to be checked by the member agent first",
+		ObservedGeneration: resourceBinding.Generation,
+	}
+	if existingWork == nil {
+		newWork := generateRolloutGateWorkObj(resourceBinding)
+		if err := r.Client.Create(ctx, newWork); err != nil {
+			klog.ErrorS(err, "Failed to create the rollout gate work", "resourceBinding", klog.KObj(resourceBinding), "work", klog.KObj(newWork))
+			return controller.NewCreateIgnoreAlreadyExistError(err)
+		}
+		klog.V(2).InfoS("Successfully created the rollout gate work", "resourceBinding", klog.KObj(resourceBinding), "work", klog.KObj(newWork))
+		resourceBinding.SetConditions(pendingCond)
+		return nil
+	}
+	if !equality.Semantic.DeepEqual(existingWork.Spec.RolloutGates, resourceBinding.Spec.RolloutGates) {
+		existingWork.Spec.RolloutGates = resourceBinding.Spec.RolloutGates
+		if err := r.Client.Update(ctx, existingWork); err != nil {
+			klog.ErrorS(err, "Failed to update the rollout gate work", "resourceBinding", klog.KObj(resourceBinding), "work", klog.KObj(existingWork))
+			return controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Successfully updated the rollout gate work", "resourceBinding", klog.KObj(resourceBinding), "work", klog.KObj(existingWork))
+		resourceBinding.SetConditions(pendingCond)
+		return nil
+	}
+	resourceBinding.SetConditions(buildRolloutGatesCondition(existingWork, resourceBinding))
+	return nil
+}
+
+// generateRolloutGateWorkObj generates the rollout gate work object for the binding.
+func generateRolloutGateWorkObj(resourceBinding *fleetv1beta1.ClusterResourceBinding) *fleetv1beta1.Work {
+	crpName := resourceBinding.Labels[fleetv1beta1.CRPTrackingLabel]
+	return &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(fleetv1beta1.RolloutGateWorkNameFmt, crpName),
+			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, resourceBinding.Spec.TargetCluster),
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel: resourceBinding.Name,
+				fleetv1beta1.CRPTrackingLabel:   crpName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         fleetv1beta1.GroupVersion.String(),
+					Kind:               resourceBinding.Kind,
+					Name:               resourceBinding.Name,
+					UID:                resourceBinding.UID,
+					BlockOwnerDeletion: pointer.Bool(true), // make sure that the k8s will call work delete when the binding is deleted
+				},
+			},
+		},
+		Spec: fleetv1beta1.WorkSpec{
+			RolloutGates: resourceBinding.Spec.RolloutGates,
+		},
+	}
+}
+
+// buildRolloutGatesCondition builds the RolloutGatesPassed condition of the binding from the status of its rollout gate work.
+func buildRolloutGatesCondition(work *fleetv1beta1.Work, binding *fleetv1beta1.ClusterResourceBinding) metav1.Condition {
+	gatesCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeRolloutGatesPassed)
+	switch {
+	case gatesCond == nil || gatesCond.ObservedGeneration != work.GetGeneration():
+		klog.V(2).InfoS("The rollout gates are not checked yet", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionUnknown,
+			Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+			Reason:             rolloutGatesPendingReason,
+			Message:            fmt.Sprintf("The rollout gates in work %s are not checked by the member agent yet", work.Name),
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	case gatesCond.Status == metav1.ConditionTrue:
+		klog.V(2).InfoS("The rollout gates passed", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionTrue,
+			Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+			Reason:             rolloutGatesPassedReason,
+			Message:            "All the rollout gates pass on the target cluster",
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	default:
+		klog.V(2).InfoS("The rollout gates failed", "work", klog.KObj(work), "binding", klog.KObj(binding))
+		return metav1.Condition{
+			Status:             metav1.ConditionFalse,
+			Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+			Reason:             rolloutGatesFailedReason,
+			Message:            fmt.Sprintf("The rollout gates in work %s failed: %s", work.Name, gatesCond.Message),
+			ObservedGeneration: binding.GetGeneration(),
+		}
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package workgenerator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestExtractRolloutGateWork(t *testing.T) {
+	work := &fleetv1beta1.Work{ObjectMeta: metav1.ObjectMeta{Name: "placement-work"}}
+	rolloutGateWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: "placement-rolloutgate"},
+		Spec: fleetv1beta1.WorkSpec{
+			RolloutGates: []fleetv1beta1.RolloutGate{{Name: "health", Type: fleetv1beta1.RolloutGateTypeHTTPProbe}},
+		},
+	}
+	tests := map[string]struct {
+		works      map[string]*fleetv1beta1.Work
+		wantWork   *fleetv1beta1.Work
+		wantRemain []string
+	}{
+		"the rollout gate work is extracted": {
+			works:      map[string]*fleetv1beta1.Work{work.Name: work, rolloutGateWork.Name: rolloutGateWork},
+			wantWork:   rolloutGateWork,
+			wantRemain: []string{work.Name},
+		},
+		"there is no rollout gate work": {
+			works:      map[string]*fleetv1beta1.Work{work.Name: work},
+			wantRemain: []string{work.Name},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := extractRolloutGateWork(tt.works); got != tt.wantWork {
+				t.Errorf("extractRolloutGateWork() = %v, want %v", got, tt.wantWork)
+			}
+			var gotRemain []string
+			for name := range tt.works {
+				gotRemain = append(gotRemain, name)
+			}
+			if diff := cmp.Diff(tt.wantRemain, gotRemain); diff != "" {
+				t.Errorf("extractRolloutGateWork() remaining works mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildRolloutGatesCondition(t *testing.T) {
+	binding := &fleetv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding", Generation: 3},
+	}
+	tests := map[string]struct {
+		workCond *metav1.Condition
+		want     metav1.Condition
+	}{
+		"the rollout gates are not checked yet": {
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+				Reason:             rolloutGatesPendingReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the rollout gates are checked with an old generation": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+				Reason:             rolloutGatesPendingReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the rollout gates passed": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 2,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+				Reason:             rolloutGatesPassedReason,
+				ObservedGeneration: 3,
+			},
+		},
+		"the rollout gates failed": {
+			workCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeRolloutGatesPassed,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingRolloutGatesPassed),
+				Reason:             rolloutGatesFailedReason,
+				ObservedGeneration: 3,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "placement-rolloutgate", Generation: 2},
+			}
+			if tt.workCond != nil {
+				work.Status.Conditions = []metav1.Condition{*tt.workCond}
+			}
+			got := buildRolloutGatesCondition(work, binding)
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(metav1.Condition{}, "Message")); diff != "" {
+				t.Errorf("buildRolloutGatesCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package propertyprovider features the interface for the member agent to collect the properties
+// and the resource usage of a member cluster, which are reported to the hub cluster for scheduling.
+package propertyprovider
+
+import (
+	"context"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+)
+
+const (
+	// NodesPropertyProvider is the name of the default property provider, which reports the properties
+	// and the resource usage collected from the nodes of the member cluster.
+	NodesPropertyProvider = "nodes"
+
+	// NoPropertyProvider is the name to use when no property should be reported.
+	NoPropertyProvider = "none"
+)
+
+// PropertyCollectionResponse is the response returned by a property provider after a collection.
+type PropertyCollectionResponse struct {
+	// Properties is a group of named properties of the member cluster, such as the node count,
+	// the cost per CPU core or the region.
+	Properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+
+	// Resources is the resource usage of the member cluster, including the capacity, the allocatable
+	// and the available resources.
+	Resources clusterv1beta1.ResourceUsage
+}
+
+// PropertyProvider is the interface that every property provider must implement.
+type PropertyProvider interface {
+	// Collect is called periodically by the member agent, at each heartbeat, to collect the properties
+	// and the resource usage of the member cluster.
+	//
+	// The implementation should set the observation time of every property and the resource usage.
+	Collect(ctx context.Context) (*PropertyCollectionResponse, error)
+}
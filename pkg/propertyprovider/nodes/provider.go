@@ -0,0 +1,195 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package nodes features the default property provider, which collects the properties and the
+// resource usage of a member cluster from its nodes.
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	"go.goms.io/fleet/pkg/propertyprovider"
+)
+
+const (
+	// podListPageSize is the number of pods listed in a request, which bounds the memory used to
+	// collect the resources requested by the pods of a large member cluster.
+	podListPageSize = 500
+)
+
+var (
+	// nonTerminatedPodSelector selects the pods which have neither succeeded nor failed on the API
+	// server, as the terminated pods do not request any resource.
+	nonTerminatedPodSelector = fields.AndSelectors(
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+	)
+)
+
+// PropertyProvider reports the node count and the Kubernetes version, and the capacity, the allocatable
+// and the available CPU and memory resources summed over all the nodes of a member cluster.
+type PropertyProvider struct {
+	// client is the uncached reader to access the member cluster, so that the agent does not keep
+	// all the pods of the member cluster in its memory.
+	client client.Reader
+	// versionGetter is used to find the Kubernetes version of the member cluster.
+	versionGetter discovery.ServerVersionInterface
+}
+
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// New returns a new node-based property provider; the memberReader should read from the API server
+// of the member cluster directly.
+func New(memberReader client.Reader, versionGetter discovery.ServerVersionInterface) *PropertyProvider {
+	return &PropertyProvider{
+		client:        memberReader,
+		versionGetter: versionGetter,
+	}
+}
+
+// Collect collects the properties and the resource usage of the member cluster from its nodes.
+//
+// The available resources of a node are its allocatable resources minus the resources requested
+// by the non-terminated pods running on the node.
+func (p *PropertyProvider) Collect(ctx context.Context) (*propertyprovider.PropertyCollectionResponse, error) {
+	var nodes corev1.NodeList
+	if err := p.client.List(ctx, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	requestedByNode, err := p.requestedResourcesByNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serverVersion, err := p.versionGetter.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the server version: %w", err)
+	}
+
+	var capacityCPU, capacityMemory, allocatableCPU, allocatableMemory, availableCPU, availableMemory resource.Quantity
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		capacityCPU.Add(*node.Status.Capacity.Cpu())
+		capacityMemory.Add(*node.Status.Capacity.Memory())
+		allocatableCPU.Add(*node.Status.Allocatable.Cpu())
+		allocatableMemory.Add(*node.Status.Allocatable.Memory())
+
+		requested := requestedByNode[node.Name]
+		availableCPU.Add(availableQuantity(*node.Status.Allocatable.Cpu(), *requested.Cpu()))
+		availableMemory.Add(availableQuantity(*node.Status.Allocatable.Memory(), *requested.Memory()))
+	}
+
+	now := metav1.Now()
+	return &propertyprovider.PropertyCollectionResponse{
+		Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+			clusterv1beta1.NodeCountProperty: {
+				Value:           strconv.Itoa(len(nodes.Items)),
+				ObservationTime: now,
+			},
+			clusterv1beta1.KubernetesVersionProperty: {
+				Value:           serverVersion.GitVersion,
+				ObservationTime: now,
+			},
+		},
+		Resources: clusterv1beta1.ResourceUsage{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    capacityCPU,
+				corev1.ResourceMemory: capacityMemory,
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    allocatableCPU,
+				corev1.ResourceMemory: allocatableMemory,
+			},
+			Available: corev1.ResourceList{
+				corev1.ResourceCPU:    availableCPU,
+				corev1.ResourceMemory: availableMemory,
+			},
+			ObservationTime: now,
+		},
+	}, nil
+}
+
+// requestedResourcesByNode returns the resources requested by the non-terminated pods on each node.
+// The pods are listed page by page with the terminated ones filtered out by the API server.
+func (p *PropertyProvider) requestedResourcesByNode(ctx context.Context) (map[string]corev1.ResourceList, error) {
+	requestedByNode := make(map[string]corev1.ResourceList)
+	continueToken := ""
+	for {
+		var pods corev1.PodList
+		if err := p.client.List(ctx, &pods,
+			client.MatchingFieldsSelector{Selector: nonTerminatedPodSelector},
+			client.Limit(podListPageSize),
+			client.Continue(continueToken),
+		); err != nil {
+			return nil, fmt.Errorf("failed to list pods: %w", err)
+		}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if pod.Spec.NodeName == "" {
+				// Skip the pods that are not scheduled yet.
+				continue
+			}
+			requested, found := requestedByNode[pod.Spec.NodeName]
+			if !found {
+				requested = corev1.ResourceList{}
+				requestedByNode[pod.Spec.NodeName] = requested
+			}
+			addResourceList(requested, podRequests(pod))
+		}
+		if continueToken = pods.Continue; continueToken == "" {
+			return requestedByNode, nil
+		}
+	}
+}
+
+// podRequests returns the resources requested by a pod, following the same rule as the
+// Kubernetes scheduler: the larger one of the sum of the requests of all the app containers
+// and the largest request of any init container, plus the pod overhead.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for i := range pod.Spec.Containers {
+		addResourceList(requests, pod.Spec.Containers[i].Resources.Requests)
+	}
+	for i := range pod.Spec.InitContainers {
+		for name, quantity := range pod.Spec.InitContainers[i].Resources.Requests {
+			if current, found := requests[name]; !found || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResourceList(requests, pod.Spec.Overhead)
+	return requests
+}
+
+// addResourceList adds the resources in the new list to the given list.
+func addResourceList(list, newList corev1.ResourceList) {
+	for name, quantity := range newList {
+		if current, found := list[name]; found {
+			current.Add(quantity)
+			list[name] = current
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// availableQuantity returns the allocatable quantity minus the requested one; the result is never negative.
+func availableQuantity(allocatable, requested resource.Quantity) resource.Quantity {
+	available := allocatable.DeepCopy()
+	available.Sub(requested)
+	if available.Sign() < 0 {
+		return resource.Quantity{}
+	}
+	return available
+}
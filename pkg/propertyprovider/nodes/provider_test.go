@@ -0,0 +1,263 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	"go.goms.io/fleet/pkg/propertyprovider"
+)
+
+const (
+	nodeName1 = "node-1"
+	nodeName2 = "node-2"
+
+	testKubernetesVersion = "v1.28.3"
+)
+
+var (
+	ignoreObservationTimeOption = cmpopts.IgnoreTypes(metav1.Time{})
+)
+
+func node(name, cpu, memory string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func pod(name, nodeName string, phase corev1.PodPhase, requests ...corev1.ResourceList) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+		},
+	}
+	for _, r := range requests {
+		p.Spec.Containers = append(p.Spec.Containers, corev1.Container{
+			Resources: corev1.ResourceRequirements{Requests: r},
+		})
+	}
+	return p
+}
+
+// mockReader returns a reader which lists the given nodes and pods. The pods are filtered by the
+// field selector and returned one per page, as the API server may return fewer items than the limit.
+func mockReader(objects []client.Object, listErr error) client.Reader {
+	return &test.MockClient{
+		MockList: func(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			if listErr != nil {
+				return listErr
+			}
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			switch l := list.(type) {
+			case *corev1.NodeList:
+				for _, obj := range objects {
+					if n, ok := obj.(*corev1.Node); ok {
+						l.Items = append(l.Items, *n)
+					}
+				}
+			case *corev1.PodList:
+				var pods []corev1.Pod
+				for _, obj := range objects {
+					if p, ok := obj.(*corev1.Pod); ok && listOpts.FieldSelector.Matches(fields.Set{"status.phase": string(p.Status.Phase)}) {
+						pods = append(pods, *p)
+					}
+				}
+				start := 0
+				if listOpts.Continue != "" {
+					start, _ = strconv.Atoi(listOpts.Continue)
+				}
+				if start < len(pods) {
+					l.Items = pods[start : start+1]
+				}
+				if start+1 < len(pods) {
+					l.Continue = strconv.Itoa(start + 1)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []client.Object
+		listErr error
+		want    *propertyprovider.PropertyCollectionResponse
+		wantErr bool
+	}{
+		{
+			name: "no nodes",
+			want: &propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					clusterv1beta1.NodeCountProperty:         {Value: "0"},
+					clusterv1beta1.KubernetesVersionProperty: {Value: testKubernetesVersion},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("0"),
+						corev1.ResourceMemory: resource.MustParse("0"),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("0"),
+						corev1.ResourceMemory: resource.MustParse("0"),
+					},
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("0"),
+						corev1.ResourceMemory: resource.MustParse("0"),
+					},
+				},
+			},
+		},
+		{
+			name: "nodes with running and terminated pods",
+			objects: []client.Object{
+				node(nodeName1, "4", "16Gi"),
+				node(nodeName2, "2", "8Gi"),
+				pod("running-1", nodeName1, corev1.PodRunning,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}),
+				pod("running-2", nodeName2, corev1.PodRunning,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourceMemory: resource.MustParse("2Gi")}),
+				pod("succeeded", nodeName1, corev1.PodSucceeded,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}),
+				pod("failed", nodeName2, corev1.PodFailed,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}),
+				pod("pending", "", corev1.PodPending,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}),
+			},
+			want: &propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					clusterv1beta1.NodeCountProperty:         {Value: "2"},
+					clusterv1beta1.KubernetesVersionProperty: {Value: testKubernetesVersion},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("6"),
+						corev1.ResourceMemory: resource.MustParse("24Gi"),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("6"),
+						corev1.ResourceMemory: resource.MustParse("24Gi"),
+					},
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("3500m"),
+						corev1.ResourceMemory: resource.MustParse("21Gi"),
+					},
+				},
+			},
+		},
+		{
+			name: "node is overcommitted",
+			objects: []client.Object{
+				node(nodeName1, "1", "1Gi"),
+				pod("running-1", nodeName1, corev1.PodRunning,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("512Mi")}),
+			},
+			want: &propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					clusterv1beta1.NodeCountProperty:         {Value: "1"},
+					clusterv1beta1.KubernetesVersionProperty: {Value: testKubernetesVersion},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("0"),
+						corev1.ResourceMemory: resource.MustParse("512Mi"),
+					},
+				},
+			},
+		},
+		{
+			name:    "failed to list",
+			listErr: errors.New("list error"),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeDiscovery := &fakediscovery.FakeDiscovery{
+				Fake:               &clienttesting.Fake{},
+				FakedServerVersion: &version.Info{GitVersion: testKubernetesVersion},
+			}
+			p := New(mockReader(tc.objects, tc.listErr), fakeDiscovery)
+			got, err := p.Collect(context.Background())
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Collect() got error %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got, ignoreObservationTimeOption); diff != "" {
+				t.Errorf("Collect() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodRequests(t *testing.T) {
+	p := pod("pod", nodeName1, corev1.PodRunning,
+		corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")})
+	p.Spec.InitContainers = []corev1.Container{
+		{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+			},
+		},
+	}
+	p.Spec.Overhead = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}
+	want := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1050m"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	if diff := cmp.Diff(want, podRequests(p)); diff != "" {
+		t.Errorf("podRequests() mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -1195,10 +1195,13 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 
 	affinityScore1 := int32(10)
 	topologySpreadScore1 := int32(2)
+	resourceAvailabilityScore1 := int32(30)
 	affinityScore2 := int32(20)
 	topologySpreadScore2 := int32(1)
+	resourceAvailabilityScore2 := int32(50)
 	affinityScore3 := int32(30)
 	topologySpreadScore3 := int32(0)
+	resourceAvailabilityScore3 := int32(70)
 
 	sorted := ScoredClusters{
 		{
@@ -1209,6 +1212,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 			},
 			Score: &ClusterScore{
 				TopologySpreadScore:            int(topologySpreadScore1),
+				ResourceAvailabilityScore:      int(resourceAvailabilityScore1),
 				AffinityScore:                  int(affinityScore1),
 				ObsoletePlacementAffinityScore: 1,
 			},
@@ -1221,6 +1225,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 			},
 			Score: &ClusterScore{
 				TopologySpreadScore:            int(topologySpreadScore2),
+				ResourceAvailabilityScore:      int(resourceAvailabilityScore2),
 				AffinityScore:                  int(affinityScore2),
 				ObsoletePlacementAffinityScore: 0,
 			},
@@ -1233,6 +1238,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 			},
 			Score: &ClusterScore{
 				TopologySpreadScore:            int(topologySpreadScore3),
+				ResourceAvailabilityScore:      int(resourceAvailabilityScore3),
 				AffinityScore:                  int(affinityScore3),
 				ObsoletePlacementAffinityScore: 1,
 			},
@@ -1283,8 +1289,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName1,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore1,
-								TopologySpreadScore: &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								TopologySpreadScore:       &topologySpreadScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore1,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1305,8 +1312,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName2,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore2,
-								TopologySpreadScore: &topologySpreadScore2,
+								AffinityScore:             &affinityScore2,
+								TopologySpreadScore:       &topologySpreadScore2,
+								ResourceAvailabilityScore: &resourceAvailabilityScore2,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1327,8 +1335,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName3,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore3,
-								TopologySpreadScore: &topologySpreadScore3,
+								AffinityScore:             &affinityScore3,
+								TopologySpreadScore:       &topologySpreadScore3,
+								ResourceAvailabilityScore: &resourceAvailabilityScore3,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1394,8 +1403,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName1,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1423,8 +1433,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName2,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1452,8 +1463,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName3,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore3,
-									TopologySpreadScore: &topologySpreadScore3,
+									AffinityScore:             &affinityScore3,
+									TopologySpreadScore:       &topologySpreadScore3,
+									ResourceAvailabilityScore: &resourceAvailabilityScore3,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1519,8 +1531,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName3,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore3,
-								TopologySpreadScore: &topologySpreadScore3,
+								AffinityScore:             &affinityScore3,
+								TopologySpreadScore:       &topologySpreadScore3,
+								ResourceAvailabilityScore: &resourceAvailabilityScore3,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1541,8 +1554,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName1,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1570,8 +1584,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName2,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1628,8 +1643,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName1,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore1,
-								TopologySpreadScore: &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								TopologySpreadScore:       &topologySpreadScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore1,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1650,8 +1666,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName2,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore2,
-								TopologySpreadScore: &topologySpreadScore2,
+								AffinityScore:             &affinityScore2,
+								TopologySpreadScore:       &topologySpreadScore2,
+								ResourceAvailabilityScore: &resourceAvailabilityScore2,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1672,8 +1689,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName3,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore3,
-								TopologySpreadScore: &topologySpreadScore3,
+								AffinityScore:             &affinityScore3,
+								TopologySpreadScore:       &topologySpreadScore3,
+								ResourceAvailabilityScore: &resourceAvailabilityScore3,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1715,8 +1733,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName1,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore1,
-								TopologySpreadScore: &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								TopologySpreadScore:       &topologySpreadScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore1,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1737,8 +1756,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName3,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore3,
-								TopologySpreadScore: &topologySpreadScore3,
+								AffinityScore:             &affinityScore3,
+								TopologySpreadScore:       &topologySpreadScore3,
+								ResourceAvailabilityScore: &resourceAvailabilityScore3,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1760,8 +1780,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName2,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1822,8 +1843,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterName: clusterName3,
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
-								AffinityScore:       &affinityScore3,
-								TopologySpreadScore: &topologySpreadScore3,
+								AffinityScore:             &affinityScore3,
+								TopologySpreadScore:       &topologySpreadScore3,
+								ResourceAvailabilityScore: &resourceAvailabilityScore3,
 							},
 							Reason: pickedByPolicyReason,
 						},
@@ -1844,8 +1866,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName1,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -1874,8 +1897,9 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterName: clusterName2,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2150,10 +2174,13 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 
 	affinityScore1 := int32(1)
 	topologySpreadScore1 := int32(10)
+	resourceAvailabilityScore1 := int32(30)
 	affinityScore2 := int32(0)
 	topologySpreadScore2 := int32(20)
+	resourceAvailabilityScore2 := int32(50)
 	affinityScore3 := int32(-1)
 	topologySpreadScore3 := int32(0)
+	resourceAvailabilityScore3 := int32(70)
 
 	filteredStatus := NewNonErrorStatus(ClusterUnschedulable, dummyPluginName, "filtered")
 
@@ -2184,8 +2211,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 			ClusterName: clusterName,
 			Selected:    true,
 			ClusterScore: &placementv1beta1.ClusterScore{
-				AffinityScore:       &affinityScore1,
-				TopologySpreadScore: &topologySpreadScore1,
+				AffinityScore:             &affinityScore1,
+				TopologySpreadScore:       &topologySpreadScore1,
+				ResourceAvailabilityScore: &resourceAvailabilityScore1,
 			},
 			Reason: pickedByPolicyReason,
 		},
@@ -2193,8 +2221,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 			ClusterName: altClusterName,
 			Selected:    true,
 			ClusterScore: &placementv1beta1.ClusterScore{
-				AffinityScore:       &affinityScore2,
-				TopologySpreadScore: &topologySpreadScore2,
+				AffinityScore:             &affinityScore2,
+				TopologySpreadScore:       &topologySpreadScore2,
+				ResourceAvailabilityScore: &resourceAvailabilityScore2,
 			},
 			Reason: pickedByPolicyReason,
 		},
@@ -2226,8 +2255,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2242,8 +2272,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2257,8 +2288,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2266,8 +2298,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore2,
-						TopologySpreadScore: &topologySpreadScore2,
+						AffinityScore:             &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2289,8 +2322,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2305,8 +2339,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2330,8 +2365,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2339,8 +2375,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore2,
-						TopologySpreadScore: &topologySpreadScore2,
+						AffinityScore:             &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2367,8 +2404,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2383,8 +2421,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2400,8 +2439,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						TopologySpreadScore: int(topologySpreadScore3),
-						AffinityScore:       int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
+						AffinityScore:             int(affinityScore3),
 					},
 				},
 			},
@@ -2411,8 +2451,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2420,8 +2461,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore2,
-						TopologySpreadScore: &topologySpreadScore2,
+						AffinityScore:             &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2430,8 +2472,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					Selected:    false,
 					Reason:      notPickedByScoreReason,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore3,
-						TopologySpreadScore: &topologySpreadScore3,
+						AffinityScore:             &affinityScore3,
+						TopologySpreadScore:       &topologySpreadScore3,
+						ResourceAvailabilityScore: &resourceAvailabilityScore3,
 					},
 				},
 			},
@@ -2452,8 +2495,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2469,8 +2513,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						TopologySpreadScore: int(topologySpreadScore3),
-						AffinityScore:       int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
+						AffinityScore:             int(affinityScore3),
 					},
 				},
 			},
@@ -2490,8 +2535,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2499,8 +2545,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    false,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore3,
-						TopologySpreadScore: &topologySpreadScore3,
+						AffinityScore:             &affinityScore3,
+						TopologySpreadScore:       &topologySpreadScore3,
+						ResourceAvailabilityScore: &resourceAvailabilityScore3,
 					},
 					Reason: notPickedByScoreReason,
 				},
@@ -2534,8 +2581,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2567,8 +2615,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2595,8 +2644,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2612,8 +2662,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						AffinityScore:       int(affinityScore2),
-						TopologySpreadScore: int(topologySpreadScore2),
+						AffinityScore:             int(affinityScore2),
+						TopologySpreadScore:       int(topologySpreadScore2),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore2),
 					},
 				},
 				{
@@ -2623,8 +2674,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						AffinityScore:       int(affinityScore3),
-						TopologySpreadScore: int(topologySpreadScore3),
+						AffinityScore:             int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
 					},
 				},
 			},
@@ -2634,8 +2686,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2644,8 +2697,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					Selected:    false,
 					Reason:      notPickedByScoreReason,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore2,
-						TopologySpreadScore: &topologySpreadScore2,
+						AffinityScore:             &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
 					},
 				},
 			},
@@ -2666,8 +2720,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore1,
-									TopologySpreadScore: &topologySpreadScore1,
+									AffinityScore:             &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2682,8 +2737,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									AffinityScore:       &affinityScore2,
-									TopologySpreadScore: &topologySpreadScore2,
+									AffinityScore:             &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -2697,8 +2753,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore1,
-						TopologySpreadScore: &topologySpreadScore1,
+						AffinityScore:             &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2706,8 +2763,9 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore2,
-						TopologySpreadScore: &topologySpreadScore2,
+						AffinityScore:             &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -2848,6 +2906,8 @@ func TestShouldDownscale(t *testing.T) {
 func TestSortByClusterScoreAndName(t *testing.T) {
 	topologySpreadScore1 := int32(0)
 	affinityScore1 := int32(10)
+	resourceAvailabilityScore1 := int32(30)
+	resourceAvailabilityScore2 := int32(60)
 	topologySpreadScore2 := int32(1)
 	affinityScore2 := int32(20)
 	topologySpreadScore3 := int32(0)
@@ -3130,6 +3190,101 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "same affinity and topology spread scores, different resource availability scores",
+			bindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore:       &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore2,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: altBindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: altClusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore:       &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore1,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: anotherBindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: anotherClusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore: &topologySpreadScore1,
+								AffinityScore:       &affinityScore1,
+							},
+						},
+					},
+				},
+			},
+			want: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: anotherBindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: anotherClusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore: &topologySpreadScore1,
+								AffinityScore:       &affinityScore1,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: altBindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: altClusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore:       &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore1,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName,
+						ClusterDecision: placementv1beta1.ClusterDecision{
+							ClusterScore: &placementv1beta1.ClusterScore{
+								TopologySpreadScore:       &topologySpreadScore1,
+								AffinityScore:             &affinityScore1,
+								ResourceAvailabilityScore: &resourceAvailabilityScore2,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3145,10 +3300,13 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 // TestNewSchedulingDecisionsFromBindings tests the newSchedulingDecisionsFromBindings function.
 func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 	topologySpreadScore1 := int32(1)
+	resourceAvailabilityScore1 := int32(30)
 	affinityScore1 := int32(10)
 	topologySpreadScore2 := int32(0)
+	resourceAvailabilityScore2 := int32(50)
 	affinityScore2 := int32(20)
 	topologySpreadScore3 := int32(2)
+	resourceAvailabilityScore3 := int32(70)
 	affinityScore3 := int32(5)
 
 	filteredStatus := NewNonErrorStatus(ClusterUnschedulable, dummyPlugin, dummyReasons...)
@@ -3175,8 +3333,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3191,8 +3350,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore2,
-									AffinityScore:       &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
+									AffinityScore:             &affinityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3205,8 +3365,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3214,8 +3375,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore2,
-						AffinityScore:       &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
+						AffinityScore:             &affinityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3232,8 +3394,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						AffinityScore:       int(affinityScore3),
-						TopologySpreadScore: int(topologySpreadScore3),
+						AffinityScore:             int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
 					},
 				},
 			},
@@ -3248,8 +3411,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3264,8 +3428,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore2,
-									AffinityScore:       &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
+									AffinityScore:             &affinityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3278,8 +3443,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3287,8 +3453,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore2,
-						AffinityScore:       &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
+						AffinityScore:             &affinityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3296,8 +3463,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: anotherClusterName,
 					Selected:    false,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore3,
-						AffinityScore:       &affinityScore3,
+						TopologySpreadScore:       &topologySpreadScore3,
+						ResourceAvailabilityScore: &resourceAvailabilityScore3,
+						AffinityScore:             &affinityScore3,
 					},
 					Reason: notPickedByScoreReason,
 				},
@@ -3314,8 +3482,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						AffinityScore:       int(affinityScore3),
-						TopologySpreadScore: int(topologySpreadScore3),
+						AffinityScore:             int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
 					},
 				},
 			},
@@ -3340,8 +3509,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3354,8 +3524,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3363,8 +3534,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: anotherClusterName,
 					Selected:    false,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore3,
-						AffinityScore:       &affinityScore3,
+						TopologySpreadScore:       &topologySpreadScore3,
+						ResourceAvailabilityScore: &resourceAvailabilityScore3,
+						AffinityScore:             &affinityScore3,
 					},
 					Reason: notPickedByScoreReason,
 				},
@@ -3399,8 +3571,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3415,8 +3588,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore2,
-									AffinityScore:       &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
+									AffinityScore:             &affinityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3429,8 +3603,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3438,8 +3613,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore2,
-						AffinityScore:       &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
+						AffinityScore:             &affinityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3474,8 +3650,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3490,8 +3667,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore2,
-									AffinityScore:       &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
+									AffinityScore:             &affinityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3504,8 +3682,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3513,8 +3692,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore2,
-						AffinityScore:       &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
+						AffinityScore:             &affinityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3531,8 +3711,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 						},
 					},
 					Score: &ClusterScore{
-						AffinityScore:       int(affinityScore3),
-						TopologySpreadScore: int(topologySpreadScore3),
+						AffinityScore:             int(affinityScore3),
+						TopologySpreadScore:       int(topologySpreadScore3),
+						ResourceAvailabilityScore: int(resourceAvailabilityScore3),
 					},
 				},
 			},
@@ -3547,8 +3728,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: clusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore1,
-									AffinityScore:       &affinityScore1,
+									TopologySpreadScore:       &topologySpreadScore1,
+									ResourceAvailabilityScore: &resourceAvailabilityScore1,
+									AffinityScore:             &affinityScore1,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3563,8 +3745,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								ClusterName: altClusterName,
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
-									TopologySpreadScore: &topologySpreadScore2,
-									AffinityScore:       &affinityScore2,
+									TopologySpreadScore:       &topologySpreadScore2,
+									ResourceAvailabilityScore: &resourceAvailabilityScore2,
+									AffinityScore:             &affinityScore2,
 								},
 								Reason: pickedByPolicyReason,
 							},
@@ -3577,8 +3760,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: clusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore1,
-						AffinityScore:       &affinityScore1,
+						TopologySpreadScore:       &topologySpreadScore1,
+						ResourceAvailabilityScore: &resourceAvailabilityScore1,
+						AffinityScore:             &affinityScore1,
 					},
 					Reason: pickedByPolicyReason,
 				},
@@ -3586,8 +3770,9 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					ClusterName: altClusterName,
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
-						TopologySpreadScore: &topologySpreadScore2,
-						AffinityScore:       &affinityScore2,
+						TopologySpreadScore:       &topologySpreadScore2,
+						ResourceAvailabilityScore: &resourceAvailabilityScore2,
+						AffinityScore:             &affinityScore2,
 					},
 					Reason: pickedByPolicyReason,
 				},
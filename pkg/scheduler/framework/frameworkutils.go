@@ -180,6 +180,7 @@ func crossReferencePickedClustersAndDeDupBindings(
 			}
 			affinityScore := int32(scored.Score.AffinityScore)
 			topologySpreadScore := int32(scored.Score.TopologySpreadScore)
+			resourceAvailabilityScore := int32(scored.Score.ResourceAvailabilityScore)
 			binding := &placementv1beta1.ClusterResourceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: name,
@@ -197,8 +198,9 @@ func crossReferencePickedClustersAndDeDupBindings(
 						ClusterName: scored.Cluster.Name,
 						Selected:    true,
 						ClusterScore: &placementv1beta1.ClusterScore{
-							AffinityScore:       &affinityScore,
-							TopologySpreadScore: &topologySpreadScore,
+							AffinityScore:             &affinityScore,
+							TopologySpreadScore:       &topologySpreadScore,
+							ResourceAvailabilityScore: &resourceAvailabilityScore,
 						},
 						Reason: pickedByPolicyReason,
 					},
@@ -218,6 +220,7 @@ func patchBindingFromScoredCluster(binding *placementv1beta1.ClusterResourceBind
 	updated := binding.DeepCopy()
 	affinityScore := int32(scored.Score.AffinityScore)
 	topologySpreadScore := int32(scored.Score.TopologySpreadScore)
+	resourceAvailabilityScore := int32(scored.Score.ResourceAvailabilityScore)
 	// Update the binding so that it is associated with the lastest scheduling policy.
 	updated.Spec.State = desiredState
 	updated.Spec.SchedulingPolicySnapshotName = policy.Name
@@ -226,8 +229,9 @@ func patchBindingFromScoredCluster(binding *placementv1beta1.ClusterResourceBind
 		ClusterName: scored.Cluster.Name,
 		Selected:    true,
 		ClusterScore: &placementv1beta1.ClusterScore{
-			AffinityScore:       &affinityScore,
-			TopologySpreadScore: &topologySpreadScore,
+			AffinityScore:             &affinityScore,
+			TopologySpreadScore:       &topologySpreadScore,
+			ResourceAvailabilityScore: &resourceAvailabilityScore,
 		},
 		Reason: pickedByPolicyReason,
 	}
@@ -300,8 +304,9 @@ func newSchedulingDecisionsFromBindings(
 			ClusterName: sc.Cluster.Name,
 			Selected:    false,
 			ClusterScore: &placementv1beta1.ClusterScore{
-				AffinityScore:       pointer.Int32(int32(sc.Score.AffinityScore)),
-				TopologySpreadScore: pointer.Int32(int32(sc.Score.TopologySpreadScore)),
+				AffinityScore:             pointer.Int32(int32(sc.Score.AffinityScore)),
+				TopologySpreadScore:       pointer.Int32(int32(sc.Score.TopologySpreadScore)),
+				ResourceAvailabilityScore: pointer.Int32(int32(sc.Score.ResourceAvailabilityScore)),
 			},
 			Reason: notPickedByScoreReason,
 		})
@@ -470,6 +475,14 @@ func sortByClusterScoreAndName(bindings []*placementv1beta1.ClusterResourceBindi
 				AffinityScore:       int(*scoreB.AffinityScore),
 				TopologySpreadScore: int(*scoreB.TopologySpreadScore),
 			}
+			// Bindings created before the resource availability score was introduced do not
+			// have this score assigned; treat them as scored 0.
+			if scoreA.ResourceAvailabilityScore != nil {
+				clusterScoreA.ResourceAvailabilityScore = int(*scoreA.ResourceAvailabilityScore)
+			}
+			if scoreB.ResourceAvailabilityScore != nil {
+				clusterScoreB.ResourceAvailabilityScore = int(*scoreB.ResourceAvailabilityScore)
+			}
 
 			if clusterScoreA.Equal(&clusterScoreB) {
 				// Two clusters have the same scores; compare their names instead.
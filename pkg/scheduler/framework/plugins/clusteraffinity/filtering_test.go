@@ -328,6 +328,52 @@ func TestFilter(t *testing.T) {
 			},
 			want: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
 		},
+		{
+			name: "matched cluster by properties",
+			ps: &pluginState{
+				requiredAffinityTerms: []affinityTerm{
+					{
+						selector: labels.SelectorFromSet(map[string]string{"region": "us-west"}),
+					},
+					{
+						selector: labels.Everything(),
+						propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     string(clusterv1beta1.AllocatableCPUCapacityProperty),
+								Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+								Values:   []string{"8"},
+							},
+						},
+					},
+				},
+			},
+			cluster: clusterWithProperties(),
+			want:    nil,
+		},
+		{
+			name: "not matched cluster by properties",
+			ps: &pluginState{
+				requiredAffinityTerms: []affinityTerm{
+					{
+						selector: labels.Everything(),
+						propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     string(clusterv1beta1.AllocatableCPUCapacityProperty),
+								Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+								Values:   []string{"8"},
+							},
+							{
+								Name:     string(clusterv1beta1.KubernetesVersionProperty),
+								Operator: placementv1beta1.PropertySelectorGreaterThan,
+								Values:   []string{"1.28.3"},
+							},
+						},
+					},
+				},
+			},
+			cluster: clusterWithProperties(),
+			want:    framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,161 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package clusteraffinity
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+// validatePropertySelectorRequirement checks if a property selector requirement can be evaluated.
+func validatePropertySelectorRequirement(req *placementv1beta1.PropertySelectorRequirement) error {
+	switch req.Operator {
+	case placementv1beta1.PropertySelectorGreaterThan,
+		placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+		placementv1beta1.PropertySelectorEqualTo,
+		placementv1beta1.PropertySelectorNotEqualTo,
+		placementv1beta1.PropertySelectorLessThan,
+		placementv1beta1.PropertySelectorLessThanOrEqualTo:
+	default:
+		return fmt.Errorf("invalid operator %q in property selector requirement %+v", req.Operator, *req)
+	}
+	if len(req.Values) != 1 {
+		return fmt.Errorf("property selector requirement %+v must have exactly one value, got %d", *req, len(req.Values))
+	}
+	// Compare the expected value with itself to verify that it can be parsed.
+	if _, err := compareProperty(clusterv1beta1.PropertyName(req.Name), req.Values[0], req.Values[0]); err != nil {
+		return fmt.Errorf("invalid value in property selector requirement %+v: %w", *req, err)
+	}
+	return nil
+}
+
+// validatePropertySorter checks if a property sorter can be evaluated.
+func validatePropertySorter(sorter *placementv1beta1.PropertySorter) error {
+	switch sorter.SortOrder {
+	case placementv1beta1.Descending, placementv1beta1.Ascending:
+	default:
+		return fmt.Errorf("invalid sort order %q in property sorter %+v", sorter.SortOrder, *sorter)
+	}
+	if clusterv1beta1.PropertyName(sorter.Name) == clusterv1beta1.KubernetesVersionProperty {
+		return fmt.Errorf("property %q in property sorter %+v cannot be used for sorting", sorter.Name, *sorter)
+	}
+	return nil
+}
+
+// matchesPropertySelectorRequirement returns true if the cluster matches the property selector requirement.
+//
+// A cluster that does not report the property, or reports a value that cannot be parsed, does not match
+// the requirement.
+func matchesPropertySelectorRequirement(req *placementv1beta1.PropertySelectorRequirement, cluster *clusterv1beta1.MemberCluster) bool {
+	name := clusterv1beta1.PropertyName(req.Name)
+	observed, found := retrievePropertyValue(cluster, name)
+	if !found {
+		return false
+	}
+	// The requirement has been validated when the affinity term is processed.
+	res, err := compareProperty(name, observed, req.Values[0])
+	if err != nil {
+		klog.V(2).InfoS("Failed to compare the observed property value", "memberCluster", klog.KObj(cluster), "property", name, "observedValue", observed, "err", err)
+		return false
+	}
+
+	switch req.Operator {
+	case placementv1beta1.PropertySelectorGreaterThan:
+		return res > 0
+	case placementv1beta1.PropertySelectorGreaterThanOrEqualTo:
+		return res >= 0
+	case placementv1beta1.PropertySelectorEqualTo:
+		return res == 0
+	case placementv1beta1.PropertySelectorNotEqualTo:
+		return res != 0
+	case placementv1beta1.PropertySelectorLessThan:
+		return res < 0
+	case placementv1beta1.PropertySelectorLessThanOrEqualTo:
+		return res <= 0
+	default:
+		// This branch should never be reached, as the operator has been validated.
+		return false
+	}
+}
+
+// retrievePropertyValue returns the observed value of a property in the status of a member cluster.
+//
+// The resource properties are read from the resource usage; all the other properties are read
+// from the reported properties.
+func retrievePropertyValue(cluster *clusterv1beta1.MemberCluster, name clusterv1beta1.PropertyName) (string, bool) {
+	var resources corev1.ResourceList
+	var resourceName corev1.ResourceName
+	switch name {
+	case clusterv1beta1.TotalCPUCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Capacity, corev1.ResourceCPU
+	case clusterv1beta1.AllocatableCPUCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Allocatable, corev1.ResourceCPU
+	case clusterv1beta1.TotalMemoryCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Capacity, corev1.ResourceMemory
+	case clusterv1beta1.AllocatableMemoryCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Allocatable, corev1.ResourceMemory
+	case clusterv1beta1.AvailableCPUCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Available, corev1.ResourceCPU
+	case clusterv1beta1.AvailableMemoryCapacityProperty:
+		resources, resourceName = cluster.Status.ResourceUsage.Available, corev1.ResourceMemory
+	default:
+		v, found := cluster.Status.Properties[name]
+		return v.Value, found
+	}
+	q, found := resources[resourceName]
+	if !found {
+		return "", false
+	}
+	return q.String(), true
+}
+
+// retrieveSortablePropertyValue returns the observed value of a property in the status of a member
+// cluster as a number; it returns false if the cluster does not report the property, or reports a
+// value that is not a valid quantity.
+func retrieveSortablePropertyValue(cluster *clusterv1beta1.MemberCluster, name clusterv1beta1.PropertyName) (float64, bool) {
+	observed, found := retrievePropertyValue(cluster, name)
+	if !found {
+		return 0, false
+	}
+	q, err := resource.ParseQuantity(observed)
+	if err != nil {
+		klog.V(2).InfoS("Failed to parse the observed property value", "memberCluster", klog.KObj(cluster), "property", name, "observedValue", observed, "err", err)
+		return 0, false
+	}
+	return q.AsApproximateFloat64(), true
+}
+
+// compareProperty compares the observed value of a property with the expected one; it returns
+// -1, 0, or 1 if the observed value is less than, equal to, or greater than the expected value.
+//
+// The values of the Kubernetes version property are compared as versions; all the other values
+// are compared as Kubernetes quantities.
+func compareProperty(name clusterv1beta1.PropertyName, observed, expected string) (int, error) {
+	if name == clusterv1beta1.KubernetesVersionProperty {
+		observedVersion, err := version.ParseGeneric(observed)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse version %q: %w", observed, err)
+		}
+		return observedVersion.Compare(expected)
+	}
+
+	observedQuantity, err := resource.ParseQuantity(observed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse quantity %q: %w", observed, err)
+	}
+	expectedQuantity, err := resource.ParseQuantity(expected)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse quantity %q: %w", expected, err)
+	}
+	return observedQuantity.Cmp(expectedQuantity), nil
+}
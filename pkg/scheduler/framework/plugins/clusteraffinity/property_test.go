@@ -0,0 +1,286 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package clusteraffinity
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func clusterWithProperties() *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("40Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8500m"),
+					corev1.ResourceMemory: resource.MustParse("32Gi"),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				clusterv1beta1.NodeCountProperty: {
+					Value: "3",
+				},
+				clusterv1beta1.KubernetesVersionProperty: {
+					Value: "v1.28.3",
+				},
+				"invalid-quantity": {
+					Value: "abc",
+				},
+			},
+		},
+	}
+}
+
+func TestMatchesPropertySelectorRequirement(t *testing.T) {
+	tests := []struct {
+		name string
+		req  placementv1beta1.PropertySelectorRequirement
+		want bool
+	}{
+		{
+			name: "node count greater than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"2"},
+			},
+			want: true,
+		},
+		{
+			name: "node count not greater than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"3"},
+			},
+			want: false,
+		},
+		{
+			name: "node count greater than or equal to",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+				Values:   []string{"3"},
+			},
+			want: true,
+		},
+		{
+			name: "node count not equal to",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorNotEqualTo,
+				Values:   []string{"3"},
+			},
+			want: false,
+		},
+		{
+			name: "allocatable cpu equal to (different format)",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.AllocatableCPUCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorEqualTo,
+				Values:   []string{"8.5"},
+			},
+			want: true,
+		},
+		{
+			name: "total cpu less than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.TotalCPUCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorLessThan,
+				Values:   []string{"10"},
+			},
+			want: false,
+		},
+		{
+			name: "allocatable memory less than or equal to",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.AllocatableMemoryCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorLessThanOrEqualTo,
+				Values:   []string{"32Gi"},
+			},
+			want: true,
+		},
+		{
+			name: "total memory greater than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.TotalMemoryCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"64Gi"},
+			},
+			want: false,
+		},
+		{
+			name: "available cpu greater than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.AvailableCPUCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"1500m"},
+			},
+			want: true,
+		},
+		{
+			name: "available memory less than",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.AvailableMemoryCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorLessThan,
+				Values:   []string{"4Gi"},
+			},
+			want: false,
+		},
+		{
+			name: "kubernetes version greater than or equal to",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.KubernetesVersionProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+				Values:   []string{"1.28"},
+			},
+			want: true,
+		},
+		{
+			name: "kubernetes version compared as a version rather than a quantity",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.KubernetesVersionProperty),
+				Operator: placementv1beta1.PropertySelectorLessThan,
+				Values:   []string{"1.3"},
+			},
+			want: false,
+		},
+		{
+			name: "property not reported",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     "example.com/gpu-count",
+				Operator: placementv1beta1.PropertySelectorLessThan,
+				Values:   []string{"1"},
+			},
+			want: false,
+		},
+		{
+			name: "reported property value cannot be parsed",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     "invalid-quantity",
+				Operator: placementv1beta1.PropertySelectorNotEqualTo,
+				Values:   []string{"1"},
+			},
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesPropertySelectorRequirement(&tc.req, clusterWithProperties()); got != tc.want {
+				t.Errorf("matchesPropertySelectorRequirement() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesPropertySelectorRequirement_ResourceNotReported(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+	}
+	req := placementv1beta1.PropertySelectorRequirement{
+		Name:     string(clusterv1beta1.AllocatableCPUCapacityProperty),
+		Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+		Values:   []string{"0"},
+	}
+	if matchesPropertySelectorRequirement(&req, cluster) {
+		t.Errorf("matchesPropertySelectorRequirement() = true, want false")
+	}
+}
+
+func TestValidatePropertySelectorRequirement(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     placementv1beta1.PropertySelectorRequirement
+		wantErr bool
+	}{
+		{
+			name: "valid quantity",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.AllocatableMemoryCapacityProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"16Gi"},
+			},
+		},
+		{
+			name: "valid version",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.KubernetesVersionProperty),
+				Operator: placementv1beta1.PropertySelectorGreaterThan,
+				Values:   []string{"1.27.1"},
+			},
+		},
+		{
+			name: "invalid operator",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: "In",
+				Values:   []string{"1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no values",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorEqualTo,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many values",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorEqualTo,
+				Values:   []string{"1", "2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid quantity",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.NodeCountProperty),
+				Operator: placementv1beta1.PropertySelectorEqualTo,
+				Values:   []string{"three"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid version",
+			req: placementv1beta1.PropertySelectorRequirement{
+				Name:     string(clusterv1beta1.KubernetesVersionProperty),
+				Operator: placementv1beta1.PropertySelectorEqualTo,
+				Values:   []string{"latest"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validatePropertySelectorRequirement(&tc.req); (err != nil) != tc.wantErr {
+				t.Errorf("validatePropertySelectorRequirement() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -48,6 +48,12 @@ func (p *Plugin) PreScore(
 		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no preferred cluster affinity term is present or all of the terms are empty")
 	}
 
+	if ps.preferredAffinityTerms.HasPropertySorters() {
+		// Normalize the observed values of the sorted properties across the clusters in the
+		// current scheduling cycle.
+		ps.preferredAffinityTerms.ObservePropertyRanges(state.ListClusters())
+	}
+
 	// All done.
 	return nil
 }
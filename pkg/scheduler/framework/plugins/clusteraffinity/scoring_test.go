@@ -112,6 +112,74 @@ func TestPreScore(t *testing.T) {
 	}
 }
 
+func TestPreScore_PropertySorters(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		*clusterWithProperties(),
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-2",
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					clusterv1beta1.NodeCountProperty: {
+						Value: "7",
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-3",
+			},
+		},
+	}
+	ps := &pluginState{
+		preferredAffinityTerms: []preferredAffinityTerm{
+			{
+				affinityTerm: affinityTerm{
+					selector: labels.Everything(),
+				},
+				weight: 20,
+				propertySorter: &placementv1beta1.PropertySorter{
+					Name:      string(clusterv1beta1.NodeCountProperty),
+					SortOrder: placementv1beta1.Descending,
+				},
+			},
+		},
+	}
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{},
+				},
+			},
+		},
+	}
+
+	p := New()
+	state := framework.NewCycleState(clusters, nil)
+	state.Write(framework.StateKey(p.Name()), ps)
+	if status := p.PreScore(context.Background(), state, policy); status != nil {
+		t.Fatalf("PreScore() = %v, want nil", status)
+	}
+
+	want := []*framework.ClusterScore{
+		{AffinityScore: 0},
+		{AffinityScore: 20},
+		{AffinityScore: 0},
+	}
+	for i := range clusters {
+		got, status := p.Score(context.Background(), state, policy, &clusters[i])
+		if status != nil {
+			t.Fatalf("Score(%s) status = %v, want nil", clusters[i].Name, status)
+		}
+		if diff := cmp.Diff(want[i], got); diff != "" {
+			t.Errorf("Score(%s) mismatch (-want, +got):\n%s", clusters[i].Name, diff)
+		}
+	}
+}
+
 func TestPluginScore(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -166,6 +234,41 @@ func TestPluginScore(t *testing.T) {
 			},
 			wantScore: &framework.ClusterScore{AffinityScore: -3},
 		},
+		{
+			name: "have preferred affinity terms with property selectors",
+			ps: &pluginState{
+				preferredAffinityTerms: []preferredAffinityTerm{
+					{
+						affinityTerm: affinityTerm{
+							selector: labels.Everything(),
+							propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+								{
+									Name:     string(clusterv1beta1.NodeCountProperty),
+									Operator: placementv1beta1.PropertySelectorGreaterThan,
+									Values:   []string{"2"},
+								},
+							},
+						},
+						weight: 10,
+					},
+					{
+						affinityTerm: affinityTerm{
+							selector: labels.Everything(),
+							propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+								{
+									Name:     string(clusterv1beta1.TotalMemoryCapacityProperty),
+									Operator: placementv1beta1.PropertySelectorGreaterThan,
+									Values:   []string{"64Gi"},
+								},
+							},
+						},
+						weight: 20,
+					},
+				},
+			},
+			cluster:   clusterWithProperties(),
+			wantScore: &framework.ClusterScore{AffinityScore: 10},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
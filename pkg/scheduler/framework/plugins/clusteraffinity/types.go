@@ -6,6 +6,8 @@ Licensed under the MIT license.
 package clusteraffinity
 
 import (
+	"math"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -16,11 +18,21 @@ import (
 // affinityTerm is a processed version of ClusterSelectorTerm.
 type affinityTerm struct {
 	selector labels.Selector
+	// propertyRequirements are the validated requirements of the property selector; they are `ANDed`.
+	propertyRequirements []placementv1beta1.PropertySelectorRequirement
 }
 
-// Matches returns true if the cluster matches the label selector.
+// Matches returns true if the cluster matches both the label selector and the property requirements.
 func (at *affinityTerm) Matches(cluster *clusterv1beta1.MemberCluster) bool {
-	return at.selector.Matches(labels.Set(cluster.Labels))
+	if !at.selector.Matches(labels.Set(cluster.Labels)) {
+		return false
+	}
+	for i := range at.propertyRequirements {
+		if !matchesPropertySelectorRequirement(&at.propertyRequirements[i], cluster) {
+			return false
+		}
+	}
+	return true
 }
 
 // AffinityTerms is a "processed" representation of []ClusterSelectorTerms.
@@ -41,28 +53,115 @@ func (at AffinityTerms) Matches(cluster *clusterv1beta1.MemberCluster) bool {
 type preferredAffinityTerm struct {
 	affinityTerm
 	weight int32
+	// propertySorter, if present, sorts the matching clusters by a property, so that each cluster
+	// receives a share of the weight per its observed value of the property.
+	propertySorter *placementv1beta1.PropertySorter
+	// observedRange is the range of the observed values of the sorted property across all the
+	// matching clusters in the scheduling cycle; it is set at the PreScore stage and is nil if
+	// no matching cluster reports the property.
+	observedRange *observedPropertyRange
+}
+
+// observedPropertyRange is the range of the observed values of a property.
+type observedPropertyRange struct {
+	min float64
+	max float64
+}
+
+// weightFor returns the weight the term assigns to a cluster that matches the term.
+func (t *preferredAffinityTerm) weightFor(cluster *clusterv1beta1.MemberCluster) int32 {
+	if t.propertySorter == nil {
+		return t.weight
+	}
+
+	value, found := retrieveSortablePropertyValue(cluster, clusterv1beta1.PropertyName(t.propertySorter.Name))
+	if !found || t.observedRange == nil {
+		return 0
+	}
+	if t.observedRange.max == t.observedRange.min {
+		// All the matching clusters report the same value; each of them receives the full weight.
+		return t.weight
+	}
+
+	ratio := (value - t.observedRange.min) / (t.observedRange.max - t.observedRange.min)
+	if t.propertySorter.SortOrder == placementv1beta1.Ascending {
+		ratio = 1 - ratio
+	}
+	return int32(math.Round(float64(t.weight) * ratio))
+}
+
+// observe updates the observed range of the sorted property with the value reported by a cluster.
+func (t *preferredAffinityTerm) observe(cluster *clusterv1beta1.MemberCluster) {
+	value, found := retrieveSortablePropertyValue(cluster, clusterv1beta1.PropertyName(t.propertySorter.Name))
+	if !found {
+		return
+	}
+	if t.observedRange == nil {
+		t.observedRange = &observedPropertyRange{min: value, max: value}
+		return
+	}
+	t.observedRange.min = math.Min(t.observedRange.min, value)
+	t.observedRange.max = math.Max(t.observedRange.max, value)
 }
 
 // PreferredAffinityTerms is a "processed" representation of []PreferredClusterSelector.
 type PreferredAffinityTerms []preferredAffinityTerm
 
-// Score returns a score for a cluster: the sum of the weights of the terms that match the cluster.
+// Score returns a score for a cluster: the sum of the weights the terms that match the cluster
+// assign to it. A term without a property sorter assigns its full weight to every matching
+// cluster; a term with a property sorter assigns each matching cluster a share of its weight,
+// normalized across the clusters observed with ObservePropertyRanges.
 func (t PreferredAffinityTerms) Score(cluster *clusterv1beta1.MemberCluster) int32 {
 	var score int32
-	for _, term := range t {
-		if term.affinityTerm.Matches(cluster) {
-			score += term.weight
+	for i := range t {
+		if t[i].affinityTerm.Matches(cluster) {
+			score += t[i].weightFor(cluster)
 		}
 	}
 	return score
 }
 
+// HasPropertySorters returns true if any of the terms sorts clusters by a property.
+func (t PreferredAffinityTerms) HasPropertySorters() bool {
+	for i := range t {
+		if t[i].propertySorter != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ObservePropertyRanges records, for each term with a property sorter, the range of the observed
+// values of the sorted property across the given clusters that match the term.
+func (t PreferredAffinityTerms) ObservePropertyRanges(clusters []clusterv1beta1.MemberCluster) {
+	for i := range t {
+		if t[i].propertySorter == nil {
+			continue
+		}
+		t[i].observedRange = nil
+		for j := range clusters {
+			if t[i].affinityTerm.Matches(&clusters[j]) {
+				t[i].observe(&clusters[j])
+			}
+		}
+	}
+}
+
 func newAffinityTerm(term *placementv1beta1.ClusterSelectorTerm) (*affinityTerm, error) {
 	selector, err := metav1.LabelSelectorAsSelector(&term.LabelSelector)
 	if err != nil {
 		return nil, err
 	}
-	return &affinityTerm{selector: selector}, nil
+	t := &affinityTerm{selector: selector}
+	if term.PropertySelector != nil && len(term.PropertySelector.MatchExpressions) > 0 {
+		for i := range term.PropertySelector.MatchExpressions {
+			if err := validatePropertySelectorRequirement(&term.PropertySelector.MatchExpressions[i]); err != nil {
+				return nil, err
+			}
+		}
+		t.propertyRequirements = term.PropertySelector.MatchExpressions
+	}
+	return t, nil
 }
 
 // NewAffinityTerms returns the list of processed affinity terms.
@@ -75,7 +174,7 @@ func NewAffinityTerms(terms []placementv1beta1.ClusterSelectorTerm) (AffinityTer
 		}
 		t, err := newAffinityTerm(&terms[i])
 		if err != nil {
-			// We get here if the label selector failed to process
+			// We get here if the label selector or the property selector failed to process
 			return nil, err
 		}
 		res = append(res, *t)
@@ -87,20 +186,26 @@ func NewAffinityTerms(terms []placementv1beta1.ClusterSelectorTerm) (AffinityTer
 func NewPreferredAffinityTerms(terms []placementv1beta1.PreferredClusterSelector) (PreferredAffinityTerms, error) {
 	res := make([]preferredAffinityTerm, 0, len(terms))
 	for i, term := range terms {
-		// skipping for weight == 0 or empty terms
-		if term.Weight == 0 || isEmptyClusterSelectorTerm(term.Preference) {
+		// skipping for weight == 0 or empty terms; a term with only a property sorter applies to all clusters
+		if term.Weight == 0 || (isEmptyClusterSelectorTerm(term.Preference) && term.Preference.PropertySorter == nil) {
 			continue
 		}
 		t, err := newAffinityTerm(&term.Preference)
 		if err != nil {
-			// We get here if the label selector failed to process
+			// We get here if the label selector or the property selector failed to process
 			return nil, err
 		}
-		res = append(res, preferredAffinityTerm{affinityTerm: *t, weight: terms[i].Weight})
+		if term.Preference.PropertySorter != nil {
+			if err := validatePropertySorter(term.Preference.PropertySorter); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, preferredAffinityTerm{affinityTerm: *t, weight: terms[i].Weight, propertySorter: terms[i].Preference.PropertySorter})
 	}
 	return res, nil
 }
 
 func isEmptyClusterSelectorTerm(term placementv1beta1.ClusterSelectorTerm) bool {
-	return len(term.LabelSelector.MatchLabels) == 0 && len(term.LabelSelector.MatchExpressions) == 0
+	return len(term.LabelSelector.MatchLabels) == 0 && len(term.LabelSelector.MatchExpressions) == 0 &&
+		(term.PropertySelector == nil || len(term.PropertySelector.MatchExpressions) == 0)
 }
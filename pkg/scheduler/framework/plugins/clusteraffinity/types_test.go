@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -87,6 +89,64 @@ func TestMatches(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "label and property matched cluster",
+			term: &affinityTerm{
+				selector: labels.SelectorFromSet(map[string]string{"region": "us-west"}),
+				propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     string(clusterv1beta1.NodeCountProperty),
+						Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+						Values:   []string{"3"},
+					},
+				},
+			},
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+					Labels: map[string]string{
+						"region": "us-west",
+					},
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						clusterv1beta1.NodeCountProperty: {
+							Value: "3",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "label matched but property mismatched cluster",
+			term: &affinityTerm{
+				selector: labels.SelectorFromSet(map[string]string{"region": "us-west"}),
+				propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     string(clusterv1beta1.NodeCountProperty),
+						Operator: placementv1beta1.PropertySelectorGreaterThan,
+						Values:   []string{"3"},
+					},
+				},
+			},
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+					Labels: map[string]string{
+						"region": "us-west",
+					},
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						clusterv1beta1.NodeCountProperty: {
+							Value: "3",
+						},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -365,6 +425,37 @@ func TestNewAffinityTerms(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "nonempty terms with property selector",
+			terms: []placementv1beta1.ClusterSelectorTerm{
+				{
+					PropertySelector: &placementv1beta1.PropertySelector{
+						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     string(clusterv1beta1.NodeCountProperty),
+								Operator: placementv1beta1.PropertySelectorGreaterThan,
+								Values:   []string{"2"},
+							},
+						},
+					},
+				},
+				{
+					PropertySelector: &placementv1beta1.PropertySelector{},
+				},
+			},
+			want: []affinityTerm{
+				{
+					selector: labels.SelectorFromSet(map[string]string{}),
+					propertyRequirements: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     string(clusterv1beta1.NodeCountProperty),
+							Operator: placementv1beta1.PropertySelectorGreaterThan,
+							Values:   []string{"2"},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -379,6 +470,184 @@ func TestNewAffinityTerms(t *testing.T) {
 	}
 }
 
+func TestPreferredAffinityTermsScore_PropertySorters(t *testing.T) {
+	clusterWithAvailableMemory := func(name, memory string, labels map[string]string) clusterv1beta1.MemberCluster {
+		cluster := clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+		}
+		if memory != "" {
+			cluster.Status.ResourceUsage.Available = corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse(memory),
+			}
+		}
+		return cluster
+	}
+	clusters := []clusterv1beta1.MemberCluster{
+		clusterWithAvailableMemory("cluster-1", "4Gi", map[string]string{"region": "us-west"}),
+		clusterWithAvailableMemory("cluster-2", "8Gi", map[string]string{"region": "us-west"}),
+		clusterWithAvailableMemory("cluster-3", "20Gi", map[string]string{"region": "us-west"}),
+		clusterWithAvailableMemory("cluster-4", "", map[string]string{"region": "us-west"}),
+		clusterWithAvailableMemory("cluster-5", "100Gi", map[string]string{"region": "us-east"}),
+	}
+
+	tests := []struct {
+		name  string
+		terms PreferredAffinityTerms
+		want  map[string]int32
+	}{
+		{
+			name: "descending order",
+			terms: []preferredAffinityTerm{
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.SelectorFromSet(map[string]string{"region": "us-west"}),
+					},
+					weight: 20,
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+						SortOrder: placementv1beta1.Descending,
+					},
+				},
+			},
+			want: map[string]int32{
+				"cluster-1": 0,
+				"cluster-2": 5,
+				"cluster-3": 20,
+				"cluster-4": 0,
+				"cluster-5": 0,
+			},
+		},
+		{
+			name: "ascending order, combined with a term without property sorter",
+			terms: []preferredAffinityTerm{
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.SelectorFromSet(map[string]string{"region": "us-west"}),
+					},
+					weight: 20,
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+						SortOrder: placementv1beta1.Ascending,
+					},
+				},
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.SelectorFromSet(map[string]string{"region": "us-east"}),
+					},
+					weight: 10,
+				},
+			},
+			want: map[string]int32{
+				"cluster-1": 20,
+				"cluster-2": 15,
+				"cluster-3": 0,
+				"cluster-4": 0,
+				"cluster-5": 10,
+			},
+		},
+		{
+			name: "negative weight",
+			terms: []preferredAffinityTerm{
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.Everything(),
+					},
+					weight: -48,
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+						SortOrder: placementv1beta1.Descending,
+					},
+				},
+			},
+			want: map[string]int32{
+				"cluster-1": 0,
+				"cluster-2": -2,
+				"cluster-3": -8,
+				"cluster-4": 0,
+				"cluster-5": -48,
+			},
+		},
+		{
+			name: "single matching cluster",
+			terms: []preferredAffinityTerm{
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.SelectorFromSet(map[string]string{"region": "us-east"}),
+					},
+					weight: 30,
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+						SortOrder: placementv1beta1.Ascending,
+					},
+				},
+			},
+			want: map[string]int32{
+				"cluster-1": 0,
+				"cluster-2": 0,
+				"cluster-3": 0,
+				"cluster-4": 0,
+				"cluster-5": 30,
+			},
+		},
+		{
+			name: "no matching cluster reports the property",
+			terms: []preferredAffinityTerm{
+				{
+					affinityTerm: affinityTerm{
+						selector: labels.Everything(),
+					},
+					weight: 30,
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.NodeCountProperty),
+						SortOrder: placementv1beta1.Descending,
+					},
+				},
+			},
+			want: map[string]int32{
+				"cluster-1": 0,
+				"cluster-2": 0,
+				"cluster-3": 0,
+				"cluster-4": 0,
+				"cluster-5": 0,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.terms.ObservePropertyRanges(clusters)
+			got := make(map[string]int32, len(clusters))
+			for i := range clusters {
+				got[clusters[i].Name] = tc.terms.Score(&clusters[i])
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Score() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAffinityTerms_InvalidPropertySelector(t *testing.T) {
+	terms := []placementv1beta1.ClusterSelectorTerm{
+		{
+			PropertySelector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     string(clusterv1beta1.NodeCountProperty),
+						Operator: placementv1beta1.PropertySelectorGreaterThan,
+						Values:   []string{"two"},
+					},
+				},
+			},
+		},
+	}
+	if _, err := NewAffinityTerms(terms); err == nil {
+		t.Errorf("NewAffinityTerms() got nil error, want error")
+	}
+}
+
 func TestNewPreferredAffinityTerms(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -441,6 +710,32 @@ func TestNewPreferredAffinityTerms(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "term with a property sorter only",
+			terms: []placementv1beta1.PreferredClusterSelector{
+				{
+					Preference: placementv1beta1.ClusterSelectorTerm{
+						PropertySorter: &placementv1beta1.PropertySorter{
+							Name:      string(clusterv1beta1.AvailableCPUCapacityProperty),
+							SortOrder: placementv1beta1.Descending,
+						},
+					},
+					Weight: 10,
+				},
+			},
+			want: []preferredAffinityTerm{
+				{
+					weight: 10,
+					affinityTerm: affinityTerm{
+						selector: labels.SelectorFromSet(map[string]string{}),
+					},
+					propertySorter: &placementv1beta1.PropertySorter{
+						Name:      string(clusterv1beta1.AvailableCPUCapacityProperty),
+						SortOrder: placementv1beta1.Descending,
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -454,3 +749,40 @@ func TestNewPreferredAffinityTerms(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPreferredAffinityTerms_InvalidPropertySorter(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorter *placementv1beta1.PropertySorter
+	}{
+		{
+			name: "invalid sort order",
+			sorter: &placementv1beta1.PropertySorter{
+				Name:      string(clusterv1beta1.NodeCountProperty),
+				SortOrder: "Random",
+			},
+		},
+		{
+			name: "Kubernetes version property",
+			sorter: &placementv1beta1.PropertySorter{
+				Name:      string(clusterv1beta1.KubernetesVersionProperty),
+				SortOrder: placementv1beta1.Ascending,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			terms := []placementv1beta1.PreferredClusterSelector{
+				{
+					Preference: placementv1beta1.ClusterSelectorTerm{
+						PropertySorter: tc.sorter,
+					},
+					Weight: 10,
+				},
+			}
+			if _, err := NewPreferredAffinityTerms(terms); err == nil {
+				t.Errorf("NewPreferredAffinityTerms() got nil error, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package resourceavailability features a scheduler plugin that scores clusters based on
+// their allocatable and available resources (CPU and memory).
+package resourceavailability
+
+import (
+	"fmt"
+
+	"go.goms.io/fleet/pkg/scheduler/framework"
+)
+
+const (
+	// defaultPluginName is the default name of the plugin.
+	defaultPluginName = "ResourceAvailability"
+)
+
+// ScoringStrategy is the strategy the plugin uses to score clusters by their resources.
+type ScoringStrategy string
+
+const (
+	// LeastAllocated favors clusters with a larger share of their allocatable resources still
+	// available; it helps spread placements across clusters.
+	LeastAllocated ScoringStrategy = "LeastAllocated"
+	// MostAllocated favors clusters with a smaller share of their allocatable resources still
+	// available; it helps bin-pack placements onto fewer clusters.
+	MostAllocated ScoringStrategy = "MostAllocated"
+	// BalancedAllocation favors clusters whose CPU and memory are allocated at similar ratios.
+	BalancedAllocation ScoringStrategy = "BalancedAllocation"
+)
+
+// ParseScoringStrategy parses a string into a ScoringStrategy.
+func ParseScoringStrategy(str string) (ScoringStrategy, error) {
+	switch s := ScoringStrategy(str); s {
+	case LeastAllocated, MostAllocated, BalancedAllocation:
+		return s, nil
+	default:
+		return "", fmt.Errorf("must be one of %q, %q or %q", LeastAllocated, MostAllocated, BalancedAllocation)
+	}
+}
+
+// Plugin is the scheduler plugin that scores clusters based on their resource availability.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+	// The strategy to use when scoring clusters.
+	strategy ScoringStrategy
+
+	// The framework handle.
+	handle framework.Handle
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points
+	// at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * Score
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.ScorePlugin = &Plugin{}
+)
+
+// pluginOptions is the options for this plugin.
+type pluginOptions struct {
+	// The name of the plugin.
+	name string
+	// The strategy to use when scoring clusters.
+	strategy ScoringStrategy
+}
+
+// Option helps set up the plugin.
+type Option func(*pluginOptions)
+
+// defaultPluginOptions is the default options for this plugin.
+var defaultPluginOptions = pluginOptions{
+	name:     defaultPluginName,
+	strategy: LeastAllocated,
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *pluginOptions) {
+		o.name = name
+	}
+}
+
+// WithScoringStrategy sets the strategy the plugin uses to score clusters.
+func WithScoringStrategy(strategy ScoringStrategy) Option {
+	return func(o *pluginOptions) {
+		o.strategy = strategy
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name:     options.name,
+		strategy: options.strategy,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+
+	// This plugin does not need to set up any informer.
+}
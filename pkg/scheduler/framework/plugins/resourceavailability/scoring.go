@@ -0,0 +1,110 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package resourceavailability
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework"
+)
+
+const (
+	// maxResourceAvailabilityScore is the highest resource availability score a cluster can get.
+	maxResourceAvailabilityScore = 100
+)
+
+var (
+	// scoredResourceNames are the resources the plugin considers when scoring clusters.
+	scoredResourceNames = []corev1.ResourceName{
+		corev1.ResourceCPU,
+		corev1.ResourceMemory,
+	}
+)
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+func (p *Plugin) Score(
+	_ context.Context,
+	_ framework.CycleStatePluginReadWriter,
+	_ *placementv1beta1.ClusterSchedulingPolicySnapshot,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	allocatedRatios, ok := allocatedRatiosOf(cluster)
+	if !ok {
+		// The cluster does not report its allocatable and available resources (yet); it is
+		// not possible to tell how favorable the cluster is, and as a result, no score is
+		// assigned.
+		return &framework.ClusterScore{}, nil
+	}
+
+	var ratio float64
+	switch p.strategy {
+	case LeastAllocated:
+		ratio = 1 - mean(allocatedRatios)
+	case MostAllocated:
+		ratio = mean(allocatedRatios)
+	case BalancedAllocation:
+		ratio = 1 - stdDev(allocatedRatios)
+	default:
+		// Normally this should never occur, as the strategy is validated when the scheduler
+		// starts.
+		return nil, framework.FromError(fmt.Errorf("unknown resource scoring strategy %q", p.strategy), p.Name())
+	}
+
+	return &framework.ClusterScore{
+		ResourceAvailabilityScore: int(math.Round(ratio * maxResourceAvailabilityScore)),
+	}, nil
+}
+
+// allocatedRatiosOf returns the ratio of allocated resources to allocatable resources, i.e.,
+// (allocatable - available) / allocatable, for each scored resource of a cluster; it returns
+// false if the cluster does not report the allocatable or available capacity of any scored
+// resource.
+func allocatedRatiosOf(cluster *clusterv1beta1.MemberCluster) ([]float64, bool) {
+	allocatable := cluster.Status.ResourceUsage.Allocatable
+	available := cluster.Status.ResourceUsage.Available
+
+	ratios := make([]float64, 0, len(scoredResourceNames))
+	for _, name := range scoredResourceNames {
+		allocatableQ, ok := allocatable[name]
+		if !ok || allocatableQ.Sign() <= 0 {
+			return nil, false
+		}
+		availableQ, ok := available[name]
+		if !ok {
+			return nil, false
+		}
+
+		ratio := 1 - availableQ.AsApproximateFloat64()/allocatableQ.AsApproximateFloat64()
+		// Guard against inconsistent reports, e.g., more available resources than allocatable ones.
+		ratios = append(ratios, math.Min(math.Max(ratio, 0), 1))
+	}
+	return ratios, true
+}
+
+// mean returns the mean of a list of values.
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// stdDev returns the population standard deviation of a list of values.
+func stdDev(values []float64) float64 {
+	m := mean(values)
+	var variance float64
+	for _, v := range values {
+		variance += (v - m) * (v - m)
+	}
+	return math.Sqrt(variance / float64(len(values)))
+}
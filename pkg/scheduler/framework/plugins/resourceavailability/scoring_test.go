@@ -0,0 +1,204 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package resourceavailability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName = "bravelion"
+)
+
+func clusterWithResources(allocatableCPU, availableCPU, allocatableMemory, availableMemory string) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+					corev1.ResourceMemory: resource.MustParse(allocatableMemory),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(availableCPU),
+					corev1.ResourceMemory: resource.MustParse(availableMemory),
+				},
+			},
+		},
+	}
+}
+
+// TestScore tests the Score extension point of this plugin.
+func TestScore(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy ScoringStrategy
+		cluster  *clusterv1beta1.MemberCluster
+		want     *framework.ClusterScore
+	}{
+		{
+			name:     "no resource usage reported",
+			strategy: LeastAllocated,
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+			},
+			want: &framework.ClusterScore{},
+		},
+		{
+			name:     "no available memory reported",
+			strategy: LeastAllocated,
+			cluster: func() *clusterv1beta1.MemberCluster {
+				cluster := clusterWithResources("4", "2", "16Gi", "8Gi")
+				delete(cluster.Status.ResourceUsage.Available, corev1.ResourceMemory)
+				return cluster
+			}(),
+			want: &framework.ClusterScore{},
+		},
+		{
+			name:     "zero allocatable CPU",
+			strategy: MostAllocated,
+			cluster:  clusterWithResources("0", "0", "16Gi", "8Gi"),
+			want:     &framework.ClusterScore{},
+		},
+		{
+			name:     "least allocated",
+			strategy: LeastAllocated,
+			// 25% of CPU and 50% of memory are allocated.
+			cluster: clusterWithResources("4", "3", "16Gi", "8Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 63,
+			},
+		},
+		{
+			name:     "least allocated, fully available",
+			strategy: LeastAllocated,
+			cluster:  clusterWithResources("4", "4", "16Gi", "16Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 100,
+			},
+		},
+		{
+			name:     "least allocated, more available than allocatable",
+			strategy: LeastAllocated,
+			cluster:  clusterWithResources("4", "8", "16Gi", "16Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 100,
+			},
+		},
+		{
+			name:     "most allocated",
+			strategy: MostAllocated,
+			// 25% of CPU and 50% of memory are allocated.
+			cluster: clusterWithResources("4", "3", "16Gi", "8Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 38,
+			},
+		},
+		{
+			name:     "most allocated, fully allocated",
+			strategy: MostAllocated,
+			cluster:  clusterWithResources("4", "0", "16Gi", "0"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 100,
+			},
+		},
+		{
+			name:     "balanced allocation",
+			strategy: BalancedAllocation,
+			// 25% of CPU and 75% of memory are allocated.
+			cluster: clusterWithResources("4", "3", "16Gi", "4Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 75,
+			},
+		},
+		{
+			name:     "balanced allocation, evenly allocated",
+			strategy: BalancedAllocation,
+			cluster:  clusterWithResources("4", "2", "16Gi", "8Gi"),
+			want: &framework.ClusterScore{
+				ResourceAvailabilityScore: 100,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New(WithScoringStrategy(tc.strategy))
+			score, status := p.Score(context.Background(), framework.NewCycleState(nil, nil), nil, tc.cluster)
+			if !status.IsSuccess() {
+				t.Fatalf("Score() = %v, want success", status)
+			}
+			if diff := cmp.Diff(score, tc.want); diff != "" {
+				t.Errorf("Score() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestScore_UnknownStrategy tests the Score extension point of this plugin with an unknown strategy.
+func TestScore_UnknownStrategy(t *testing.T) {
+	p := New(WithScoringStrategy("Unknown"))
+	_, status := p.Score(context.Background(), framework.NewCycleState(nil, nil), nil, clusterWithResources("4", "2", "16Gi", "8Gi"))
+	if !status.IsInteralError() {
+		t.Fatalf("Score() = %v, want internal error", status)
+	}
+}
+
+// TestParseScoringStrategy tests the ParseScoringStrategy function.
+func TestParseScoringStrategy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		str     string
+		want    ScoringStrategy
+		wantErr bool
+	}{
+		{
+			name: "least allocated",
+			str:  "LeastAllocated",
+			want: LeastAllocated,
+		},
+		{
+			name: "most allocated",
+			str:  "MostAllocated",
+			want: MostAllocated,
+		},
+		{
+			name: "balanced allocation",
+			str:  "BalancedAllocation",
+			want: BalancedAllocation,
+		},
+		{
+			name:    "unknown strategy",
+			str:     "leastallocated",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseScoringStrategy(tc.str)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ParseScoringStrategy(%q) error = %v, want error %t", tc.str, err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ParseScoringStrategy(%q) = %q, want %q", tc.str, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package tainttoleration features a scheduler plugin that filters out clusters
+// with NoSchedule taints that are not tolerated by the placement.
+package tainttoleration
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework"
+)
+
+const (
+	// defaultPluginName is the default name of the plugin.
+	defaultPluginName = "TaintToleration"
+
+	// untoleratedTaintReasonTemplate is the reason reported when a cluster is filtered out
+	// for a taint that is not tolerated by the placement.
+	untoleratedTaintReasonTemplate = "cluster has an untolerated taint: %+v"
+)
+
+// Plugin is the scheduler plugin that enforces the taint and toleration check.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// The framework handle.
+	handle framework.Handle
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points
+	// at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * Filter
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.FilterPlugin = &Plugin{}
+)
+
+// pluginOptions is the options for this plugin.
+type pluginOptions struct {
+	// The name of the plugin.
+	name string
+}
+
+// Option helps set up the plugin.
+type Option func(*pluginOptions)
+
+// defaultPluginOptions is the default options for this plugin.
+var defaultPluginOptions = pluginOptions{
+	name: defaultPluginName,
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *pluginOptions) {
+		o.name = name
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name: options.name,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+
+	// This plugin does not need to set up any informer.
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+//
+// Note that the scheduler does not run this extension point for placements of the PickFixed type;
+// also, clusters that have already been picked are filtered out by the same placement affinity
+// plugin before this check, so that newly added taints will not evict existing placements.
+func (p *Plugin) Filter(
+	_ context.Context,
+	_ framework.CycleStatePluginReadWriter,
+	policy *placementv1beta1.ClusterSchedulingPolicySnapshot,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	var tolerations []placementv1beta1.Toleration
+	if policy.Spec.Policy != nil {
+		tolerations = policy.Spec.Policy.Tolerations
+	}
+
+	if taint, untolerated := findUntoleratedTaint(cluster.Spec.Taints, tolerations); untolerated {
+		return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), fmt.Sprintf(untoleratedTaintReasonTemplate, *taint))
+	}
+
+	return nil
+}
+
+// findUntoleratedTaint returns the first NoSchedule taint that is not tolerated by any of the
+// given tolerations, if any.
+func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) (*clusterv1beta1.Taint, bool) {
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule {
+			// Only the NoSchedule effect is enforced by this plugin.
+			continue
+		}
+		if !tolerationsTolerateTaint(tolerations, taint) {
+			return taint, true
+		}
+	}
+	return nil, false
+}
+
+// tolerationsTolerateTaint checks if the taint is tolerated by any of the tolerations.
+func tolerationsTolerateTaint(tolerations []placementv1beta1.Toleration, taint *clusterv1beta1.Taint) bool {
+	for i := range tolerations {
+		if toleratesTaint(&tolerations[i], taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// toleratesTaint checks if the toleration tolerates the taint.
+// The matching follows the same semantics as Kubernetes tolerations on nodes:
+//  1. an empty toleration effect means to match all taint effects;
+//  2. an empty toleration key with the Exists operator means to match all taint keys and values;
+//  3. the Exists operator matches any taint value, and the Equal (or empty) operator requires
+//     the values to be equal.
+func toleratesTaint(toleration *placementv1beta1.Toleration, taint *clusterv1beta1.Taint) bool {
+	if len(toleration.Effect) > 0 && toleration.Effect != taint.Effect {
+		return false
+	}
+
+	if len(toleration.Key) > 0 && toleration.Key != taint.Key {
+		return false
+	}
+
+	switch toleration.Operator {
+	// An empty operator is equivalent to Equal.
+	case "", corev1.TolerationOpEqual:
+		return toleration.Value == taint.Value
+	case corev1.TolerationOpExists:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package tainttoleration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName = "bravelion"
+
+	policyName = "test-policy"
+)
+
+var (
+	ignoredStatusFields = cmpopts.IgnoreFields(framework.Status{}, "reasons", "err")
+)
+
+// TestFilter tests the Filter method.
+func TestFilter(t *testing.T) {
+	maintenanceTaint := clusterv1beta1.Taint{
+		Key:    "maintenance",
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	tenantTaint := clusterv1beta1.Taint{
+		Key:    "tenant",
+		Value:  "team-a",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	testCases := []struct {
+		name   string
+		taints []clusterv1beta1.Taint
+		policy *placementv1beta1.PlacementPolicy
+		want   *framework.Status
+	}{
+		{
+			name: "no taints and no policy",
+		},
+		{
+			name: "no taints with tolerations",
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpExists,
+					},
+				},
+			},
+		},
+		{
+			name:   "taint with no policy",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			want:   framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:   "taint with no tolerations",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+			want: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:   "taint tolerated by the Equal operator",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "true",
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+				},
+			},
+		},
+		{
+			name:   "taint tolerated by the empty operator",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:   "maintenance",
+						Value: "true",
+					},
+				},
+			},
+		},
+		{
+			name:   "taint not tolerated as the value does not match",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "false",
+					},
+				},
+			},
+			want: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:   "taint tolerated by the Exists operator",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpExists,
+					},
+				},
+			},
+		},
+		{
+			name:   "taints tolerated by the Exists operator with an empty key",
+			taints: []clusterv1beta1.Taint{maintenanceTaint, tenantTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Operator: corev1.TolerationOpExists,
+					},
+				},
+			},
+		},
+		{
+			name:   "one of the taints is not tolerated",
+			taints: []clusterv1beta1.Taint{maintenanceTaint, tenantTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "tenant",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "team-a",
+					},
+				},
+			},
+			want: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:   "all of the taints are tolerated",
+			taints: []clusterv1beta1.Taint{maintenanceTaint, tenantTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "tenant",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "team-a",
+					},
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+				},
+			},
+		},
+		{
+			name: "taint with an effect other than NoSchedule is ignored",
+			taints: []clusterv1beta1.Taint{
+				{
+					Key:    "maintenance",
+					Effect: corev1.TaintEffectPreferNoSchedule,
+				},
+			},
+		},
+		{
+			name:   "toleration with a different effect",
+			taints: []clusterv1beta1.Taint{maintenanceTaint},
+			policy: &placementv1beta1.PlacementPolicy{
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "maintenance",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoExecute,
+					},
+				},
+			},
+			want: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+	}
+
+	p := New()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Spec: clusterv1beta1.MemberClusterSpec{
+					Taints: tc.taints,
+				},
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: tc.policy,
+				},
+			}
+			got := p.Filter(context.Background(), framework.NewCycleState(nil, nil), policy, cluster)
+			if diff := cmp.Diff(got, tc.want, cmp.AllowUnexported(framework.Status{}), ignoredStatusFields); diff != "" {
+				t.Errorf("Filter() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,11 @@ type ClusterScore struct {
 	// AffinityScore determines how much a binding would satisfy the affinity terms
 	// specified by the user.
 	AffinityScore int
+	// ResourceAvailabilityScore determines how favorable a cluster is in terms of its allocatable
+	// and available resources (CPU and memory), per the resource scoring strategy in use.
+	//
+	// Note that this score is only compared when all the other scores are the same.
+	ResourceAvailabilityScore int
 	// ObsoletePlacementAffinityScore reflects if there has already been an obsolete binding from
 	// the same cluster resource placement associated with the cluster; it value range should
 	// be [0, 1], where 1 signals that an obsolete binding is present.
@@ -33,6 +38,7 @@ type ClusterScore struct {
 func (s1 *ClusterScore) Add(s2 *ClusterScore) {
 	s1.TopologySpreadScore += s2.TopologySpreadScore
 	s1.AffinityScore += s2.AffinityScore
+	s1.ResourceAvailabilityScore += s2.ResourceAvailabilityScore
 	s1.ObsoletePlacementAffinityScore += s2.ObsoletePlacementAffinityScore
 }
 
@@ -49,6 +55,7 @@ func (s1 *ClusterScore) Equal(s2 *ClusterScore) bool {
 		// Both are not nils.
 		return s1.TopologySpreadScore == s2.TopologySpreadScore &&
 			s1.AffinityScore == s2.AffinityScore &&
+			s1.ResourceAvailabilityScore == s2.ResourceAvailabilityScore &&
 			s1.ObsoletePlacementAffinityScore == s2.ObsoletePlacementAffinityScore
 	}
 }
@@ -65,7 +72,13 @@ func (s1 *ClusterScore) Less(s2 *ClusterScore) bool {
 		return s1.AffinityScore < s2.AffinityScore
 	}
 
-	return s1.ObsoletePlacementAffinityScore < s2.ObsoletePlacementAffinityScore
+	// Prefer the already selected clusters over the ones with more available resources, so that
+	// the changes in the resource usage do not move the placement between the clusters.
+	if s1.ObsoletePlacementAffinityScore != s2.ObsoletePlacementAffinityScore {
+		return s1.ObsoletePlacementAffinityScore < s2.ObsoletePlacementAffinityScore
+	}
+
+	return s1.ResourceAvailabilityScore < s2.ResourceAvailabilityScore
 }
 
 // ScoredCluster is a cluster with a score.
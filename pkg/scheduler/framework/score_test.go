@@ -26,6 +26,7 @@ func TestClusterScoreAdd(t *testing.T) {
 	s2 := &ClusterScore{
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
+		ResourceAvailabilityScore:      80,
 		ObsoletePlacementAffinityScore: 1,
 	}
 
@@ -33,6 +34,7 @@ func TestClusterScoreAdd(t *testing.T) {
 	want := &ClusterScore{
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
+		ResourceAvailabilityScore:      80,
 		ObsoletePlacementAffinityScore: 1,
 	}
 	if diff := cmp.Diff(s1, want); diff != "" {
@@ -137,6 +139,38 @@ func TestClusterScoreLess(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "s1 is less than s2 in resource availability score",
+			s1: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ResourceAvailabilityScore:      40,
+				ObsoletePlacementAffinityScore: 1,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ResourceAvailabilityScore:      60,
+				ObsoletePlacementAffinityScore: 1,
+			},
+			want: true,
+		},
+		{
+			name: "s1 is less than s2 in obsolete placement affinity score with a higher resource availability score",
+			s1: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ResourceAvailabilityScore:      60,
+				ObsoletePlacementAffinityScore: 0,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ResourceAvailabilityScore:      40,
+				ObsoletePlacementAffinityScore: 1,
+			},
+			want: true,
+		},
 		{
 			name: "s1 is less than s2 in active or creating binding score",
 			s1: &ClusterScore{
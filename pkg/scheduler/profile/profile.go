@@ -10,7 +10,9 @@ import (
 	"go.goms.io/fleet/pkg/scheduler/framework"
 	"go.goms.io/fleet/pkg/scheduler/framework/plugins/clusteraffinity"
 	"go.goms.io/fleet/pkg/scheduler/framework/plugins/clustereligibility"
+	"go.goms.io/fleet/pkg/scheduler/framework/plugins/resourceavailability"
 	"go.goms.io/fleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
+	"go.goms.io/fleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"go.goms.io/fleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
 )
 
@@ -19,20 +21,48 @@ const (
 	defaultProfileName = "DefaultProfile"
 )
 
+// profileOptions is the options for the default scheduling profile.
+type profileOptions struct {
+	// resourceScoringStrategy is the strategy the resource availability plugin uses to score clusters.
+	resourceScoringStrategy resourceavailability.ScoringStrategy
+}
+
+// Option helps set up the default scheduling profile.
+type Option func(*profileOptions)
+
+// defaultProfileOptions is the default options for the default scheduling profile.
+var defaultProfileOptions = profileOptions{
+	resourceScoringStrategy: resourceavailability.LeastAllocated,
+}
+
+// WithResourceScoringStrategy sets the strategy the resource availability plugin uses to score clusters.
+func WithResourceScoringStrategy(strategy resourceavailability.ScoringStrategy) Option {
+	return func(o *profileOptions) {
+		o.resourceScoringStrategy = strategy
+	}
+}
+
 // NewDefaultProfile creates a default scheduling profile.
-func NewDefaultProfile() *framework.Profile {
+func NewDefaultProfile(opts ...Option) *framework.Profile {
+	options := defaultProfileOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	p := framework.NewProfile(defaultProfileName)
 
 	// default plugin list
 	clusterAffinityPlugin := clusteraffinity.New()
 	clusterEligibilityPlugin := clustereligibility.New()
+	resourceAvailabilityPlugin := resourceavailability.New(resourceavailability.WithScoringStrategy(options.resourceScoringStrategy))
 	samePlacementAffinityPlugin := sameplacementaffinity.New()
+	taintTolerationPlugin := tainttoleration.New()
 	topologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 
 	p.WithPostBatchPlugin(&topologySpreadConstraintsPlugin).
 		WithPreFilterPlugin(&clusterAffinityPlugin).WithPreFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
+		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&taintTolerationPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
 		WithPreScorePlugin(&clusterAffinityPlugin).WithPreScorePlugin(&topologySpreadConstraintsPlugin).
-		WithScorePlugin(&clusterAffinityPlugin).WithScorePlugin(&samePlacementAffinityPlugin).WithScorePlugin(&topologySpreadConstraintsPlugin)
+		WithScorePlugin(&clusterAffinityPlugin).WithScorePlugin(&resourceAvailabilityPlugin).WithScorePlugin(&samePlacementAffinityPlugin).WithScorePlugin(&topologySpreadConstraintsPlugin)
 	return p
 }
@@ -55,7 +55,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	//
 	//     It may happen for 2 reasons:
 	//
-	//     a) the cluster setting, specifically its labels, has changed; and/or
+	//     a) the cluster setting, specifically its labels, taints or properties, has changed; and/or
 	//     b) an unexpected development which originally leads the scheduler to disregard the cluster
 	//     (e.g., agents not joining, network partition, etc.) has been resolved.
 	//
@@ -63,7 +63,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	//
 	//     Similarly, it may happen for 2 reasons:
 	//
-	//     a) the cluster setting, specifically its labels, has changed; and/or
+	//     a) the cluster setting, specifically its labels, taints or properties, has changed; and/or
 	//     b) an unexpected development (e.g., agents failing, network partition, etc.) has occurred.
 	//     c) the cluster, which may or may not have resources placed on it, has left the fleet (deleting).
 	//
@@ -203,6 +203,16 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 				klog.V(2).InfoS("A member cluster label change has been detected", "memberCluster", clusterKObj)
 				return true
 			}
+			// Capture taint changes; a removed or changed taint may allow more CRPs to select the cluster.
+			if !reflect.DeepEqual(oldCluster.Spec.Taints, newCluster.Spec.Taints) {
+				klog.V(2).InfoS("A member cluster taint change has been detected", "memberCluster", clusterKObj)
+				return true
+			}
+			// Capture property changes; a cluster may match more property selectors with the new values.
+			if isClusterPropertiesChanged(oldCluster, newCluster) {
+				klog.V(2).InfoS("A member cluster property change has been detected", "memberCluster", clusterKObj)
+				return true
+			}
 
 			// Check the resource placement eligibility for the old and new cluster object.
 			oldEligible, _ := r.ClusterEligibilityChecker.IsEligible(oldCluster)
@@ -6,8 +6,13 @@ Licensed under the MIT license.
 package membercluster
 
 import (
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	"go.goms.io/fleet/pkg/utils/condition"
 )
@@ -60,3 +65,53 @@ func classifyCRPs(crps []fleetv1beta1.ClusterResourcePlacement) (toProcess []fle
 
 	return toProcess
 }
+
+// availableResourceChangeThreshold is the fraction of the allocatable resources by which the available resources
+// of a member cluster must change before the change is considered significant.
+const availableResourceChangeThreshold = 0.1
+
+// isClusterPropertiesChanged returns whether the observed properties, including the resource capacities,
+// of a member cluster have changed.
+//
+// Note that the observation times are not compared, as they are refreshed on every heartbeat
+// even if the values stay the same. The available resources change with every pod created or
+// deleted on the member cluster, so only the significant changes are considered.
+func isClusterPropertiesChanged(oldCluster, newCluster *clusterv1beta1.MemberCluster) bool {
+	oldUsage, newUsage := oldCluster.Status.ResourceUsage, newCluster.Status.ResourceUsage
+	if !equality.Semantic.DeepEqual(oldUsage.Capacity, newUsage.Capacity) ||
+		!equality.Semantic.DeepEqual(oldUsage.Allocatable, newUsage.Allocatable) ||
+		isAvailableResourcesChanged(oldUsage.Available, newUsage.Available, newUsage.Allocatable) {
+		return true
+	}
+
+	if len(oldCluster.Status.Properties) != len(newCluster.Status.Properties) {
+		return true
+	}
+	for name, oldValue := range oldCluster.Status.Properties {
+		newValue, found := newCluster.Status.Properties[name]
+		if !found || newValue.Value != oldValue.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// isAvailableResourcesChanged returns whether any of the available resources has been added, removed, or changed by
+// more than availableResourceChangeThreshold of the allocatable amount of the resource.
+func isAvailableResourcesChanged(oldAvailable, newAvailable, allocatable corev1.ResourceList) bool {
+	if len(oldAvailable) != len(newAvailable) {
+		return true
+	}
+	for name, oldQuantity := range oldAvailable {
+		newQuantity, found := newAvailable[name]
+		if !found {
+			return true
+		}
+		diff := math.Abs(newQuantity.AsApproximateFloat64() - oldQuantity.AsApproximateFloat64())
+		allocatableQuantity := allocatable[name]
+		if diff > allocatableQuantity.AsApproximateFloat64()*availableResourceChangeThreshold {
+			return true
+		}
+	}
+	return false
+}
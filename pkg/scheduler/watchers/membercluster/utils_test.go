@@ -7,10 +7,14 @@ package membercluster
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 )
 
@@ -408,3 +412,110 @@ func TestClassifyCRPs(t *testing.T) {
 		})
 	}
 }
+
+// TestIsClusterPropertiesChanged tests the isClusterPropertiesChanged function.
+func TestIsClusterPropertiesChanged(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	clusterWith := func(cpu string, nodeCount string, observationTime metav1.Time) *clusterv1beta1.MemberCluster {
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName1,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse(cpu),
+					},
+					ObservationTime: observationTime,
+				},
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					clusterv1beta1.NodeCountProperty: {
+						Value:           nodeCount,
+						ObservationTime: observationTime,
+					},
+				},
+			},
+		}
+	}
+
+	clusterWithAvailable := func(allocatableCPU, availableCPU string) *clusterv1beta1.MemberCluster {
+		cluster := clusterWith(allocatableCPU, "3", now)
+		cluster.Status.ResourceUsage.Available = corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse(availableCPU),
+		}
+		return cluster
+	}
+
+	testCases := []struct {
+		name       string
+		oldCluster *clusterv1beta1.MemberCluster
+		newCluster *clusterv1beta1.MemberCluster
+		want       bool
+	}{
+		{
+			name:       "no change",
+			oldCluster: clusterWith("4", "3", now),
+			newCluster: clusterWith("4", "3", now),
+			want:       false,
+		},
+		{
+			name:       "only observation times changed",
+			oldCluster: clusterWith("4", "3", now),
+			newCluster: clusterWith("4000m", "3", later),
+			want:       false,
+		},
+		{
+			name:       "resource usage changed",
+			oldCluster: clusterWith("4", "3", now),
+			newCluster: clusterWith("8", "3", later),
+			want:       true,
+		},
+		{
+			name:       "property value changed",
+			oldCluster: clusterWith("4", "3", now),
+			newCluster: clusterWith("4", "4", later),
+			want:       true,
+		},
+		{
+			name:       "available resources changed slightly",
+			oldCluster: clusterWithAvailable("4", "2"),
+			newCluster: clusterWithAvailable("4", "1900m"),
+			want:       false,
+		},
+		{
+			name:       "available resources changed significantly",
+			oldCluster: clusterWithAvailable("4", "2"),
+			newCluster: clusterWithAvailable("4", "1"),
+			want:       true,
+		},
+		{
+			name:       "available resources reported for the first time",
+			oldCluster: clusterWith("4", "3", now),
+			newCluster: clusterWithAvailable("4", "2"),
+			want:       true,
+		},
+		{
+			name:       "property added",
+			oldCluster: &clusterv1beta1.MemberCluster{},
+			newCluster: &clusterv1beta1.MemberCluster{
+				Status: clusterv1beta1.MemberClusterStatus{
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						clusterv1beta1.KubernetesVersionProperty: {
+							Value: "1.28.3",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isClusterPropertiesChanged(tc.oldCluster, tc.newCluster); got != tc.want {
+				t.Errorf("isClusterPropertiesChanged() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -53,6 +53,9 @@ type ResourceConfig struct {
 	groupVersions map[schema.GroupVersion]struct{}
 	// groupVersionKinds holds a collection of resource that should be considered.
 	groupVersionKinds map[schema.GroupVersionKind]struct{}
+	// exemptGroupVersionKinds holds a collection of resource that should not be considered even if their group or
+	// group version is configured.
+	exemptGroupVersionKinds map[schema.GroupVersionKind]struct{}
 	// isAllowList indicates whether the ResourceConfig is an allow list or not.
 	isAllowList bool
 }
@@ -61,9 +64,10 @@ type ResourceConfig struct {
 // If the resourceConfig is not an allowlist, it creates a default skipped propagating APIs list.
 func NewResourceConfig(isAllowList bool) *ResourceConfig {
 	r := &ResourceConfig{
-		groups:            map[string]struct{}{},
-		groupVersions:     map[schema.GroupVersion]struct{}{},
-		groupVersionKinds: map[schema.GroupVersionKind]struct{}{},
+		groups:                  map[string]struct{}{},
+		groupVersions:           map[schema.GroupVersion]struct{}{},
+		groupVersionKinds:       map[schema.GroupVersionKind]struct{}{},
+		exemptGroupVersionKinds: map[schema.GroupVersionKind]struct{}{},
 	}
 	r.isAllowList = isAllowList
 	if r.isAllowList {
@@ -74,6 +78,9 @@ func NewResourceConfig(isAllowList bool) *ResourceConfig {
 	r.AddGroup(placementv1beta1.GroupVersion.Group)
 	r.AddGroup(clusterv1beta1.GroupVersion.Group)
 	r.AddGroupVersionKind(WorkGVK)
+	// the envelope objects are placed as the other user resources, and the wrapped resources are applied instead
+	r.exemptGroupVersionKinds[ClusterResourceEnvelopeGVK] = struct{}{}
+	r.exemptGroupVersionKinds[ResourceEnvelopeGVK] = struct{}{}
 
 	// disable the below built-in resources
 	r.AddGroup(eventsv1.GroupName)
@@ -181,17 +188,21 @@ func (r *ResourceConfig) IsResourceDisabled(gvk schema.GroupVersionKind) bool {
 }
 
 // isResourceConfigured returns whether a given GroupVersionKind is found in the ResourceConfig.
-// A gvk is configured if its group or group version is configured.
+// A gvk is configured if its group or group version is configured, unless it's exempted.
 func (r *ResourceConfig) isResourceConfigured(gvk schema.GroupVersionKind) bool {
-	if _, ok := r.groups[gvk.Group]; ok {
+	if _, ok := r.groupVersionKinds[gvk]; ok {
 		return true
 	}
 
-	if _, ok := r.groupVersions[gvk.GroupVersion()]; ok {
+	if _, ok := r.exemptGroupVersionKinds[gvk]; ok {
+		return false
+	}
+
+	if _, ok := r.groups[gvk.Group]; ok {
 		return true
 	}
 
-	if _, ok := r.groupVersionKinds[gvk]; ok {
+	if _, ok := r.groupVersions[gvk.GroupVersion()]; ok {
 		return true
 	}
 
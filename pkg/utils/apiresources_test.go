@@ -288,6 +288,11 @@ func TestDefaultResourceConfigGroupVersionKindParse(t *testing.T) {
 			Version: "v1beta1",
 			Kind:    "Event",
 		},
+		{
+			Group:   "placement.kubernetes-fleet.io",
+			Version: "v1beta1",
+			Kind:    "ClusterResourcePlacement",
+		},
 	}
 
 	resourcesNotInDefaultResourcesList := []schema.GroupVersionKind{
@@ -306,6 +311,8 @@ func TestDefaultResourceConfigGroupVersionKindParse(t *testing.T) {
 			Version: "v1",
 			Kind:    "Event",
 		},
+		ClusterResourceEnvelopeGVK,
+		ResourceEnvelopeGVK,
 	}
 
 	tests := map[string]struct {
@@ -333,6 +340,12 @@ func TestDefaultResourceConfigGroupVersionKindParse(t *testing.T) {
 	}
 }
 
+func TestSkippedEnvelopeResourceConfig(t *testing.T) {
+	r := newTestResourceConfig(t, false, "placement.kubernetes-fleet.io/v1beta1/ResourceEnvelope")
+	checkIfResourcesAreDisabledInConfig(t, r, []schema.GroupVersionKind{ResourceEnvelopeGVK})
+	checkIfResourcesAreEnabledInConfig(t, r, []schema.GroupVersionKind{ClusterResourceEnvelopeGVK})
+}
+
 // newTestResourceConfig creates a new ResourceConfig for either allow or disable list
 // for testing with resources parsed from the input string. If the input string is not
 // valid, it will fail the test.
@@ -16,6 +16,7 @@ import (
 	discoveryv1 "k8s.io/api/discovery/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -166,8 +167,58 @@ var (
 		Version: corev1.SchemeGroupVersion.Version,
 		Kind:    "ConfigMap",
 	}
+
+	SecretGVK = schema.GroupVersionKind{
+		Group:   corev1.GroupName,
+		Version: corev1.SchemeGroupVersion.Version,
+		Kind:    "Secret",
+	}
+
+	ClusterResourceEnvelopeGVK = schema.GroupVersionKind{
+		Group:   placementv1beta1.GroupVersion.Group,
+		Version: placementv1beta1.GroupVersion.Version,
+		Kind:    placementv1beta1.ClusterResourceEnvelopeKind,
+	}
+
+	ResourceEnvelopeGVK = schema.GroupVersionKind{
+		Group:   placementv1beta1.GroupVersion.Group,
+		Version: placementv1beta1.GroupVersion.Version,
+		Kind:    placementv1beta1.ResourceEnvelopeKind,
+	}
 )
 
+// GetEnvelopeType returns the envelope type of the resource and whether the resource is an envelope object, which
+// is either a configMap or a secret with the envelope annotation, or a ClusterResourceEnvelope or a ResourceEnvelope.
+func GetEnvelopeType(uResource *unstructured.Unstructured) (placementv1beta1.EnvelopeType, bool) {
+	switch uResource.GroupVersionKind() {
+	case ConfigMapGVK:
+		return placementv1beta1.ConfigMapEnvelopeType, len(uResource.GetAnnotations()[placementv1beta1.EnvelopeConfigMapAnnotation]) != 0
+	case SecretGVK:
+		return placementv1beta1.SecretEnvelopeType, len(uResource.GetAnnotations()[placementv1beta1.EnvelopeSecretAnnotation]) != 0
+	case ClusterResourceEnvelopeGVK:
+		return placementv1beta1.ClusterResourceEnvelopeType, true
+	case ResourceEnvelopeGVK:
+		return placementv1beta1.ResourceEnvelopeType, true
+	default:
+		return "", false
+	}
+}
+
+// GetResourcePlacementOwner returns the owner reference of the object if it's controlled by a ResourcePlacement, e.g.,
+// the clusterResourcePlacement backing a resourcePlacement. The owner reference does not carry the namespace of the
+// resourcePlacement, which is recorded by the ResourcePlacementNamespaceLabel instead.
+func GetResourcePlacementOwner(obj metav1.Object) (*metav1.OwnerReference, bool) {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != placementv1beta1.ResourcePlacementKind {
+		return nil, false
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil || gv.Group != placementv1beta1.GroupVersion.Group {
+		return nil, false
+	}
+	return owner, true
+}
+
 // RandSecureInt returns a uniform random value in [1, max] or panic.
 // Only use this in tests.
 func RandSecureInt(limit int64) int64 {
@@ -0,0 +1,161 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestGetEnvelopeType(t *testing.T) {
+	tests := map[string]struct {
+		apiVersion     string
+		kind           string
+		annotations    map[string]string
+		wantType       placementv1beta1.EnvelopeType
+		wantIsEnvelope bool
+	}{
+		"envelope configMap": {
+			apiVersion:     "v1",
+			kind:           "ConfigMap",
+			annotations:    map[string]string{placementv1beta1.EnvelopeConfigMapAnnotation: "true"},
+			wantType:       placementv1beta1.ConfigMapEnvelopeType,
+			wantIsEnvelope: true,
+		},
+		"configMap without the envelope annotation": {
+			apiVersion:     "v1",
+			kind:           "ConfigMap",
+			wantType:       placementv1beta1.ConfigMapEnvelopeType,
+			wantIsEnvelope: false,
+		},
+		"envelope secret": {
+			apiVersion:     "v1",
+			kind:           "Secret",
+			annotations:    map[string]string{placementv1beta1.EnvelopeSecretAnnotation: "true"},
+			wantType:       placementv1beta1.SecretEnvelopeType,
+			wantIsEnvelope: true,
+		},
+		"secret with the configMap envelope annotation": {
+			apiVersion:     "v1",
+			kind:           "Secret",
+			annotations:    map[string]string{placementv1beta1.EnvelopeConfigMapAnnotation: "true"},
+			wantType:       placementv1beta1.SecretEnvelopeType,
+			wantIsEnvelope: false,
+		},
+		"clusterResourceEnvelope": {
+			apiVersion:     placementv1beta1.GroupVersion.String(),
+			kind:           placementv1beta1.ClusterResourceEnvelopeKind,
+			wantType:       placementv1beta1.ClusterResourceEnvelopeType,
+			wantIsEnvelope: true,
+		},
+		"resourceEnvelope": {
+			apiVersion:     placementv1beta1.GroupVersion.String(),
+			kind:           placementv1beta1.ResourceEnvelopeKind,
+			wantType:       placementv1beta1.ResourceEnvelopeType,
+			wantIsEnvelope: true,
+		},
+		"other resource": {
+			apiVersion:     "apps/v1",
+			kind:           "Deployment",
+			annotations:    map[string]string{placementv1beta1.EnvelopeConfigMapAnnotation: "true"},
+			wantIsEnvelope: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion(tt.apiVersion)
+			obj.SetKind(tt.kind)
+			obj.SetAnnotations(tt.annotations)
+			gotType, gotIsEnvelope := GetEnvelopeType(obj)
+			if gotIsEnvelope != tt.wantIsEnvelope {
+				t.Errorf("GetEnvelopeType() isEnvelope = %v, want %v", gotIsEnvelope, tt.wantIsEnvelope)
+			}
+			if gotType != tt.wantType {
+				t.Errorf("GetEnvelopeType() type = %v, want %v", gotType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestGetResourcePlacementOwner(t *testing.T) {
+	rpOwner := metav1.OwnerReference{
+		APIVersion: placementv1beta1.GroupVersion.String(),
+		Kind:       placementv1beta1.ResourcePlacementKind,
+		Name:       "web",
+		UID:        "rp-uid",
+		Controller: pointer.Bool(true),
+	}
+	tests := map[string]struct {
+		owners    []metav1.OwnerReference
+		wantOwner *metav1.OwnerReference
+		wantOK    bool
+	}{
+		"controlled by a resourcePlacement": {
+			owners:    []metav1.OwnerReference{rpOwner},
+			wantOwner: &rpOwner,
+			wantOK:    true,
+		},
+		"no owner": {
+			wantOK: false,
+		},
+		"resourcePlacement owner which is not the controller": {
+			owners: []metav1.OwnerReference{
+				{
+					APIVersion: placementv1beta1.GroupVersion.String(),
+					Kind:       placementv1beta1.ResourcePlacementKind,
+					Name:       "web",
+					UID:        "rp-uid",
+				},
+			},
+			wantOK: false,
+		},
+		"controlled by a resourcePlacement kind of another group": {
+			owners: []metav1.OwnerReference{
+				{
+					APIVersion: "example.com/v1",
+					Kind:       placementv1beta1.ResourcePlacementKind,
+					Name:       "web",
+					UID:        "rp-uid",
+					Controller: pointer.Bool(true),
+				},
+			},
+			wantOK: false,
+		},
+		"controlled by another kind": {
+			owners: []metav1.OwnerReference{
+				{
+					APIVersion: placementv1beta1.GroupVersion.String(),
+					Kind:       placementv1beta1.ClusterResourcePlacementKind,
+					Name:       "web",
+					UID:        "crp-uid",
+					Controller: pointer.Bool(true),
+				},
+			},
+			wantOK: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			obj := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "crp", OwnerReferences: tt.owners},
+			}
+			gotOwner, gotOK := GetResourcePlacementOwner(obj)
+			if gotOK != tt.wantOK {
+				t.Fatalf("GetResourcePlacementOwner() ok = %v, want %v", gotOK, tt.wantOK)
+			}
+			if diff := cmp.Diff(tt.wantOwner, gotOwner); diff != "" {
+				t.Errorf("GetResourcePlacementOwner() owner mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+// ExtractResFromEnvelope extracts the wrapped resources from an envelope object of the given type.
+func ExtractResFromEnvelope(uEnvelope *unstructured.Unstructured, envelopeType placementv1beta1.EnvelopeType) ([]placementv1beta1.Manifest, error) {
+	switch envelopeType {
+	case placementv1beta1.ConfigMapEnvelopeType:
+		return extractResFromConfigMap(uEnvelope)
+	case placementv1beta1.SecretEnvelopeType:
+		return extractResFromSecret(uEnvelope)
+	case placementv1beta1.ClusterResourceEnvelopeType:
+		var envelope placementv1beta1.ClusterResourceEnvelope
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uEnvelope.Object, &envelope); err != nil {
+			return nil, err
+		}
+		return extractResFromEnvelopeData(envelope.Data), nil
+	case placementv1beta1.ResourceEnvelopeType:
+		var envelope placementv1beta1.ResourceEnvelope
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uEnvelope.Object, &envelope); err != nil {
+			return nil, err
+		}
+		return extractResFromEnvelopeData(envelope.Data), nil
+	default:
+		return nil, fmt.Errorf("unsupported envelope type %s", envelopeType)
+	}
+}
+
+func extractResFromConfigMap(uConfigMap *unstructured.Unstructured) ([]placementv1beta1.Manifest, error) {
+	var configMap corev1.ConfigMap
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(uConfigMap.Object, &configMap)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(configMap.Data))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	return extractResFromYAMLData(data)
+}
+
+// extractResFromSecret converts the values of the data of an envelope secret to manifests.
+// The decoded values are placed in the work as they are, i.e., the envelope secret offers no confidentiality for the
+// wrapped resources beyond the access control of the works.
+func extractResFromSecret(uSecret *unstructured.Unstructured) ([]placementv1beta1.Manifest, error) {
+	var secret corev1.Secret
+	// the data of the secret is base64 decoded by the converter
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(uSecret.Object, &secret)
+	if err != nil {
+		return nil, err
+	}
+	return extractResFromYAMLData(secret.Data)
+}
+
+// extractResFromYAMLData converts the YAML or JSON formatted values of the data of a configMap or a secret to manifests.
+func extractResFromYAMLData(data map[string][]byte) ([]placementv1beta1.Manifest, error) {
+	manifests := make([]placementv1beta1.Manifest, 0, len(data))
+	// the list order is not stable as the map traverse is random
+	for _, value := range data {
+		content, jsonErr := yaml.ToJSON(value)
+		if jsonErr != nil {
+			return nil, jsonErr
+		}
+		manifests = append(manifests, placementv1beta1.Manifest{
+			RawExtension: runtime.RawExtension{Raw: content},
+		})
+	}
+	sortManifests(manifests)
+	return manifests, nil
+}
+
+// extractResFromEnvelopeData collects the manifests held by a ClusterResourceEnvelope or a ResourceEnvelope.
+func extractResFromEnvelopeData(data map[string]placementv1beta1.Manifest) []placementv1beta1.Manifest {
+	manifests := make([]placementv1beta1.Manifest, 0, len(data))
+	for _, manifest := range data {
+		manifests = append(manifests, manifest)
+	}
+	sortManifests(manifests)
+	return manifests
+}
+
+// sortManifests stable sorts the manifests so that we can have a deterministic order.
+func sortManifests(manifests []placementv1beta1.Manifest) {
+	sort.Slice(manifests, func(i, j int) bool {
+		obj1 := manifests[i].Raw
+		obj2 := manifests[j].Raw
+		// order by its json formatted string
+		return strings.Compare(string(obj1), string(obj2)) > 0
+	})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package utils
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestExtractResFromEnvelope(t *testing.T) {
+	configMapManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"app"}}`
+	roleManifest := `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"Role","metadata":{"name":"role","namespace":"app"}}`
+	configMapYAML := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: app
+`
+	tests := map[string]struct {
+		envelope     map[string]interface{}
+		envelopeType placementv1beta1.EnvelopeType
+		want         []placementv1beta1.Manifest
+		wantErr      bool
+	}{
+		"configMap envelope": {
+			envelope: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "envelope", "namespace": "app"},
+				"data": map[string]interface{}{
+					"configmap.yaml": configMapYAML,
+					"role.json":      roleManifest,
+				},
+			},
+			envelopeType: placementv1beta1.ConfigMapEnvelopeType,
+			want: []placementv1beta1.Manifest{
+				{RawExtension: runtime.RawExtension{Raw: []byte(configMapManifest)}},
+				{RawExtension: runtime.RawExtension{Raw: []byte(roleManifest)}},
+			},
+		},
+		"secret envelope": {
+			envelope: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "envelope", "namespace": "app"},
+				"data": map[string]interface{}{
+					// base64 encoded configMapYAML and roleManifest
+					"configmap.yaml": base64.StdEncoding.EncodeToString([]byte(configMapYAML)),
+					"role.json":      base64.StdEncoding.EncodeToString([]byte(roleManifest)),
+				},
+			},
+			envelopeType: placementv1beta1.SecretEnvelopeType,
+			want: []placementv1beta1.Manifest{
+				{RawExtension: runtime.RawExtension{Raw: []byte(configMapManifest)}},
+				{RawExtension: runtime.RawExtension{Raw: []byte(roleManifest)}},
+			},
+		},
+		"secret envelope with invalid content": {
+			envelope: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "envelope", "namespace": "app"},
+				"data": map[string]interface{}{
+					"invalid.yaml": base64.StdEncoding.EncodeToString([]byte("key: [")),
+				},
+			},
+			envelopeType: placementv1beta1.SecretEnvelopeType,
+			wantErr:      true,
+		},
+		"clusterResourceEnvelope": {
+			envelope: map[string]interface{}{
+				"apiVersion": "placement.kubernetes-fleet.io/v1beta1",
+				"kind":       "ClusterResourceEnvelope",
+				"metadata":   map[string]interface{}{"name": "envelope"},
+				"data": map[string]interface{}{
+					"configmap": map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "config", "namespace": "app"},
+					},
+					"role": map[string]interface{}{
+						"apiVersion": "rbac.authorization.k8s.io/v1",
+						"kind":       "Role",
+						"metadata":   map[string]interface{}{"name": "role", "namespace": "app"},
+					},
+				},
+			},
+			envelopeType: placementv1beta1.ClusterResourceEnvelopeType,
+			want: []placementv1beta1.Manifest{
+				{RawExtension: runtime.RawExtension{Raw: []byte(configMapManifest)}},
+				{RawExtension: runtime.RawExtension{Raw: []byte(roleManifest)}},
+			},
+		},
+		"resourceEnvelope": {
+			envelope: map[string]interface{}{
+				"apiVersion": "placement.kubernetes-fleet.io/v1beta1",
+				"kind":       "ResourceEnvelope",
+				"metadata":   map[string]interface{}{"name": "envelope", "namespace": "app"},
+				"data": map[string]interface{}{
+					"configmap": map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "config", "namespace": "app"},
+					},
+				},
+			},
+			envelopeType: placementv1beta1.ResourceEnvelopeType,
+			want: []placementv1beta1.Manifest{
+				{RawExtension: runtime.RawExtension{Raw: []byte(configMapManifest)}},
+			},
+		},
+		"unsupported envelope type": {
+			envelope: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]interface{}{"name": "envelope", "namespace": "app"},
+			},
+			envelopeType: placementv1beta1.EnvelopeType("Pod"),
+			wantErr:      true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ExtractResFromEnvelope(&unstructured.Unstructured{Object: tt.envelope}, tt.envelopeType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractResFromEnvelope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			// compare the manifests as strings so that the diff is readable
+			toStrings := func(manifests []placementv1beta1.Manifest) []string {
+				res := make([]string, len(manifests))
+				for i := range manifests {
+					res[i] = string(manifests[i].Raw)
+				}
+				return res
+			}
+			if diff := cmp.Diff(toStrings(tt.want), toStrings(got)); diff != "" {
+				t.Errorf("ExtractResFromEnvelope() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package overrider provides utils to find the overrides that apply to the placed resources.
+package overrider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+	"go.goms.io/fleet/pkg/utils/controller"
+)
+
+// FetchAllMatchingOverridesForResourceSnapshot fetches all the latest override snapshots which select any of the resources
+// in the resource snapshot index group that the master resource snapshot belongs to.
+func FetchAllMatchingOverridesForResourceSnapshot(ctx context.Context, c client.Reader, crp string, masterResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot) ([]*fleetv1beta1.ClusterResourceOverrideSnapshot, []*fleetv1beta1.ResourceOverrideSnapshot, error) {
+	latestSnapshotLabelMatcher := client.MatchingLabels{
+		fleetv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(true),
+	}
+	croList := &fleetv1beta1.ClusterResourceOverrideSnapshotList{}
+	if err := c.List(ctx, croList, latestSnapshotLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list all the clusterResourceOverrideSnapshots")
+		return nil, nil, controller.NewAPIServerError(true, err)
+	}
+	roList := &fleetv1beta1.ResourceOverrideSnapshotList{}
+	if err := c.List(ctx, roList, latestSnapshotLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list all the resourceOverrideSnapshots")
+		return nil, nil, controller.NewAPIServerError(true, err)
+	}
+	if len(croList.Items) == 0 && len(roList.Items) == 0 {
+		return nil, nil, nil // no overrides and nothing to do
+	}
+
+	resourceSnapshots, err := fetchAllResourceSnapshotsInGroup(ctx, c, crp, masterResourceSnapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources := make([]*unstructured.Unstructured, 0)
+	namespaceLabels := make(map[string]map[string]string)
+	for _, snapshot := range resourceSnapshots {
+		for _, res := range snapshot.Spec.SelectedResources {
+			var uResource unstructured.Unstructured
+			if err := uResource.UnmarshalJSON(res.Raw); err != nil {
+				klog.ErrorS(err, "Resource has invalid content", "snapshot", klog.KObj(snapshot), "selectedResource", res.Raw)
+				return nil, nil, controller.NewUnexpectedBehaviorError(err)
+			}
+			if uResource.GroupVersionKind() == utils.NamespaceGVK {
+				namespaceLabels[uResource.GetName()] = uResource.GetLabels()
+			}
+			envelopeType, isEnvelope := utils.GetEnvelopeType(&uResource)
+			if !isEnvelope {
+				resources = append(resources, &uResource)
+				continue
+			}
+			// the overrides are applied to the resources wrapped in the envelope instead of the envelope itself
+			wrappedResources, err := decodeEnvelopedResources(&uResource, envelopeType)
+			if err != nil {
+				// the work generator reports the envelope with invalid content to the user
+				klog.ErrorS(err, "Envelope object has invalid content", "snapshot", klog.KObj(snapshot), "envelope", klog.KObj(&uResource))
+				continue
+			}
+			resources = append(resources, wrappedResources...)
+		}
+	}
+
+	filteredCRO := make([]*fleetv1beta1.ClusterResourceOverrideSnapshot, 0, len(croList.Items))
+	for i := range croList.Items {
+		for _, res := range resources {
+			if IsClusterResourceSelected(res, croList.Items[i].Spec.OverrideSpec.ClusterResourceSelectors, namespaceLabels) {
+				filteredCRO = append(filteredCRO, &croList.Items[i])
+				break
+			}
+		}
+	}
+	filteredRO := make([]*fleetv1beta1.ResourceOverrideSnapshot, 0, len(roList.Items))
+	for i := range roList.Items {
+		for _, res := range resources {
+			if IsResourceSelected(res, roList.Items[i].Namespace, roList.Items[i].Spec.OverrideSpec.ResourceSelectors) {
+				filteredRO = append(filteredRO, &roList.Items[i])
+				break
+			}
+		}
+	}
+	klog.V(2).InfoS("Found the overrides matching the resource snapshot", "clusterResourcePlacement", crp, "resourceSnapshot", klog.KObj(masterResourceSnapshot),
+		"numberOfClusterResourceOverrides", len(filteredCRO), "numberOfResourceOverrides", len(filteredRO))
+	return filteredCRO, filteredRO, nil
+}
+
+// decodeEnvelopedResources decodes the resources wrapped in an envelope object of the given type.
+func decodeEnvelopedResources(envelope *unstructured.Unstructured, envelopeType fleetv1beta1.EnvelopeType) ([]*unstructured.Unstructured, error) {
+	manifests, err := utils.ExtractResFromEnvelope(envelope, envelopeType)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*unstructured.Unstructured, 0, len(manifests))
+	for _, manifest := range manifests {
+		var uResource unstructured.Unstructured
+		if err := uResource.UnmarshalJSON(manifest.Raw); err != nil {
+			return nil, err
+		}
+		resources = append(resources, &uResource)
+	}
+	return resources, nil
+}
+
+// fetchAllResourceSnapshotsInGroup returns all the resource snapshots in the same index group as the master resource snapshot.
+func fetchAllResourceSnapshotsInGroup(ctx context.Context, c client.Reader, crp string, masterResourceSnapshot *fleetv1beta1.ClusterResourceSnapshot) ([]*fleetv1beta1.ClusterResourceSnapshot, error) {
+	countAnnotation := masterResourceSnapshot.Annotations[fleetv1beta1.NumberOfResourceSnapshotsAnnotation]
+	snapshotCount, err := strconv.Atoi(countAnnotation)
+	if err != nil || snapshotCount < 1 {
+		return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf(
+			"master resource snapshot %s has an invalid snapshot count %d or err %w", masterResourceSnapshot.Name, snapshotCount, err))
+	}
+	if snapshotCount == 1 {
+		return []*fleetv1beta1.ClusterResourceSnapshot{masterResourceSnapshot}, nil
+	}
+	resourceIndexLabelMatcher := client.MatchingLabels{
+		fleetv1beta1.ResourceIndexLabel: masterResourceSnapshot.Labels[fleetv1beta1.ResourceIndexLabel],
+		fleetv1beta1.CRPTrackingLabel:   crp,
+	}
+	resourceSnapshotList := &fleetv1beta1.ClusterResourceSnapshotList{}
+	if err := c.List(ctx, resourceSnapshotList, resourceIndexLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list all the resource snapshots in the index group", "resourceSnapshot", klog.KObj(masterResourceSnapshot))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	if len(resourceSnapshotList.Items) != snapshotCount {
+		err := fmt.Errorf("resource snapshots are still being created for the masterResourceSnapshot %s, total snapshot in the index group = %d, num Of existing snapshot in the group= %d",
+			masterResourceSnapshot.Name, snapshotCount, len(resourceSnapshotList.Items))
+		klog.ErrorS(err, "Resource snapshots are not ready", "resourceSnapshot", klog.KObj(masterResourceSnapshot))
+		return nil, controller.NewExpectedBehaviorError(err)
+	}
+	snapshots := make([]*fleetv1beta1.ClusterResourceSnapshot, 0, snapshotCount)
+	for i := range resourceSnapshotList.Items {
+		snapshots = append(snapshots, &resourceSnapshotList.Items[i])
+	}
+	return snapshots, nil
+}
+
+// PickFromResourceMatchedOverridesForTargetCluster filters the overrides which have at least one rule selecting the
+// target cluster, and returns their names in a deterministic order.
+// The overrides are expected to be the ones selecting the resources already.
+func PickFromResourceMatchedOverridesForTargetCluster(ctx context.Context, c client.Reader, targetCluster string,
+	croList []*fleetv1beta1.ClusterResourceOverrideSnapshot, roList []*fleetv1beta1.ResourceOverrideSnapshot) ([]string, []fleetv1beta1.NamespacedName, error) {
+	if len(croList) == 0 && len(roList) == 0 {
+		return nil, nil, nil
+	}
+	clusterLabels, err := FetchClusterLabels(ctx, c, targetCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	croNames := make([]string, 0, len(croList))
+	for _, cro := range croList {
+		selected, err := IsClusterSelectedByPolicy(clusterLabels, cro.Spec.OverrideSpec.Policy)
+		if err != nil {
+			klog.ErrorS(err, "Found an invalid clusterResourceOverrideSnapshot", "clusterResourceOverrideSnapshot", klog.KObj(cro))
+			return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		if selected {
+			croNames = append(croNames, cro.Name)
+		}
+	}
+	roNames := make([]fleetv1beta1.NamespacedName, 0, len(roList))
+	for _, ro := range roList {
+		selected, err := IsClusterSelectedByPolicy(clusterLabels, ro.Spec.OverrideSpec.Policy)
+		if err != nil {
+			klog.ErrorS(err, "Found an invalid resourceOverrideSnapshot", "resourceOverrideSnapshot", klog.KObj(ro))
+			return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		if selected {
+			roNames = append(roNames, fleetv1beta1.NamespacedName{Namespace: ro.Namespace, Name: ro.Name})
+		}
+	}
+	sort.Strings(croNames)
+	sort.Slice(roNames, func(i, j int) bool {
+		if roNames[i].Namespace == roNames[j].Namespace {
+			return roNames[i].Name < roNames[j].Name
+		}
+		return roNames[i].Namespace < roNames[j].Namespace
+	})
+	klog.V(2).InfoS("Found the overrides selecting the target cluster", "cluster", targetCluster, "clusterResourceOverrides", croNames, "resourceOverrides", roNames)
+	return croNames, roNames, nil
+}
+
+// FetchClusterLabels returns the labels of the target member cluster.
+// A cluster which is not found is treated as a cluster without any label, so that it is only selected by the rules
+// which select all the clusters.
+func FetchClusterLabels(ctx context.Context, c client.Reader, targetCluster string) (map[string]string, error) {
+	var cluster clusterv1beta1.MemberCluster
+	if err := c.Get(ctx, client.ObjectKey{Name: targetCluster}, &cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("The target cluster is not found", "memberCluster", targetCluster)
+			return nil, nil
+		}
+		klog.ErrorS(err, "Failed to get the target cluster", "memberCluster", targetCluster)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return cluster.Labels, nil
+}
+
+// IsClusterSelectedByPolicy checks if any of the override rules in the policy selects the cluster.
+func IsClusterSelectedByPolicy(clusterLabels map[string]string, policy *fleetv1beta1.OverridePolicy) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+	for _, rule := range policy.OverrideRules {
+		selected, err := IsClusterSelectedByRule(clusterLabels, rule.ClusterSelector)
+		if err != nil {
+			return false, err
+		}
+		if selected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsClusterSelectedByRule checks if the cluster with the given labels is selected by the cluster selector of an override rule.
+// The cluster selector terms are ORed, and a nil cluster selector selects all the clusters.
+func IsClusterSelectedByRule(clusterLabels map[string]string, clusterSelector *fleetv1beta1.ClusterSelector) (bool, error) {
+	if clusterSelector == nil || len(clusterSelector.ClusterSelectorTerms) == 0 {
+		return true, nil
+	}
+	for _, term := range clusterSelector.ClusterSelectorTerms {
+		s, err := metav1.LabelSelectorAsSelector(&term.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid cluster label selector %+v: %w", term.LabelSelector, err)
+		}
+		if s.Matches(labels.Set(clusterLabels)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsClusterResourceSelected checks if a resource is selected by any of the cluster resource selectors.
+// A cluster scoped resource is selected by the selectors of its own kind, while a namespace scoped resource is only
+// selected by the namespaced resource selectors of a selector which selects its namespace.
+// The namespaceLabels are the labels of the selected namespaces keyed by their names, which are matched against the
+// label selectors of the namespace selectors.
+func IsClusterResourceSelected(resource *unstructured.Unstructured, selectors []fleetv1beta1.ClusterResourceSelector,
+	namespaceLabels map[string]map[string]string) bool {
+	namespace := resource.GetNamespace()
+	gvk := resource.GroupVersionKind()
+	for _, selector := range selectors {
+		if namespace == "" {
+			if isObjectSelected(selector.Group, selector.Version, selector.Kind, selector.Name, selector.LabelSelector,
+				gvk, resource.GetName(), resource.GetLabels()) {
+				return true
+			}
+			continue
+		}
+		if len(selector.NamespacedResourceSelectors) == 0 ||
+			!isObjectSelected(selector.Group, selector.Version, selector.Kind, selector.Name, selector.LabelSelector,
+				utils.NamespaceGVK, namespace, namespaceLabels[namespace]) {
+			continue
+		}
+		for _, namespacedSelector := range selector.NamespacedResourceSelectors {
+			if isObjectSelected(namespacedSelector.Group, namespacedSelector.Version, namespacedSelector.Kind, namespacedSelector.Name,
+				namespacedSelector.LabelSelector, gvk, resource.GetName(), resource.GetLabels()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isObjectSelected checks if an object of the given kind, name and labels matches the fields of a selector.
+func isObjectSelected(group, version, kind, name string, labelSelector *metav1.LabelSelector,
+	gvk schema.GroupVersionKind, objName string, objLabels map[string]string) bool {
+	if group != gvk.Group || version != gvk.Version || kind != gvk.Kind {
+		return false
+	}
+	if name != "" {
+		return name == objName
+	}
+	if labelSelector == nil {
+		// if the labelselector not set, it means select all
+		return true
+	}
+	s, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		// should not happen as the label selector is validated by the webhook
+		klog.ErrorS(err, "Found an invalid resource selector", "labelSelector", labelSelector)
+		return false
+	}
+	return s.Matches(labels.Set(objLabels))
+}
+
+// IsResourceSelected checks if a namespaced scope resource in the given namespace is selected by any of the resource selectors.
+func IsResourceSelected(resource *unstructured.Unstructured, namespace string, selectors []fleetv1beta1.ResourceSelector) bool {
+	if resource.GetNamespace() != namespace {
+		// resourceOverride only selects the resources in its own namespace
+		return false
+	}
+	gvk := resource.GroupVersionKind()
+	for _, selector := range selectors {
+		if selector.Group == gvk.Group && selector.Version == gvk.Version && selector.Kind == gvk.Kind && selector.Name == resource.GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,440 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package overrider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
+	fleetv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func clusterRole() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"name": "clusterrole-name",
+				"labels": map[string]interface{}{
+					"app": "test",
+				},
+			},
+		},
+	}
+}
+
+func deployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "deployment-name",
+				"namespace": "app",
+			},
+		},
+	}
+}
+
+func policyWithClusterSelector(matchLabels map[string]string) *fleetv1beta1.OverridePolicy {
+	return &fleetv1beta1.OverridePolicy{
+		OverrideRules: []fleetv1beta1.OverrideRule{
+			{
+				ClusterSelector: &fleetv1beta1.ClusterSelector{
+					ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+						{
+							LabelSelector: metav1.LabelSelector{MatchLabels: matchLabels},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIsClusterResourceSelected(t *testing.T) {
+	tests := map[string]struct {
+		namespace       string
+		namespaceLabels map[string]map[string]string
+		selectors       []fleetv1beta1.ClusterResourceSelector
+		want            bool
+	}{
+		"select by name": {
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+					Name:    "clusterrole-name",
+				},
+			},
+			want: true,
+		},
+		"select all of the kind": {
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+				},
+			},
+			want: true,
+		},
+		"label selector does not match": {
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "other"},
+					},
+				},
+			},
+			want: false,
+		},
+		"gvk does not match": {
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "clusterrole-name",
+				},
+			},
+			want: false,
+		},
+		"namespaced resource is not selected": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+				},
+			},
+			want: false,
+		},
+		"namespaced resource is selected in the namespace selected by name": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "app",
+					NamespacedResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+						{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole", Name: "clusterrole-name"},
+					},
+				},
+			},
+			want: true,
+		},
+		"namespaced resource is selected in the namespace selected by labels": {
+			namespace:       "app",
+			namespaceLabels: map[string]map[string]string{"app": {"team": "a"}},
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:         "",
+					Version:       "v1",
+					Kind:          "Namespace",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+					NamespacedResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+						{
+							Group:         "rbac.authorization.k8s.io",
+							Version:       "v1",
+							Kind:          "ClusterRole",
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"namespaced resource in a namespace not selected": {
+			namespace:       "app",
+			namespaceLabels: map[string]map[string]string{"app": {"team": "b"}},
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:         "",
+					Version:       "v1",
+					Kind:          "Namespace",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+					NamespacedResourceSelectors: []fleetv1beta1.NamespacedResourceSelector{
+						{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+					},
+				},
+			},
+			want: false,
+		},
+		"namespaced resource is not selected by the namespace selector alone": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ClusterResourceSelector{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "app",
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource := clusterRole()
+			resource.SetNamespace(tt.namespace)
+			if got := IsClusterResourceSelected(resource, tt.selectors, tt.namespaceLabels); got != tt.want {
+				t.Errorf("IsClusterResourceSelected() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsResourceSelected(t *testing.T) {
+	selector := fleetv1beta1.ResourceSelector{
+		Group:   "apps",
+		Version: "v1",
+		Kind:    "Deployment",
+		Name:    "deployment-name",
+	}
+	tests := map[string]struct {
+		namespace string
+		selectors []fleetv1beta1.ResourceSelector
+		want      bool
+	}{
+		"select by name in the same namespace": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ResourceSelector{selector},
+			want:      true,
+		},
+		"resource in another namespace": {
+			namespace: "other",
+			selectors: []fleetv1beta1.ResourceSelector{selector},
+			want:      false,
+		},
+		"name does not match": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ResourceSelector{
+				{
+					Group:   "apps",
+					Version: "v1",
+					Kind:    "Deployment",
+					Name:    "other-name",
+				},
+			},
+			want: false,
+		},
+		"kind does not match": {
+			namespace: "app",
+			selectors: []fleetv1beta1.ResourceSelector{
+				{
+					Group:   "apps",
+					Version: "v1",
+					Kind:    "StatefulSet",
+					Name:    "deployment-name",
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsResourceSelected(deployment(), tt.namespace, tt.selectors); got != tt.want {
+				t.Errorf("IsResourceSelected() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsClusterSelectedByPolicy(t *testing.T) {
+	tests := map[string]struct {
+		clusterLabels map[string]string
+		policy        *fleetv1beta1.OverridePolicy
+		want          bool
+		wantErr       bool
+	}{
+		"nil policy": {
+			clusterLabels: map[string]string{"env": "prod"},
+			want:          false,
+		},
+		"rule without cluster selector selects all the clusters": {
+			clusterLabels: map[string]string{"env": "prod"},
+			policy: &fleetv1beta1.OverridePolicy{
+				OverrideRules: []fleetv1beta1.OverrideRule{{}},
+			},
+			want: true,
+		},
+		"rule with an empty cluster selector selects all the clusters": {
+			policy: &fleetv1beta1.OverridePolicy{
+				OverrideRules: []fleetv1beta1.OverrideRule{
+					{ClusterSelector: &fleetv1beta1.ClusterSelector{}},
+				},
+			},
+			want: true,
+		},
+		"cluster labels match": {
+			clusterLabels: map[string]string{"env": "prod", "region": "east"},
+			policy:        policyWithClusterSelector(map[string]string{"env": "prod"}),
+			want:          true,
+		},
+		"cluster labels do not match": {
+			clusterLabels: map[string]string{"env": "test"},
+			policy:        policyWithClusterSelector(map[string]string{"env": "prod"}),
+			want:          false,
+		},
+		"invalid label selector": {
+			clusterLabels: map[string]string{"env": "prod"},
+			policy: &fleetv1beta1.OverridePolicy{
+				OverrideRules: []fleetv1beta1.OverrideRule{
+					{
+						ClusterSelector: &fleetv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: metav1.LabelSelector{
+										MatchExpressions: []metav1.LabelSelectorRequirement{
+											{Key: "env", Operator: "invalid"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsClusterSelectedByPolicy(tt.clusterLabels, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsClusterSelectedByPolicy() got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsClusterSelectedByPolicy() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickFromResourceMatchedOverridesForTargetCluster(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "cluster-1",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+	croList := []*fleetv1beta1.ClusterResourceOverrideSnapshot{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cro-2-0"},
+			Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+					Policy: policyWithClusterSelector(map[string]string{"env": "prod"}),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cro-1-1"},
+			Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+					Policy: &fleetv1beta1.OverridePolicy{
+						OverrideRules: []fleetv1beta1.OverrideRule{{}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cro-3-0"},
+			Spec: fleetv1beta1.ClusterResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ClusterResourceOverrideSpec{
+					Policy: policyWithClusterSelector(map[string]string{"env": "test"}),
+				},
+			},
+		},
+	}
+	roList := []*fleetv1beta1.ResourceOverrideSnapshot{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ro-1-0", Namespace: "b"},
+			Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+					Policy: policyWithClusterSelector(map[string]string{"env": "prod"}),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ro-2-0", Namespace: "a"},
+			Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+					Policy: policyWithClusterSelector(map[string]string{"env": "prod"}),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ro-3-0", Namespace: "a"},
+			Spec: fleetv1beta1.ResourceOverrideSnapshotSpec{
+				OverrideSpec: fleetv1beta1.ResourceOverrideSpec{
+					Policy: policyWithClusterSelector(map[string]string{"env": "test"}),
+				},
+			},
+		},
+	}
+	tests := map[string]struct {
+		targetCluster string
+		croList       []*fleetv1beta1.ClusterResourceOverrideSnapshot
+		roList        []*fleetv1beta1.ResourceOverrideSnapshot
+		wantCRO       []string
+		wantRO        []fleetv1beta1.NamespacedName
+	}{
+		"no overrides": {
+			targetCluster: "cluster-1",
+		},
+		"pick the overrides selecting the cluster in order": {
+			targetCluster: "cluster-1",
+			croList:       croList,
+			roList:        roList,
+			wantCRO:       []string{"cro-1-1", "cro-2-0"},
+			wantRO: []fleetv1beta1.NamespacedName{
+				{Namespace: "a", Name: "ro-2-0"},
+				{Namespace: "b", Name: "ro-1-0"},
+			},
+		},
+		"cluster is not found": {
+			targetCluster: "unknown",
+			croList:       croList,
+			roList:        roList,
+			wantCRO:       []string{"cro-1-1"},
+			wantRO:        []fleetv1beta1.NamespacedName{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() got error %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+			gotCRO, gotRO, err := PickFromResourceMatchedOverridesForTargetCluster(context.Background(), fakeClient, tt.targetCluster, tt.croList, tt.roList)
+			if err != nil {
+				t.Fatalf("PickFromResourceMatchedOverridesForTargetCluster() got error %v, want nil", err)
+			}
+			if diff := cmp.Diff(tt.wantCRO, gotCRO); diff != "" {
+				t.Errorf("PickFromResourceMatchedOverridesForTargetCluster() clusterResourceOverrides mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRO, gotRO); diff != "" {
+				t.Errorf("PickFromResourceMatchedOverridesForTargetCluster() resourceOverrides mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
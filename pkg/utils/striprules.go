@@ -0,0 +1,134 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// StripRule describes the fields of a kind of resources which should not be propagated to the member clusters,
+// e.g., the annotations injected by a mutating webhook on the hub cluster.
+type StripRule struct {
+	// Group of the resources. Use an empty string for the core API group.
+	Group string `json:"group"`
+	// Version of the resources.
+	Version string `json:"version"`
+	// Kind of the resources.
+	Kind string `json:"kind"`
+	// Paths are the JSON pointers (RFC 6901) of the fields to strip, e.g. /spec/volumeName or
+	// /metadata/annotations/example.com~1injected.
+	Paths []string `json:"paths"`
+}
+
+// StripRulesConfig is the format of the strip rules config file of the hub agent.
+type StripRulesConfig struct {
+	Rules []StripRule `json:"rules"`
+}
+
+// StripRules holds the parsed strip rules indexed by the GVK of the resources they apply to.
+type StripRules struct {
+	paths map[schema.GroupVersionKind][][]string
+}
+
+// LoadStripRules reads and parses the strip rules from a config file.
+func LoadStripRules(file string) (*StripRules, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the strip rules config file %s: %w", file, err)
+	}
+	return ParseStripRules(data)
+}
+
+// ParseStripRules parses the strip rules from the content of a config file in either YAML or JSON.
+func ParseStripRules(data []byte) (*StripRules, error) {
+	var config StripRulesConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the strip rules: %w", err)
+	}
+	return NewStripRules(config.Rules)
+}
+
+// NewStripRules validates the strip rules and builds StripRules from them.
+func NewStripRules(rules []StripRule) (*StripRules, error) {
+	sr := &StripRules{paths: make(map[schema.GroupVersionKind][][]string)}
+	for _, rule := range rules {
+		if rule.Version == "" || rule.Kind == "" {
+			return nil, fmt.Errorf("the version and kind of the strip rule %+v are required", rule)
+		}
+		gvk := schema.GroupVersionKind{Group: rule.Group, Version: rule.Version, Kind: rule.Kind}
+		for _, path := range rule.Paths {
+			tokens, err := parseJSONPointer(path)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path in the strip rule of %s: %w", gvk, err)
+			}
+			sr.paths[gvk] = append(sr.paths[gvk], tokens)
+		}
+	}
+	return sr, nil
+}
+
+// Apply removes the fields matching the strip rules from the object.
+// The paths that do not exist in the object are ignored.
+func (sr *StripRules) Apply(object *unstructured.Unstructured) {
+	if sr == nil {
+		return
+	}
+	for _, tokens := range sr.paths[object.GroupVersionKind()] {
+		// the root of an object is always a map which is updated in place
+		removeField(object.Object, tokens)
+	}
+}
+
+// parseJSONPointer splits a JSON pointer into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("the JSON pointer %q must start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		// "~1" must be unescaped before "~0" so that "~01" becomes "~1" instead of "/"
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// removeField removes the field referenced by the tokens from an object or an array and returns the updated value,
+// as an array element can only be removed by building a new array.
+func removeField(obj interface{}, tokens []string) interface{} {
+	last := len(tokens) == 1
+	switch val := obj.(type) {
+	case map[string]interface{}:
+		child, ok := val[tokens[0]]
+		if !ok {
+			return val
+		}
+		if last {
+			delete(val, tokens[0])
+		} else {
+			val[tokens[0]] = removeField(child, tokens[1:])
+		}
+		return val
+	case []interface{}:
+		index, err := strconv.Atoi(tokens[0])
+		if err != nil || index < 0 || index >= len(val) {
+			return val
+		}
+		if last {
+			return append(val[:index:index], val[index+1:]...)
+		}
+		val[index] = removeField(val[index], tokens[1:])
+		return val
+	default:
+		return obj
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseStripRules(t *testing.T) {
+	tests := map[string]struct {
+		config  string
+		wantErr bool
+	}{
+		"valid rules": {
+			config: `
+rules:
+- version: v1
+  kind: PersistentVolumeClaim
+  paths:
+  - /spec/volumeName
+  - /metadata/annotations/pv.kubernetes.io~1bind-completed
+- group: apps
+  version: v1
+  kind: Deployment
+  paths:
+  - /spec/template/metadata/annotations/sidecar.example.com~1status
+`,
+		},
+		"empty config": {
+			config: "",
+		},
+		"rule without kind": {
+			config: `
+rules:
+- version: v1
+  paths:
+  - /spec/volumeName
+`,
+			wantErr: true,
+		},
+		"path which is not a JSON pointer": {
+			config: `
+rules:
+- version: v1
+  kind: PersistentVolumeClaim
+  paths:
+  - spec.volumeName
+`,
+			wantErr: true,
+		},
+		"unknown field": {
+			config: `
+rules:
+- version: v1
+  kind: PersistentVolumeClaim
+  path: /spec/volumeName
+`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseStripRules([]byte(tt.config)); (err != nil) != tt.wantErr {
+				t.Errorf("ParseStripRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStripRulesApply(t *testing.T) {
+	rules := []StripRule{
+		{
+			Version: "v1",
+			Kind:    "PersistentVolumeClaim",
+			Paths: []string{
+				"/spec/volumeName",
+				"/metadata/annotations/pv.kubernetes.io~1bind-completed",
+				"/metadata/annotations/not-exist",
+				"/status/phase",
+			},
+		},
+		{
+			Group:   "apps",
+			Version: "v1",
+			Kind:    "Deployment",
+			Paths: []string{
+				"/spec/template/spec/containers/1",
+				"/spec/template/spec/containers/0/env/5",
+				"/spec/template/metadata/annotations/a~0b",
+			},
+		},
+	}
+	tests := map[string]struct {
+		object map[string]interface{}
+		want   map[string]interface{}
+	}{
+		"strip map fields": {
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata": map[string]interface{}{
+					"name": "data",
+					"annotations": map[string]interface{}{
+						"pv.kubernetes.io/bind-completed": "yes",
+						"team":                            "app",
+					},
+				},
+				"spec": map[string]interface{}{
+					"volumeName":       "pvc-1234",
+					"storageClassName": "default",
+				},
+			},
+			want: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata": map[string]interface{}{
+					"name": "data",
+					"annotations": map[string]interface{}{
+						"team": "app",
+					},
+				},
+				"spec": map[string]interface{}{
+					"storageClassName": "default",
+				},
+			},
+		},
+		"strip array elements and escaped keys": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"annotations": map[string]interface{}{
+								"a~b": "injected",
+								"a/b": "kept",
+							},
+						},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "app"},
+								map[string]interface{}{"name": "sidecar"},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"annotations": map[string]interface{}{
+								"a/b": "kept",
+							},
+						},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "app"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"no rules for the kind": {
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"spec": map[string]interface{}{
+					"volumeName": "pvc-1234",
+				},
+			},
+			want: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"spec": map[string]interface{}{
+					"volumeName": "pvc-1234",
+				},
+			},
+		},
+	}
+	stripRules, err := NewStripRules(rules)
+	if err != nil {
+		t.Fatalf("NewStripRules() failed: %v", err)
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			object := &unstructured.Unstructured{Object: tt.object}
+			stripRules.Apply(object)
+			if diff := cmp.Diff(tt.want, object.Object); diff != "" {
+				t.Errorf("Apply() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNilStripRulesApply(t *testing.T) {
+	var stripRules *StripRules
+	object := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+	stripRules.Apply(object)
+	if diff := cmp.Diff(map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}, object.Object); diff != "" {
+		t.Errorf("Apply() mismatch (-want, +got):\n%s", diff)
+	}
+}
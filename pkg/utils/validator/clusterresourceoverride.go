@@ -0,0 +1,35 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validator
+
+import (
+	"fmt"
+
+	apiErrors "k8s.io/apimachinery/pkg/util/errors"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+// ValidateClusterResourceOverride validates a ClusterResourceOverride object.
+func ValidateClusterResourceOverride(cro *placementv1beta1.ClusterResourceOverride) error {
+	allErr := make([]error, 0)
+	for _, selector := range cro.Spec.ClusterResourceSelectors {
+		if selector.LabelSelector != nil {
+			if len(selector.Name) != 0 {
+				allErr = append(allErr, fmt.Errorf("the labelSelector and name fields are mutually exclusive in selector %+v", selector))
+			}
+			allErr = append(allErr, validateLabelSelector(selector.LabelSelector, "resource selector"))
+		}
+		// the selection scope only applies to the resources selected by a placement
+		if selector.SelectionScope != "" {
+			allErr = append(allErr, fmt.Errorf("the selectionScope field is not supported by clusterResourceOverride in selector %+v", selector))
+		}
+		if len(selector.NamespacedResourceSelectors) != 0 {
+			allErr = append(allErr, validateNamespaceSelectionScope(selector))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validator
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+func TestValidateClusterResourceOverride(t *testing.T) {
+	tests := map[string]struct {
+		selector placementv1beta1.ClusterResourceSelector
+		wantErr  bool
+	}{
+		"valid selector": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:   "",
+				Version: "v1",
+				Kind:    "Namespace",
+				Name:    "app",
+			},
+			wantErr: false,
+		},
+		"selector with both name and label selector": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:         "",
+				Version:       "v1",
+				Kind:          "Namespace",
+				Name:          "app",
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			},
+			wantErr: true,
+		},
+		"selector with the selection scope": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:          "",
+				Version:        "v1",
+				Kind:           "Namespace",
+				Name:           "app",
+				SelectionScope: placementv1beta1.NamespaceOnly,
+			},
+			wantErr: true,
+		},
+		"selector with the namespaced resource selectors": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:   "",
+				Version: "v1",
+				Kind:    "Namespace",
+				Name:    "app",
+				NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+			},
+			wantErr: false,
+		},
+		"selector of a non-namespace kind with the namespaced resource selectors": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:   "rbac.authorization.k8s.io",
+				Version: "v1",
+				Kind:    "ClusterRole",
+				NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+			},
+			wantErr: true,
+		},
+		"selector with an invalid namespaced resource selector": {
+			selector: placementv1beta1.ClusterResourceSelector{
+				Group:   "",
+				Version: "v1",
+				Kind:    "Namespace",
+				NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+					{
+						Group:         "apps",
+						Version:       "v1",
+						Kind:          "Deployment",
+						Name:          "app",
+						LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			cro := &placementv1beta1.ClusterResourceOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cro"},
+				Spec: placementv1beta1.ClusterResourceOverrideSpec{
+					ClusterResourceSelectors: []placementv1beta1.ClusterResourceSelector{testCase.selector},
+				},
+			}
+			if err := ValidateClusterResourceOverride(cro); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateClusterResourceOverride() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
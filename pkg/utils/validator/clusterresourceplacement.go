@@ -8,13 +8,18 @@ package validator
 
 import (
 	"fmt"
+	"net/url"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	apiErrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/version"
 
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	fleetv1alpha1 "go.goms.io/fleet/apis/v1alpha1"
 	"go.goms.io/fleet/pkg/utils/informer"
@@ -81,6 +86,9 @@ func ValidateClusterResourcePlacement(clusterResourcePlacement *placementv1beta1
 			}
 			allErr = append(allErr, validateLabelSelector(selector.LabelSelector, "resource selector"))
 		}
+		if selector.SelectionScope != "" || len(selector.NamespacedResourceSelectors) != 0 {
+			allErr = append(allErr, validateNamespaceSelectionScope(selector))
+		}
 	}
 
 	if clusterResourcePlacement.Spec.Policy != nil {
@@ -93,6 +101,33 @@ func ValidateClusterResourcePlacement(clusterResourcePlacement *placementv1beta1
 		allErr = append(allErr, fmt.Errorf("the rollout Strategy field  is invalid: %w", err))
 	}
 
+	if clusterResourcePlacement.Spec.PinnedResourceIndex != nil && *clusterResourcePlacement.Spec.PinnedResourceIndex < 0 {
+		allErr = append(allErr, fmt.Errorf("pinnedResourceIndex must be greater than or equal to 0, got %d", *clusterResourcePlacement.Spec.PinnedResourceIndex))
+	}
+
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateNamespaceSelectionScope validates the selection scope and the namespaced resource selectors of a selector,
+// which can only be specified for namespaces.
+func validateNamespaceSelectionScope(selector placementv1beta1.ClusterResourceSelector) error {
+	gvk := schema.GroupVersionKind{
+		Group:   selector.Group,
+		Version: selector.Version,
+		Kind:    selector.Kind,
+	}
+	if gvk != corev1.SchemeGroupVersion.WithKind("Namespace") {
+		return fmt.Errorf("the selectionScope and namespacedResourceSelectors fields can only be specified for namespaces in selector %+v", selector)
+	}
+	if selector.SelectionScope == placementv1beta1.NamespaceOnly && len(selector.NamespacedResourceSelectors) != 0 {
+		return fmt.Errorf("the namespacedResourceSelectors field cannot be specified with the %s selection scope in selector %+v", placementv1beta1.NamespaceOnly, selector)
+	}
+	allErr := make([]error, 0)
+	for _, namespacedSelector := range selector.NamespacedResourceSelectors {
+		if err := validateNamespacedResourceSelector(namespacedSelector); err != nil {
+			allErr = append(allErr, fmt.Errorf("the namespaced resource selector %+v is invalid: %w", namespacedSelector, err))
+		}
+	}
 	return apiErrors.NewAggregate(allErr)
 }
 
@@ -164,6 +199,9 @@ func validatePolicyForPickAllPlacementType(policy *placementv1beta1.PlacementPol
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, fmt.Errorf("topology spread constraints needs to be empty for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
 	}
+	if len(policy.Tolerations) > 0 {
+		allErr = append(allErr, validateTolerations(policy.Tolerations))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -187,6 +225,9 @@ func validatePolicyForPickNPolicyType(policy *placementv1beta1.PlacementPolicy)
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, validateTopologySpreadConstraints(policy.TopologySpreadConstraints))
 	}
+	if len(policy.Tolerations) > 0 {
+		allErr = append(allErr, validateTolerations(policy.Tolerations))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -223,11 +264,48 @@ func validateTopologySpreadConstraints(topologyConstraints []placementv1beta1.To
 	return apiErrors.NewAggregate(allErr)
 }
 
+func validateTolerations(tolerations []placementv1beta1.Toleration) error {
+	allErr := make([]error, 0)
+	for _, toleration := range tolerations {
+		if len(toleration.Key) > 0 {
+			for _, msg := range validation.IsQualifiedName(toleration.Key) {
+				allErr = append(allErr, fmt.Errorf("invalid key %s in toleration %+v: %s", toleration.Key, toleration, msg))
+			}
+		}
+		switch toleration.Operator {
+		// An empty operator is equivalent to Equal.
+		case "", corev1.TolerationOpEqual:
+			if len(toleration.Key) == 0 {
+				allErr = append(allErr, fmt.Errorf("toleration key cannot be empty when operator is %s, toleration %+v", corev1.TolerationOpEqual, toleration))
+			}
+			for _, msg := range validation.IsValidLabelValue(toleration.Value) {
+				allErr = append(allErr, fmt.Errorf("invalid value %s in toleration %+v: %s", toleration.Value, toleration, msg))
+			}
+		case corev1.TolerationOpExists:
+			if len(toleration.Value) > 0 {
+				allErr = append(allErr, fmt.Errorf("toleration value needs to be empty when operator is %s, toleration %+v", corev1.TolerationOpExists, toleration))
+			}
+		default:
+			allErr = append(allErr, fmt.Errorf("unsupported toleration operator %s in toleration %+v", toleration.Operator, toleration))
+		}
+		if len(toleration.Effect) > 0 && toleration.Effect != corev1.TaintEffectNoSchedule {
+			allErr = append(allErr, fmt.Errorf("unsupported toleration effect %s in toleration %+v, only %s is supported", toleration.Effect, toleration, corev1.TaintEffectNoSchedule))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
 func validateClusterSelector(clusterSelector *placementv1beta1.ClusterSelector) error {
 	allErr := make([]error, 0)
 	for _, clusterSelectorTerm := range clusterSelector.ClusterSelectorTerms {
-		// Since label selector is a required field in ClusterSelectorTerm, not checking to see if it's an empty object.
+		// Since label selector is a value field in ClusterSelectorTerm, not checking to see if it's an empty object.
 		allErr = append(allErr, validateLabelSelector(&clusterSelectorTerm.LabelSelector, "cluster selector"))
+		if clusterSelectorTerm.PropertySelector != nil {
+			allErr = append(allErr, validatePropertySelector(clusterSelectorTerm.PropertySelector, "cluster selector"))
+		}
+		if clusterSelectorTerm.PropertySorter != nil {
+			allErr = append(allErr, fmt.Errorf("the property sorter %+v is not allowed in cluster selector, it can only be used in preferred cluster selector", *clusterSelectorTerm.PropertySorter))
+		}
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -237,6 +315,12 @@ func validatePreferredClusterSelectors(preferredClusterSelectors []placementv1be
 	for _, preferredClusterSelector := range preferredClusterSelectors {
 		// API server validation on object occurs before webhook is triggered hence not validating weight.
 		allErr = append(allErr, validateLabelSelector(&preferredClusterSelector.Preference.LabelSelector, "preferred cluster selector"))
+		if preferredClusterSelector.Preference.PropertySelector != nil {
+			allErr = append(allErr, validatePropertySelector(preferredClusterSelector.Preference.PropertySelector, "preferred cluster selector"))
+		}
+		if preferredClusterSelector.Preference.PropertySorter != nil {
+			allErr = append(allErr, validatePropertySorter(preferredClusterSelector.Preference.PropertySorter))
+		}
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -248,10 +332,69 @@ func validateLabelSelector(labelSelector *metav1.LabelSelector, parent string) e
 	return nil
 }
 
+func validatePropertySelector(propertySelector *placementv1beta1.PropertySelector, parent string) error {
+	allErr := make([]error, 0)
+	for _, req := range propertySelector.MatchExpressions {
+		for _, msg := range validation.IsQualifiedName(req.Name) {
+			allErr = append(allErr, fmt.Errorf("invalid property name %s in %s %+v: %s", req.Name, parent, req, msg))
+		}
+		switch req.Operator {
+		case placementv1beta1.PropertySelectorGreaterThan,
+			placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+			placementv1beta1.PropertySelectorEqualTo,
+			placementv1beta1.PropertySelectorNotEqualTo,
+			placementv1beta1.PropertySelectorLessThan,
+			placementv1beta1.PropertySelectorLessThanOrEqualTo:
+		default:
+			allErr = append(allErr, fmt.Errorf("unsupported operator %s in %s %+v", req.Operator, parent, req))
+		}
+		if len(req.Values) != 1 {
+			allErr = append(allErr, fmt.Errorf("exactly one value is required in %s %+v, got %d", parent, req, len(req.Values)))
+			continue
+		}
+		// The Kubernetes version property is compared as a version; all the other properties are compared as quantities.
+		if clusterv1beta1.PropertyName(req.Name) == clusterv1beta1.KubernetesVersionProperty {
+			if _, err := version.ParseGeneric(req.Values[0]); err != nil {
+				allErr = append(allErr, fmt.Errorf("the value in %s %+v is not a valid version: %w", parent, req, err))
+			}
+		} else if _, err := resource.ParseQuantity(req.Values[0]); err != nil {
+			allErr = append(allErr, fmt.Errorf("the value in %s %+v is not a valid quantity: %w", parent, req, err))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+func validatePropertySorter(propertySorter *placementv1beta1.PropertySorter) error {
+	allErr := make([]error, 0)
+	for _, msg := range validation.IsQualifiedName(propertySorter.Name) {
+		allErr = append(allErr, fmt.Errorf("invalid property name %s in property sorter %+v: %s", propertySorter.Name, *propertySorter, msg))
+	}
+	// Clusters are sorted by the quantities of the property; the Kubernetes version property cannot be sorted this way.
+	if clusterv1beta1.PropertyName(propertySorter.Name) == clusterv1beta1.KubernetesVersionProperty {
+		allErr = append(allErr, fmt.Errorf("the property %s in property sorter %+v cannot be used for sorting", propertySorter.Name, *propertySorter))
+	}
+	switch propertySorter.SortOrder {
+	case placementv1beta1.Descending, placementv1beta1.Ascending:
+	default:
+		allErr = append(allErr, fmt.Errorf("unsupported sort order %s in property sorter %+v", propertySorter.SortOrder, *propertySorter))
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
 func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) error {
 	allErr := make([]error, 0)
 
-	if rolloutStrategy.Type != "" && rolloutStrategy.Type != placementv1beta1.RollingUpdateRolloutStrategyType {
+	switch rolloutStrategy.Type {
+	case "", placementv1beta1.RollingUpdateRolloutStrategyType:
+		if rolloutStrategy.StagedUpdate != nil {
+			allErr = append(allErr, fmt.Errorf("stagedUpdate is only allowed with the `%s` rollout strategy type",
+				placementv1beta1.StagedUpdateRolloutStrategyType))
+		}
+	case placementv1beta1.StagedUpdateRolloutStrategyType:
+		if err := validateStagedUpdateStrategy(rolloutStrategy.StagedUpdate); err != nil {
+			allErr = append(allErr, fmt.Errorf("the stagedUpdate field is invalid: %w", err))
+		}
+	default:
 		allErr = append(allErr, fmt.Errorf("unsupported rollout strategy type `%s`", rolloutStrategy.Type))
 	}
 
@@ -279,5 +422,113 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 		}
 	}
 
+	if rolloutStrategy.ApplyStrategy != nil && rolloutStrategy.ApplyStrategy.ServerSideApplyConfig != nil &&
+		rolloutStrategy.ApplyStrategy.Type != placementv1beta1.ApplyStrategyTypeServerSideApply {
+		allErr = append(allErr, fmt.Errorf("serverSideApplyConfig is only allowed with the `%s` apply strategy type, got `%s`",
+			placementv1beta1.ApplyStrategyTypeServerSideApply, rolloutStrategy.ApplyStrategy.Type))
+	}
+
+	if rolloutStrategy.DeletionPolicy != nil {
+		isRetain := rolloutStrategy.DeletionPolicy.Type == placementv1beta1.DeletionPolicyTypeRetain
+		hasGracePeriod := rolloutStrategy.DeletionPolicy.RetainGracePeriodSeconds != nil
+		if isRetain && !hasGracePeriod {
+			allErr = append(allErr, fmt.Errorf("retainGracePeriodSeconds is required with the `%s` deletion policy type",
+				placementv1beta1.DeletionPolicyTypeRetain))
+		}
+		if !isRetain && hasGracePeriod {
+			allErr = append(allErr, fmt.Errorf("retainGracePeriodSeconds is only allowed with the `%s` deletion policy type, got `%s`",
+				placementv1beta1.DeletionPolicyTypeRetain, rolloutStrategy.DeletionPolicy.Type))
+		}
+	}
+
+	if err := validateRolloutGates(rolloutStrategy.RolloutGates); err != nil {
+		allErr = append(allErr, fmt.Errorf("the rolloutGates field is invalid: %w", err))
+	}
+
+	return apiErrors.NewAggregate(allErr)
+}
+
+func validateRolloutGates(gates []placementv1beta1.RolloutGate) error {
+	allErr := make([]error, 0)
+	gateNames := make(map[string]bool, len(gates))
+	for _, gate := range gates {
+		for _, msg := range validation.IsDNS1123Label(gate.Name) {
+			allErr = append(allErr, fmt.Errorf("invalid gate name `%s`: %s", gate.Name, msg))
+		}
+		if gateNames[gate.Name] {
+			allErr = append(allErr, fmt.Errorf("duplicated gate name `%s`", gate.Name))
+		}
+		gateNames[gate.Name] = true
+		if gate.TimeoutSeconds != nil && *gate.TimeoutSeconds < 1 {
+			allErr = append(allErr, fmt.Errorf("the timeoutSeconds of gate `%s` must be greater than or equal to 1, got %d", gate.Name, *gate.TimeoutSeconds))
+		}
+		switch gate.Type {
+		case placementv1beta1.RolloutGateTypeHTTPProbe:
+			if gate.HTTPProbe == nil {
+				allErr = append(allErr, fmt.Errorf("the httpProbe of gate `%s` is required", gate.Name))
+			} else if err := validateHTTPURL(gate.HTTPProbe.URL); err != nil {
+				allErr = append(allErr, fmt.Errorf("the url of gate `%s` is invalid: %w", gate.Name, err))
+			}
+			if gate.MetricsQuery != nil {
+				allErr = append(allErr, fmt.Errorf("metricsQuery of gate `%s` is only allowed with the `%s` gate type",
+					gate.Name, placementv1beta1.RolloutGateTypeMetricsQuery))
+			}
+		case placementv1beta1.RolloutGateTypeMetricsQuery:
+			if gate.MetricsQuery == nil {
+				allErr = append(allErr, fmt.Errorf("the metricsQuery of gate `%s` is required", gate.Name))
+			} else {
+				if err := validateHTTPURL(gate.MetricsQuery.Address); err != nil {
+					allErr = append(allErr, fmt.Errorf("the address of gate `%s` is invalid: %w", gate.Name, err))
+				}
+				if len(gate.MetricsQuery.Query) == 0 {
+					allErr = append(allErr, fmt.Errorf("the query of gate `%s` is required", gate.Name))
+				}
+			}
+			if gate.HTTPProbe != nil {
+				allErr = append(allErr, fmt.Errorf("httpProbe of gate `%s` is only allowed with the `%s` gate type",
+					gate.Name, placementv1beta1.RolloutGateTypeHTTPProbe))
+			}
+		default:
+			allErr = append(allErr, fmt.Errorf("unsupported type `%s` of gate `%s`", gate.Type, gate.Name))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateHTTPURL checks if the address is an absolute http or https url.
+func validateHTTPURL(address string) error {
+	u, err := url.Parse(address)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("`%s` is not an absolute http or https url", address)
+	}
+	return nil
+}
+
+func validateStagedUpdateStrategy(stagedUpdate *placementv1beta1.StagedUpdateStrategy) error {
+	if stagedUpdate == nil || len(stagedUpdate.Stages) == 0 {
+		return fmt.Errorf("at least one stage is required")
+	}
+	allErr := make([]error, 0)
+	stageNames := make(map[string]bool, len(stagedUpdate.Stages))
+	for _, stage := range stagedUpdate.Stages {
+		for _, msg := range validation.IsDNS1123Label(stage.Name) {
+			allErr = append(allErr, fmt.Errorf("invalid stage name `%s`: %s", stage.Name, msg))
+		}
+		if stageNames[stage.Name] {
+			allErr = append(allErr, fmt.Errorf("duplicated stage name `%s`", stage.Name))
+		}
+		stageNames[stage.Name] = true
+		if stage.LabelSelector == nil {
+			allErr = append(allErr, fmt.Errorf("the labelSelector of stage `%s` is required", stage.Name))
+		} else if err := validateLabelSelector(stage.LabelSelector, "stage "+stage.Name); err != nil {
+			allErr = append(allErr, err)
+		}
+		if stage.WaitTime != nil && stage.WaitTime.Duration < 0 {
+			allErr = append(allErr, fmt.Errorf("the waitTime of stage `%s` must be greater than or equal to 0, got %s", stage.Name, stage.WaitTime.Duration))
+		}
+	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -7,10 +7,15 @@ package validator
 
 import (
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 
+	clusterv1beta1 "go.goms.io/fleet/apis/cluster/v1beta1"
 	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	fleetv1alpha1 "go.goms.io/fleet/apis/v1alpha1"
 	"go.goms.io/fleet/pkg/utils/informer"
@@ -242,6 +247,135 @@ func TestValidateClusterResourcePlacement(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"CRP with negative pinned resource index": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+					},
+					PinnedResourceIndex: pointer.Int32(-1),
+				},
+			},
+			wantErr: true,
+		},
+		"valid namespace selector with the NamespaceOnly selection scope": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{
+						{
+							Group:          "",
+							Version:        "v1",
+							Kind:           "Namespace",
+							Name:           "test-ns",
+							SelectionScope: placementv1beta1.NamespaceOnly,
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          false,
+		},
+		"valid namespace selector with namespaced resource selectors": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{
+						{
+							Group:   "",
+							Version: "v1",
+							Kind:    "Namespace",
+							Name:    "test-ns",
+							NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+								{
+									Group:   "rbac.authorization.k8s.io",
+									Version: "v1",
+									Kind:    "Role",
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key": "test-value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          false,
+		},
+		"invalid selection scope for a cluster scoped resource": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{
+						{
+							Group:          "rbac.authorization.k8s.io",
+							Version:        "v1",
+							Kind:           "ClusterRole",
+							Name:           "test-cluster-role",
+							SelectionScope: placementv1beta1.NamespaceOnly,
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"invalid namespaced resource selectors with the NamespaceOnly selection scope": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{
+						{
+							Group:          "",
+							Version:        "v1",
+							Kind:           "Namespace",
+							Name:           "test-ns",
+							SelectionScope: placementv1beta1.NamespaceOnly,
+							NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+								{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"invalid namespaced resource selector selecting a cluster scoped resource": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{
+						{
+							Group:   "",
+							Version: "v1",
+							Kind:    "Namespace",
+							Name:    "test-ns",
+							NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+								{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -381,194 +515,150 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			},
 			wantErr: true,
 		},
-	}
-
-	for testName, testCase := range tests {
-		t.Run(testName, func(t *testing.T) {
-			ResourceInformer = testCase.resourceInformer
-			if err := ValidateClusterResourcePlacement(testCase.crp); (err != nil) != testCase.wantErr {
-				t.Errorf("ValidateClusterResourcePlacement_RolloutStrategy() error = %v, wantErr %v", err, testCase.wantErr)
-			}
-		})
-	}
-}
-
-func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T) {
-	tests := map[string]struct {
-		crp              *placementv1beta1.ClusterResourcePlacement
-		resourceInformer informer.Manager
-		wantErr          bool
-	}{
-		"valid placement policy - PickFixed with non-empty cluster names": {
+		"valid apply strategy - server side apply with config": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickFixedPlacementType,
-						ClusterNames:  []string{"test-cluster"},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:                  placementv1beta1.ApplyStrategyTypeServerSideApply,
+							ServerSideApplyConfig: &placementv1beta1.ServerSideApplyConfig{ForceConflicts: true},
+						},
 					},
 				},
 			},
 			wantErr: false,
 		},
-		"invalid placement policy - PickFixed with empty cluster names": {
+		"invalid apply strategy - server side apply config with client side apply": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickFixedPlacementType,
-					},
 					Strategy: placementv1beta1.RolloutStrategy{
-						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:                  placementv1beta1.ApplyStrategyTypeClientSideApply,
+							ServerSideApplyConfig: &placementv1beta1.ServerSideApplyConfig{ForceConflicts: true},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickFixed with non nil number of clusters": {
+		"valid deletion policy - retain with grace period": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType:    placementv1beta1.PickFixedPlacementType,
-						ClusterNames:     []string{"test-cluster"},
-						NumberOfClusters: &positiveNumberOfClusters,
+					Strategy: placementv1beta1.RolloutStrategy{
+						DeletionPolicy: &placementv1beta1.DeletionPolicy{
+							Type:                     placementv1beta1.DeletionPolicyTypeRetain,
+							RetainGracePeriodSeconds: pointer.Int32(60),
+						},
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
-		"invalid placement policy - PickFixed with non nil affinity": {
+		"invalid deletion policy - retain without grace period": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickFixedPlacementType,
-						ClusterNames:  []string{"test-cluster"},
-						Affinity: &placementv1beta1.Affinity{
-							ClusterAffinity: &placementv1beta1.ClusterAffinity{
-								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
-									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
-										{
-											LabelSelector: metav1.LabelSelector{
-												MatchLabels: map[string]string{"test-key": "test-value"},
-											},
-										},
-									},
-								},
-							},
+					Strategy: placementv1beta1.RolloutStrategy{
+						DeletionPolicy: &placementv1beta1.DeletionPolicy{
+							Type: placementv1beta1.DeletionPolicyTypeRetain,
 						},
 					},
 				},
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickFixed with non empty topology constraints": {
+		"valid rollout strategy - staged update": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickFixedPlacementType,
-						ClusterNames:  []string{"test-cluster"},
-						TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
-							{
-								TopologyKey: "test-key",
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &placementv1beta1.StagedUpdateStrategy{
+							Stages: []placementv1beta1.StageConfig{
+								{
+									Name:            "canary",
+									LabelSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"env": "canary"}},
+									WaitTime:        &metav1.Duration{Duration: time.Hour},
+									RequireApproval: true,
+								},
+								{
+									Name:          "prod",
+									LabelSelector: &metav1.LabelSelector{},
+								},
 							},
 						},
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
-	}
-
-	for testName, testCase := range tests {
-		t.Run(testName, func(t *testing.T) {
-			ResourceInformer = testCase.resourceInformer
-			if err := ValidateClusterResourcePlacement(testCase.crp); (err != nil) != testCase.wantErr {
-				t.Errorf("ValidateClusterResourcePlacement_PickFixedPlacementPolicy() error = %v, wantErr %v", err, testCase.wantErr)
-			}
-		})
-	}
-}
-
-func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
-	tests := map[string]struct {
-		crp              *placementv1beta1.ClusterResourcePlacement
-		resourceInformer informer.Manager
-		wantErr          bool
-	}{
-		"invalid placement policy - PickAll with non-empty cluster names": {
+		"invalid rollout strategy - staged update without stages": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickAllPlacementType,
-						ClusterNames:  []string{"test-cluster"},
-					},
 					Strategy: placementv1beta1.RolloutStrategy{
-						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+						Type: placementv1beta1.StagedUpdateRolloutStrategyType,
 					},
 				},
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickAll with non nil number of clusters": {
+		"invalid rollout strategy - staged update with duplicated stage names": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType:    placementv1beta1.PickFixedPlacementType,
-						NumberOfClusters: &positiveNumberOfClusters,
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &placementv1beta1.StagedUpdateStrategy{
+							Stages: []placementv1beta1.StageConfig{
+								{Name: "canary", LabelSelector: &metav1.LabelSelector{}},
+								{Name: "canary", LabelSelector: &metav1.LabelSelector{}},
+							},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickAll with invalid label selector terms in RequiredDuringSchedulingIgnoredDuringExecution in affinity": {
+		"invalid rollout strategy - staged update with invalid label selector": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickAllPlacementType,
-						Affinity: &placementv1beta1.Affinity{
-							ClusterAffinity: &placementv1beta1.ClusterAffinity{
-								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
-									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
-										{
-											LabelSelector: metav1.LabelSelector{
-												MatchExpressions: []metav1.LabelSelectorRequirement{
-													{
-														Key:      "test-key",
-														Operator: metav1.LabelSelectorOpIn,
-													},
-												},
-											},
-										},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &placementv1beta1.StagedUpdateStrategy{
+							Stages: []placementv1beta1.StageConfig{
+								{
+									Name: "canary",
+									LabelSelector: &metav1.LabelSelector{
+										MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "random"}},
 									},
 								},
 							},
@@ -578,27 +668,18 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickAll with non empty PreferredDuringSchedulingIgnoredDuringExecution": {
+		"invalid rollout strategy - staged update with negative wait time": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickAllPlacementType,
-						Affinity: &placementv1beta1.Affinity{
-							ClusterAffinity: &placementv1beta1.ClusterAffinity{
-								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
-									{
-										Weight: 1,
-										Preference: placementv1beta1.ClusterSelectorTerm{
-											LabelSelector: metav1.LabelSelector{
-												MatchLabels: map[string]string{"test-key": "test-value"},
-											},
-										},
-									},
-								},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.StagedUpdateRolloutStrategyType,
+						StagedUpdate: &placementv1beta1.StagedUpdateStrategy{
+							Stages: []placementv1beta1.StageConfig{
+								{Name: "canary", LabelSelector: &metav1.LabelSelector{}, WaitTime: &metav1.Duration{Duration: -time.Minute}},
 							},
 						},
 					},
@@ -606,44 +687,44 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			},
 			wantErr: true,
 		},
-		"invalid placement policy - PickAll with non empty topology constraints": {
+		"invalid rollout strategy - staged update config with rolling update type": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickAllPlacementType,
-						TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
-							{
-								TopologyKey: "test-key",
-							},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+						StagedUpdate: &placementv1beta1.StagedUpdateStrategy{
+							Stages: []placementv1beta1.StageConfig{{Name: "canary", LabelSelector: &metav1.LabelSelector{}}},
 						},
 					},
 				},
 			},
 			wantErr: true,
 		},
-		"valid placement policy - PickAll with non nil affinity": {
+		"valid rollout gates": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-crp",
 				},
 				Spec: placementv1beta1.ClusterResourcePlacementSpec{
 					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
-					Policy: &placementv1beta1.PlacementPolicy{
-						PlacementType: placementv1beta1.PickAllPlacementType,
-						Affinity: &placementv1beta1.Affinity{
-							ClusterAffinity: &placementv1beta1.ClusterAffinity{
-								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
-									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
-										{
-											LabelSelector: metav1.LabelSelector{
-												MatchLabels: map[string]string{"test-key": "test-value"},
-											},
-										},
-									},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{
+								Name:      "health",
+								Type:      placementv1beta1.RolloutGateTypeHTTPProbe,
+								HTTPProbe: &placementv1beta1.HTTPProbeGate{URL: "http://app.app.svc.cluster.local:8080/healthz"},
+							},
+							{
+								Name: "error-rate",
+								Type: placementv1beta1.RolloutGateTypeMetricsQuery,
+								MetricsQuery: &placementv1beta1.MetricsQueryGate{
+									Address:   "http://prometheus.monitoring.svc.cluster.local:9090",
+									Query:     "sum(rate(http_requests_total{code=~\"5..\"}[5m])) / sum(rate(http_requests_total[5m]))",
+									Threshold: resource.MustParse("0.05"),
 								},
 							},
 						},
@@ -652,9 +733,458 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			},
 			wantErr: false,
 		},
-	}
-
-	for testName, testCase := range tests {
+		"invalid rollout gates - duplicated gate names": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{Name: "health", Type: placementv1beta1.RolloutGateTypeHTTPProbe, HTTPProbe: &placementv1beta1.HTTPProbeGate{URL: "http://app/healthz"}},
+							{Name: "health", Type: placementv1beta1.RolloutGateTypeHTTPProbe, HTTPProbe: &placementv1beta1.HTTPProbeGate{URL: "http://app/readyz"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid rollout gates - http probe without url scheme": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{Name: "health", Type: placementv1beta1.RolloutGateTypeHTTPProbe, HTTPProbe: &placementv1beta1.HTTPProbeGate{URL: "app:8080/healthz"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid rollout gates - metrics query config with http probe type": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{
+								Name:      "health",
+								Type:      placementv1beta1.RolloutGateTypeHTTPProbe,
+								HTTPProbe: &placementv1beta1.HTTPProbeGate{URL: "http://app/healthz"},
+								MetricsQuery: &placementv1beta1.MetricsQueryGate{
+									Address: "http://prometheus:9090",
+									Query:   "up",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid rollout gates - metrics query without query": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{
+								Name:         "error-rate",
+								Type:         placementv1beta1.RolloutGateTypeMetricsQuery,
+								MetricsQuery: &placementv1beta1.MetricsQueryGate{Address: "http://prometheus:9090"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid deletion policy - grace period with orphan": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						DeletionPolicy: &placementv1beta1.DeletionPolicy{
+							Type:                     placementv1beta1.DeletionPolicyTypeOrphan,
+							RetainGracePeriodSeconds: pointer.Int32(60),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ResourceInformer = testCase.resourceInformer
+			if err := ValidateClusterResourcePlacement(testCase.crp); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateClusterResourcePlacement_RolloutStrategy() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T) {
+	tests := map[string]struct {
+		crp              *placementv1beta1.ClusterResourcePlacement
+		resourceInformer informer.Manager
+		wantErr          bool
+	}{
+		"valid placement policy - PickFixed with non-empty cluster names": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickFixedPlacementType,
+						ClusterNames:  []string{"test-cluster"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickFixed with empty cluster names": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickFixedPlacementType,
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickFixed with non nil number of clusters": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickFixedPlacementType,
+						ClusterNames:     []string{"test-cluster"},
+						NumberOfClusters: &positiveNumberOfClusters,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickFixed with non nil affinity": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickFixedPlacementType,
+						ClusterNames:  []string{"test-cluster"},
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											LabelSelector: metav1.LabelSelector{
+												MatchLabels: map[string]string{"test-key": "test-value"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickFixed with non empty topology constraints": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickFixedPlacementType,
+						ClusterNames:  []string{"test-cluster"},
+						TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+							{
+								TopologyKey: "test-key",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ResourceInformer = testCase.resourceInformer
+			if err := ValidateClusterResourcePlacement(testCase.crp); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateClusterResourcePlacement_PickFixedPlacementPolicy() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
+	tests := map[string]struct {
+		crp              *placementv1beta1.ClusterResourcePlacement
+		resourceInformer informer.Manager
+		wantErr          bool
+	}{
+		"invalid placement policy - PickAll with non-empty cluster names": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						ClusterNames:  []string{"test-cluster"},
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with non nil number of clusters": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickFixedPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with invalid label selector terms in RequiredDuringSchedulingIgnoredDuringExecution in affinity": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											LabelSelector: metav1.LabelSelector{
+												MatchExpressions: []metav1.LabelSelectorRequirement{
+													{
+														Key:      "test-key",
+														Operator: metav1.LabelSelectorOpIn,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with non empty PreferredDuringSchedulingIgnoredDuringExecution": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+									{
+										Weight: 1,
+										Preference: placementv1beta1.ClusterSelectorTerm{
+											LabelSelector: metav1.LabelSelector{
+												MatchLabels: map[string]string{"test-key": "test-value"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with non empty topology constraints": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+							{
+								TopologyKey: "test-key",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"valid placement policy - PickAll with non nil affinity": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											LabelSelector: metav1.LabelSelector{
+												MatchLabels: map[string]string{"test-key": "test-value"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickAll with toleration of Exists operator and non empty value": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Key:      "test-key",
+								Operator: corev1.TolerationOpExists,
+								Value:    "test-value",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with toleration of Equal operator and empty key": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Operator: corev1.TolerationOpEqual,
+								Value:    "test-value",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickAll with toleration of unsupported effect": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Key:      "test-key",
+								Operator: corev1.TolerationOpExists,
+								Effect:   corev1.TaintEffectNoExecute,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"valid placement policy - PickAll with tolerations": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Key:      "test-key",
+								Operator: corev1.TolerationOpEqual,
+								Value:    "test-value",
+								Effect:   corev1.TaintEffectNoSchedule,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
 			ResourceInformer = testCase.resourceInformer
 			if err := ValidateClusterResourcePlacement(testCase.crp); (err != nil) != testCase.wantErr {
@@ -854,6 +1384,303 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"invalid placement policy - PickN with toleration of invalid key": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Key:      "test-key!",
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"valid placement policy - PickN with toleration of Exists operator and empty key": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with invalid quantity in property selector": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											PropertySelector: &placementv1beta1.PropertySelector{
+												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+													{
+														Name:     string(clusterv1beta1.NodeCountProperty),
+														Operator: placementv1beta1.PropertySelectorGreaterThan,
+														Values:   []string{"three"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickN with invalid version in preferred property selector": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+									{
+										Weight: 10,
+										Preference: placementv1beta1.ClusterSelectorTerm{
+											PropertySelector: &placementv1beta1.PropertySelector{
+												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+													{
+														Name:     string(clusterv1beta1.KubernetesVersionProperty),
+														Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+														Values:   []string{"latest"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickN with invalid property name in property selector": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											PropertySelector: &placementv1beta1.PropertySelector{
+												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+													{
+														Name:     "invalid name!",
+														Operator: placementv1beta1.PropertySelectorEqualTo,
+														Values:   []string{"1"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickN with property sorter in required cluster selector": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+												SortOrder: placementv1beta1.Descending,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickN with unsupported sort order in property sorter": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+									{
+										Weight: 10,
+										Preference: placementv1beta1.ClusterSelectorTerm{
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+												SortOrder: "Random",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"invalid placement policy - PickN with Kubernetes version property in property sorter": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+									{
+										Weight: 10,
+										Preference: placementv1beta1.ClusterSelectorTerm{
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      string(clusterv1beta1.KubernetesVersionProperty),
+												SortOrder: placementv1beta1.Ascending,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"valid placement policy - PickN with property sorter": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+									{
+										Weight: 10,
+										Preference: placementv1beta1.ClusterSelectorTerm{
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      string(clusterv1beta1.AvailableMemoryCapacityProperty),
+												SortOrder: placementv1beta1.Descending,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"valid placement policy - PickN with property selectors": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.ClusterResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.ClusterResourceSelector{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: &positiveNumberOfClusters,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											PropertySelector: &placementv1beta1.PropertySelector{
+												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+													{
+														Name:     string(clusterv1beta1.AllocatableMemoryCapacityProperty),
+														Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+														Values:   []string{"16Gi"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for testName, testCase := range tests {
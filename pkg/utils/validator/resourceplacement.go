@@ -0,0 +1,94 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validator
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiErrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+)
+
+// ValidateResourcePlacement validates a ResourcePlacement object.
+func ValidateResourcePlacement(resourcePlacement *placementv1beta1.ResourcePlacement) error {
+	allErr := make([]error, 0)
+
+	// the name is set as a label value of the clusterResourcePlacement backing the resourcePlacement
+	if len(resourcePlacement.Name) > validation.LabelValueMaxLength {
+		allErr = append(allErr, fmt.Errorf("the name field cannot have length exceeding %d", validation.LabelValueMaxLength))
+	}
+
+	// we leverage the informer manager to do the resource scope validation
+	if ResourceInformer == nil {
+		allErr = append(allErr, fmt.Errorf("cannot perform resource scope check for now, please retry"))
+	}
+
+	for _, selector := range resourcePlacement.Spec.ResourceSelectors {
+		allErr = append(allErr, validateNamespacedResourceSelector(selector))
+	}
+
+	if resourcePlacement.Spec.Policy != nil {
+		if err := validatePlacementPolicy(resourcePlacement.Spec.Policy); err != nil {
+			allErr = append(allErr, fmt.Errorf("the placement policy field is invalid: %w", err))
+		}
+		// tolerating the taints of the member clusters is left to the fleet admins
+		if len(resourcePlacement.Spec.Policy.Tolerations) != 0 {
+			allErr = append(allErr, fmt.Errorf("the tolerations field is not supported by resourcePlacement"))
+		}
+	}
+
+	if err := validateRolloutStrategy(resourcePlacement.Spec.Strategy); err != nil {
+		allErr = append(allErr, fmt.Errorf("the rollout Strategy field  is invalid: %w", err))
+	}
+	allErr = append(allErr, validateResourcePlacementStrategy(resourcePlacement.Spec.Strategy))
+
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateResourcePlacementStrategy rejects the rollout strategy fields which reach beyond the namespace of a
+// resourcePlacement on the target clusters, i.e., the rollout gates run by the member agent, and taking over or forcing
+// the ownership of the resources which are not placed by Fleet.
+func validateResourcePlacementStrategy(strategy placementv1beta1.RolloutStrategy) error {
+	allErr := make([]error, 0)
+	if len(strategy.RolloutGates) != 0 {
+		allErr = append(allErr, fmt.Errorf("the rolloutGates field is not supported by resourcePlacement"))
+	}
+	if applyStrategy := strategy.ApplyStrategy; applyStrategy != nil {
+		if applyStrategy.WhenToTakeOver != "" && applyStrategy.WhenToTakeOver != placementv1beta1.WhenToTakeOverTypeNever {
+			allErr = append(allErr, fmt.Errorf("the whenToTakeOver field can only be %s in resourcePlacement, got %s",
+				placementv1beta1.WhenToTakeOverTypeNever, applyStrategy.WhenToTakeOver))
+		}
+		if applyStrategy.ServerSideApplyConfig != nil && applyStrategy.ServerSideApplyConfig.ForceConflicts {
+			allErr = append(allErr, fmt.Errorf("the force field of the serverSideApplyConfig is not supported by resourcePlacement"))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateNamespacedResourceSelector validates a selector of the namespace scoped resources.
+func validateNamespacedResourceSelector(selector placementv1beta1.NamespacedResourceSelector) error {
+	allErr := make([]error, 0)
+	if selector.LabelSelector != nil {
+		if len(selector.Name) != 0 {
+			allErr = append(allErr, fmt.Errorf("the labelSelector and name fields are mutually exclusive in selector %+v", selector))
+		}
+		allErr = append(allErr, validateLabelSelector(selector.LabelSelector, "resource selector"))
+	}
+	if ResourceInformer != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   selector.Group,
+			Version: selector.Version,
+			Kind:    selector.Kind,
+		}
+		if ResourceInformer.IsClusterScopedResources(gvk) {
+			allErr = append(allErr, fmt.Errorf("the resource is a cluster scoped resource which cannot be selected by a namespaced resource selector: %v", gvk))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/informer"
+)
+
+func TestValidateResourcePlacement(t *testing.T) {
+	namespacedResourceSelector := placementv1beta1.NamespacedResourceSelector{
+		Group:   "rbac.authorization.k8s.io",
+		Version: "v1",
+		Kind:    "Role",
+		Name:    "test-role",
+	}
+	tests := map[string]struct {
+		rp               *placementv1beta1.ResourcePlacement
+		resourceInformer informer.Manager
+		wantErr          bool
+	}{
+		"valid RP": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          false,
+		},
+		"RP with a name exceeding the label value length": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 64), Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with a cluster scoped resource selector": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+						{
+							Group:   "rbac.authorization.k8s.io",
+							Version: "v1",
+							Kind:    "ClusterRole",
+							Name:    "test-cluster-role",
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with both name and label selector": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+						{
+							Group:   "rbac.authorization.k8s.io",
+							Version: "v1",
+							Kind:    "Role",
+							Name:    "test-role",
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "test"},
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with an invalid placement policy": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: pointer.Int32(-1),
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with an apply strategy which never takes over": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:           placementv1beta1.ApplyStrategyTypeServerSideApply,
+							WhenToTakeOver: placementv1beta1.WhenToTakeOverTypeNever,
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          false,
+		},
+		"RP with rollout gates": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						RolloutGates: []placementv1beta1.RolloutGate{
+							{
+								Name: "probe",
+								Type: placementv1beta1.RolloutGateTypeHTTPProbe,
+								HTTPProbe: &placementv1beta1.HTTPProbeGate{
+									URL: "http://app.app.svc/healthz",
+								},
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with an apply strategy which takes over the existing resources": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							WhenToTakeOver: placementv1beta1.WhenToTakeOverTypeIfNoDiff,
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with an apply strategy which forces the conflicts": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:                  placementv1beta1.ApplyStrategyTypeServerSideApply,
+							ServerSideApplyConfig: &placementv1beta1.ServerSideApplyConfig{ForceConflicts: true},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"RP with tolerations": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						Tolerations: []placementv1beta1.Toleration{
+							{
+								Key:      "key1",
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+			resourceInformer: MockResourceInformer{},
+			wantErr:          true,
+		},
+		"nil resource informer": {
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "app"},
+				Spec: placementv1beta1.ResourcePlacementSpec{
+					ResourceSelectors: []placementv1beta1.NamespacedResourceSelector{namespacedResourceSelector},
+				},
+			},
+			resourceInformer: nil,
+			wantErr:          true,
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ResourceInformer = testCase.resourceInformer
+			if err := ValidateResourcePlacement(testCase.rp); (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateResourcePlacement() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
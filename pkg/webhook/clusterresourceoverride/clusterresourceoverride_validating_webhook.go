@@ -0,0 +1,57 @@
+package clusterresourceoverride
+
+import (
+	"context"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/validator"
+)
+
+const (
+	// ValidationPath is the webhook service path which admission requests are routed to for validating ClusterResourceOverride resources.
+	ValidationPath = "/validate-placement.kubernetes-fleet.io-v1beta1-clusterresourceoverride"
+)
+
+type clusterResourceOverrideValidator struct {
+	Client  client.Client
+	decoder *admission.Decoder
+}
+
+// Add registers the webhook for the ClusterResourceOverride.
+func Add(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &clusterResourceOverrideValidator{Client: mgr.GetClient()}})
+	return nil
+}
+
+// Handle clusterResourceOverrideValidator handles create, update CRO requests.
+func (v *clusterResourceOverrideValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	var cro placementv1beta1.ClusterResourceOverride
+	if req.Operation == admissionv1.Create || req.Operation == admissionv1.Update {
+		klog.V(2).InfoS("handling CRO", "operation", req.Operation, "name", req.Name)
+		if err := v.decoder.Decode(req, &cro); err != nil {
+			klog.ErrorS(err, "failed to decode CRO object for create/update operation", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := validator.ValidateClusterResourceOverride(&cro); err != nil {
+			klog.V(2).InfoS("cluster resource override has invalid fields, request is denied", "operation", req.Operation, "name", req.Name)
+			return admission.Denied(err.Error())
+		}
+	}
+	klog.V(2).InfoS("user is allowed to modify cluster resource override", "operation", req.Operation, "user", req.UserInfo.Username, "group", req.UserInfo.Groups, "name", req.Name)
+	return admission.Allowed("any user is allowed to modify CRO")
+}
+
+// InjectDecoder injects the decoder.
+func (v *clusterResourceOverrideValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
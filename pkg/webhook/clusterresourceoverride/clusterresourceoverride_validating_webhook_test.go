@@ -0,0 +1,94 @@
+package clusterresourceoverride
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils"
+)
+
+func TestHandle(t *testing.T) {
+	croWithSelector := func(selector placementv1beta1.ClusterResourceSelector) []byte {
+		cro := &placementv1beta1.ClusterResourceOverride{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: placementv1beta1.GroupVersion.String(),
+				Kind:       placementv1beta1.ClusterResourceOverrideKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cro"},
+			Spec: placementv1beta1.ClusterResourceOverrideSpec{
+				ClusterResourceSelectors: []placementv1beta1.ClusterResourceSelector{selector},
+			},
+		}
+		croBytes, err := json.Marshal(cro)
+		assert.Nil(t, err)
+		return croBytes
+	}
+
+	scheme := runtime.NewScheme()
+	err := placementv1beta1.AddToScheme(scheme)
+	assert.Nil(t, err)
+	decoder, err := admission.NewDecoder(scheme)
+	assert.Nil(t, err)
+
+	testCases := map[string]struct {
+		croBytes    []byte
+		wantAllowed bool
+	}{
+		"allow the CRO selecting the namespaced resources in the selected namespaces": {
+			croBytes: croWithSelector(placementv1beta1.ClusterResourceSelector{
+				Version: "v1",
+				Kind:    "Namespace",
+				Name:    "app",
+				NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+			}),
+			wantAllowed: true,
+		},
+		"deny the CRO with the selection scope": {
+			croBytes: croWithSelector(placementv1beta1.ClusterResourceSelector{
+				Version:        "v1",
+				Kind:           "Namespace",
+				Name:           "app",
+				SelectionScope: placementv1beta1.NamespaceOnly,
+			}),
+			wantAllowed: false,
+		},
+		"deny the CRO selecting the namespaced resources with a non-namespace selector": {
+			croBytes: croWithSelector(placementv1beta1.ClusterResourceSelector{
+				Group:   "rbac.authorization.k8s.io",
+				Version: "v1",
+				Kind:    "ClusterRole",
+				NamespacedResourceSelectors: []placementv1beta1.NamespacedResourceSelector{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+			}),
+			wantAllowed: false,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			v := clusterResourceOverrideValidator{decoder: decoder}
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Name:      "test-cro",
+					Object:    runtime.RawExtension{Raw: testCase.croBytes},
+					UserInfo:  authenticationv1.UserInfo{Username: "test-user"},
+					Operation: admissionv1.Create,
+				},
+			}
+			gotResult := v.Handle(context.Background(), req)
+			assert.Equal(t, testCase.wantAllowed, gotResult.Allowed, utils.TestCaseMsg, testName)
+		})
+	}
+}
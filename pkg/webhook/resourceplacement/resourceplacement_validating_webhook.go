@@ -0,0 +1,69 @@
+package resourceplacement
+
+import (
+	"context"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
+	"go.goms.io/fleet/pkg/utils/validator"
+)
+
+const (
+	// ValidationPath is the webhook service path which admission requests are routed to for validating ResourcePlacement resources.
+	ValidationPath = "/validate-placement.kubernetes-fleet.io-v1beta1-resourceplacement"
+)
+
+type resourcePlacementValidator struct {
+	Client  client.Client
+	decoder *admission.Decoder
+}
+
+// Add registers the webhook for the ResourcePlacement.
+func Add(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &resourcePlacementValidator{Client: mgr.GetClient()}})
+	return nil
+}
+
+// Handle resourcePlacementValidator handles create, update RP requests.
+func (v *resourcePlacementValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	var rp placementv1beta1.ResourcePlacement
+	namespacedName := types.NamespacedName{Name: req.Name, Namespace: req.Namespace}
+	if req.Operation == admissionv1.Create || req.Operation == admissionv1.Update {
+		klog.V(2).InfoS("handling RP", "operation", req.Operation, "namespacedName", namespacedName)
+		if err := v.decoder.Decode(req, &rp); err != nil {
+			klog.ErrorS(err, "failed to decode RP object for create/update operation", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if req.Operation == admissionv1.Update {
+			var oldRP placementv1beta1.ResourcePlacement
+			if err := v.decoder.DecodeRaw(req.OldObject, &oldRP); err != nil {
+				return admission.Errored(http.StatusBadRequest, err)
+			}
+			// handle update case where placement type should be immutable.
+			if validator.IsPlacementPolicyTypeUpdated(oldRP.Spec.Policy, rp.Spec.Policy) {
+				return admission.Denied("placement type is immutable")
+			}
+		}
+		if err := validator.ValidateResourcePlacement(&rp); err != nil {
+			klog.V(2).InfoS("resource placement has invalid fields, request is denied", "operation", req.Operation, "namespacedName", namespacedName)
+			return admission.Denied(err.Error())
+		}
+	}
+	klog.V(2).InfoS("user is allowed to modify resource placement", "operation", req.Operation, "user", req.UserInfo.Username, "group", req.UserInfo.Groups, "namespacedName", namespacedName)
+	return admission.Allowed("any user is allowed to modify RP")
+}
+
+// InjectDecoder injects the decoder.
+func (v *resourcePlacementValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
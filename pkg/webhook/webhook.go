@@ -46,10 +46,12 @@ import (
 	placementv1beta1 "go.goms.io/fleet/apis/placement/v1beta1"
 	fleetv1alpha1 "go.goms.io/fleet/apis/v1alpha1"
 	"go.goms.io/fleet/cmd/hubagent/options"
+	"go.goms.io/fleet/pkg/webhook/clusterresourceoverride"
 	"go.goms.io/fleet/pkg/webhook/clusterresourceplacement"
 	"go.goms.io/fleet/pkg/webhook/fleetresourcehandler"
 	"go.goms.io/fleet/pkg/webhook/pod"
 	"go.goms.io/fleet/pkg/webhook/replicaset"
+	"go.goms.io/fleet/pkg/webhook/resourceplacement"
 )
 
 const (
@@ -271,6 +273,40 @@ func (w *Config) buildFleetValidatingWebhooks() []admv1.ValidatingWebhook {
 			},
 			TimeoutSeconds: webhookTimeoutSeconds,
 		},
+		{
+			Name:                    "fleet.resourceplacementv1beta1.validating",
+			ClientConfig:            w.createClientConfig(resourceplacement.ValidationPath),
+			FailurePolicy:           &failPolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{
+						admv1.Create,
+						admv1.Update,
+					},
+					Rule: createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{placementv1beta1.ResourcePlacementResource}, &namespacedScope),
+				},
+			},
+			TimeoutSeconds: webhookTimeoutSeconds,
+		},
+		{
+			Name:                    "fleet.clusterresourceoverridev1beta1.validating",
+			ClientConfig:            w.createClientConfig(clusterresourceoverride.ValidationPath),
+			FailurePolicy:           &failPolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{
+						admv1.Create,
+						admv1.Update,
+					},
+					Rule: createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{placementv1beta1.ClusterResourceOverrideResource}, &clusterScope),
+				},
+			},
+			TimeoutSeconds: webhookTimeoutSeconds,
+		},
 		{
 			Name:                    "fleet.replicaset.validating",
 			ClientConfig:            w.createClientConfig(replicaset.ValidationPath),
@@ -392,12 +392,14 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			memberCluster2EastProd:   &zeroScore,
 			memberCluster3EastCanary: &zeroScore,
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(10),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(10),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(10),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(10),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			memberCluster6WestProd:   &zeroScore,
 			memberCluster7WestCanary: &zeroScore,
@@ -492,16 +494,19 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			memberCluster1EastProd: &zeroScore,
 			memberCluster2EastProd: &zeroScore,
 			memberCluster3EastCanary: {
-				AffinityScore:       pointer.Int32(20),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(20),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(10),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(10),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(10),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(10),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			memberCluster6WestProd:   &zeroScore,
 			memberCluster7WestCanary: &zeroScore,
@@ -620,8 +625,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// not violate any topology spread constraints + does not increase the skew. It
 			// is assigned a topology spread score of 0 as the skew is unchanged.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is considered to be unschedulable in the second iteration as placing
 			// resources on it would violate the topology spread constraint (skew becomes 2,
@@ -632,8 +638,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// clusters), and its name is the largest in alphanumeric order. It is assigned
 			// a topology spread score of -1 as placing resources on it increases the skew.
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -710,16 +717,18 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster1EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 2 is not picked in the second iteration, but placing resources on it
 			// would leave the skew for the region-based topology spread constraint unchanged,
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is considered to be unschedulable in the second iteration as placing
 			// resources on it would violate the environment-based topology spread constraint
@@ -730,16 +739,18 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the second iteration, as placing resources on it does
 			// not violate any topology spread constraints + does not increase the skew. It
 			// is assigned a topology spread score of 0 as the skew is unchanged for both
 			// topology spread constraints.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is considered to be unschedulable in the second iteration as placing
 			// resources on it would violate the region-based topology spread constraint
@@ -751,8 +762,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// in alphanumeric order. It is assigned a topology spread score of -2 as placing
 			// resources on it increases the skew in both topology spread constraints..
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-2),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-2),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -837,23 +849,26 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// not violate any topology spread constraints + does not increase the skew. It
 			// is assigned a topology spread score of 0 as the skew is unchanged.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(0),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(0),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is not picked in the second iteration, and placing
 			// resources on it would violate the topology spread constraint (skew becomes 2,
 			// the limit is 1); the violation leads to a topology spread score of -1000.
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1000),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1000),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is picked in the first iteration, as placing resources on it does not
 			// violate any topology spread constraints + increases the skew only by one (so do other
 			// clusters), and its name is the largest in alphanumeric order. It is assigned
 			// a topology spread score of -1 as placing resources on it increases the skew.
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -930,40 +945,45 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster1EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 2 is not picked in the second iteration, but placing resources on it
 			// would leave the skew for the region-based topology spread constraint unchanged,
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is not picked in the second iteration as placing
 			// resources on it would violate the region-based topology spread constraint
 			// (skew becomes 2, the limit is 1); the violation leads to a topology spread score
 			// of -1000.
 			memberCluster3EastCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1000),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1000),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 4 is not picked in the second iteration, but placing resources on it
 			// would leave the skew for the region-based topology spread constraint unchanged,
 			// and decrease the skew for the environment-based topology spread constraint by 1;
 			// consequently it receives a topology spread score of 1.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the second iteration, as placing resources on it does
 			// not violate any topology spread constraints + does not increase the skew. It
 			// is assigned a topology spread score of 0 as the skew is unchanged for both
 			// topology spread constraints.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is not picked in the second iteration as placing
 			// resources on it would violate the region-based topology spread constraint
@@ -971,8 +991,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// environment based topology spread constraint by 1, so it receives a topology
 			// spread score of -999 (-1000 for the violation, +1 for the skew decrease).
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-999),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-999),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is picked in the first iteration, as placing resources on it does not
 			// violate any topology spread constraints + increases the skew only by one in both
@@ -980,8 +1001,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// in alphanumeric order. It is assigned a topology spread score of -2 as placing
 			// resources on it increases the skew in both topology spread constraints..
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-2),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-2),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -1075,8 +1097,9 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// not violate any topology spread constraints; but it increases the skew by 1, hence
 			// the -1 topology spread score.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is filtered out (does not match with required affinity term).
 			memberCluster6WestProd: nil,
@@ -1178,50 +1201,57 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// configuration (with a weight of 30); but it increases the skew by 1, hence
 			// the -1 topology spread score.
 			memberCluster1EastProd: {
-				AffinityScore:       pointer.Int32(30),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(30),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 2 is picked in the second iteration, as placing resources on it reduces
 			// the skew by 1, hence the topology spread score of 1, and it is preferred
 			// per affinity configuration (with a weight of 30).
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(30),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(30),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is picked in the first iteration, as placing resources on it does
 			// not violate any topology spread constraints and it is preferred per affinity
 			// configuration (with a weight of 30); but it increases the skew by 1, hence
 			// the -1 topology spread score.
 			memberCluster3EastCanary: {
-				AffinityScore:       pointer.Int32(30),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(30),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 4 is not picked in the 6th iteration; placing resources on it violates
 			// the topology spread constraint, hence the topology spread score of -1000.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1000),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1000),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the 6th iteration; placing resources on it violates the
 			// topology spread constraint, hence the topology spread score of -1000. It ranks
 			// higher by name in alphanumeric order than cluster 4.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1000),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1000),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is picked in the 5th iteration, as placing resources on it does
 			// not violate any topology spread constraints + the cluster is ranked higher by name
 			// in alphanumeric order; but it increases the skew by 1, hence
 			// the -1 topology spread score.
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is picked in the 4th iteration, as placing resources on it reduces
 			// the skew by 1, hence the topology spread score of 1.
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -1320,15 +1350,17 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// * it is preferred per affinity configuration (with a weight of 40);
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(40),
-				TopologySpreadScore: pointer.Int32(-2),
+				AffinityScore:             pointer.Int32(40),
+				TopologySpreadScore:       pointer.Int32(-2),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is filtered out as it does not meet the affinity requirements.
 			memberCluster3EastCanary: nil,
 			// Cluster 4 is not picked as it ranks lower by name in alphanumeric order.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-999),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-999),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the 3rd iteration, as
 			// * placing resources on it does not violate the DoNotSchedule topology spread
@@ -1336,16 +1368,18 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			//   topology spread constraint (skew becomes 3, limit is 2); and
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-999),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-999),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is picked in the second iteration, as
 			// * placing resources on it does not violate any topology spread constraints (it
 			//   increase the skew by 1 for environment-based topology spread constraint); and
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is not picked as it does not meet the affinity requirements.
 			memberCluster7WestCanary: nil,
@@ -1648,15 +1682,17 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			// * it is preferred per affinity configuration (with a weight of 40);
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(40),
-				TopologySpreadScore: pointer.Int32(-2),
+				AffinityScore:             pointer.Int32(40),
+				TopologySpreadScore:       pointer.Int32(-2),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is filtered out as it does not meet the affinity requirements.
 			memberCluster3EastCanary: nil,
 			// Cluster 4 is not picked as it ranks lower by name in alphanumeric order.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-999),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-999),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the 3rd iteration, as
 			// * placing resources on it does not violate the DoNotSchedule topology spread
@@ -1664,16 +1700,18 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 			//   topology spread constraint (skew becomes 3, limit is 2); and
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-999),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-999),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is picked in the second iteration, as
 			// * placing resources on it does not violate any topology spread constraints (it
 			//   increase the skew by 1 for environment-based topology spread constraint); and
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is not picked as it does not meet the affinity requirements.
 			memberCluster7WestCanary: nil,
@@ -1682,46 +1720,53 @@ var _ = Describe("scheduling CRPs of the PickN placement type", func() {
 		scoreByClusterAfter := map[string]*placementv1beta1.ClusterScore{
 			// Cluster 1 is not picked as it is not preferred per affinity configuration.
 			memberCluster1EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 2 is not picked as it is not preferred per affinity configuration.
 			memberCluster2EastProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 3 is not picked as it is not preferred per affinity configuration.
 			memberCluster3EastCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 4 is picked in the 3rd iteration, as
 			// * placing resources on it increases the skew by 1 (so does other clusters); and
 			// * it is preferred per affinity configuration (with a weight of 50);
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster4CentralProd: {
-				AffinityScore:       pointer.Int32(50),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(50),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 5 is picked in the first iteration, as
 			// * placing resources on it increases the skew by 1 (so does other clusters); and
 			// * it is preferred per affinity configuration (with a weight of 50);
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster5CentralProd: {
-				AffinityScore:       pointer.Int32(50),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(50),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 6 is not picked as it is not preferred per affinity configuration.
 			memberCluster6WestProd: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(-1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(-1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 			// Cluster 7 is picked in the second iteration, as
 			// * placing resources on it decreases the skew by 1; and
 			// * it is ranked higher by name in alphanumeric order.
 			memberCluster7WestCanary: {
-				AffinityScore:       pointer.Int32(0),
-				TopologySpreadScore: pointer.Int32(1),
+				AffinityScore:             pointer.Int32(0),
+				TopologySpreadScore:       pointer.Int32(1),
+				ResourceAvailabilityScore: pointer.Int32(0),
 			},
 		}
 
@@ -64,8 +64,9 @@ var (
 
 	nilScoreByCluster = map[string]*placementv1beta1.ClusterScore{}
 	zeroScore         = placementv1beta1.ClusterScore{
-		AffinityScore:       pointer.Int32(0),
-		TopologySpreadScore: pointer.Int32(0),
+		AffinityScore:             pointer.Int32(0),
+		TopologySpreadScore:       pointer.Int32(0),
+		ResourceAvailabilityScore: pointer.Int32(0),
 	}
 	zeroScoreByCluster = map[string]*placementv1beta1.ClusterScore{
 		memberCluster1EastProd:          &zeroScore,